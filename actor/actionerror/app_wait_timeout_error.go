@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// AppWaitTimeoutError is returned when an application does not reach the
+// awaited state before the timeout. Reasons explains what was still missing
+// when the wait timed out, one line per instance or deployment.
+type AppWaitTimeoutError struct {
+	Name    string
+	Reasons []string
+}
+
+func (e AppWaitTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for application '%s'", e.Name)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ApplicationsNotFoundForLabelSelectorError is returned when no applications
+// in the space match a label selector.
+type ApplicationsNotFoundForLabelSelectorError struct {
+	LabelSelector string
+}
+
+func (e ApplicationsNotFoundForLabelSelectorError) Error() string {
+	return fmt.Sprintf("No applications match label selector '%s'.", e.LabelSelector)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// BrowserLoginError is returned when the browser does not come back to the
+// loopback listener with an authorization code.
+type BrowserLoginError struct {
+	Reason string
+}
+
+func (e BrowserLoginError) Error() string {
+	return fmt.Sprintf("Browser login failed: %s", e.Reason)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// DeviceLoginError is returned when a device login is denied or expires
+// before the user approves it.
+type DeviceLoginError struct {
+	Reason string
+}
+
+func (e DeviceLoginError) Error() string {
+	return fmt.Sprintf("Device login failed: %s", e.Reason)
+}
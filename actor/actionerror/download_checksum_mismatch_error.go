@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// DownloadChecksumMismatchError is returned when downloaded bits do not match
+// the checksum reported by the Cloud Controller.
+type DownloadChecksumMismatchError struct {
+	Type     string
+	Expected string
+	Actual   string
+}
+
+func (e DownloadChecksumMismatchError) Error() string {
+	return fmt.Sprintf("Downloaded bits have %s checksum %s, expected %s.", e.Type, e.Actual, e.Expected)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// PackageNotDownloadableError is returned when downloading a package that has
+// no bits, such as a docker package.
+type PackageNotDownloadableError struct {
+	GUID string
+}
+
+func (e PackageNotDownloadableError) Error() string {
+	return fmt.Sprintf("Package '%s' references a docker image and has no bits to download.", e.GUID)
+}
@@ -0,0 +1,94 @@
+package sharedaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	logcache "code.cloudfoundry.org/go-log-cache/v2"
+	"code.cloudfoundry.org/go-log-cache/v2/rpc/logcache_v1"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+)
+
+// Names of the gauges Diego reports for every app container.
+const (
+	ContainerMetricCPU     = "cpu"
+	ContainerMetricMemory  = "memory"
+	ContainerMetricDisk    = "disk"
+	ContainerMetricLogRate = "log_rate"
+
+	containerMetricsPageSize = 1000
+)
+
+// ContainerMetric is a single gauge sample reported for an app instance.
+type ContainerMetric struct {
+	Name        string
+	Value       float64
+	Timestamp   time.Time
+	InstanceID  string
+	ProcessType string
+}
+
+// GetContainerMetrics returns the cpu, memory, disk and log rate samples Log
+// Cache holds for the app between since and until, oldest first.
+func GetContainerMetrics(appGUID string, client LogCacheClient, since time.Time, until time.Time) ([]ContainerMetric, error) {
+	var metrics []ContainerMetric
+
+	start := since
+	for {
+		envelopes, err := client.Read(
+			context.Background(),
+			appGUID,
+			start,
+			logcache.WithEnvelopeTypes(logcache_v1.EnvelopeType_GAUGE),
+			logcache.WithLimit(containerMetricsPageSize),
+			logcache.WithEndTime(until),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to retrieve metrics from Log Cache: %s", err)
+		}
+
+		for _, envelope := range envelopes {
+			metrics = append(metrics, convertEnvelopeToContainerMetrics(envelope)...)
+		}
+
+		if len(envelopes) < containerMetricsPageSize {
+			break
+		}
+		start = time.Unix(0, envelopes[len(envelopes)-1].GetTimestamp()+1)
+	}
+
+	return metrics, nil
+}
+
+func convertEnvelopeToContainerMetrics(envelope *loggregator_v2.Envelope) []ContainerMetric {
+	gaugeEnvelope, ok := envelope.GetMessage().(*loggregator_v2.Envelope_Gauge)
+	if !ok {
+		return nil
+	}
+
+	// Older Diego cells do not tag container metrics with the process type;
+	// they only report metrics for the web process.
+	processType := envelope.GetTags()["process_type"]
+	if processType == "" {
+		processType = constant.ProcessTypeWeb
+	}
+
+	var metrics []ContainerMetric
+	for _, name := range []string{ContainerMetricCPU, ContainerMetricMemory, ContainerMetricDisk, ContainerMetricLogRate} {
+		value, ok := gaugeEnvelope.Gauge.GetMetrics()[name]
+		if !ok {
+			continue
+		}
+
+		metrics = append(metrics, ContainerMetric{
+			Name:        name,
+			Value:       value.GetValue(),
+			Timestamp:   time.Unix(0, envelope.GetTimestamp()),
+			InstanceID:  envelope.GetInstanceId(),
+			ProcessType: processType,
+		})
+	}
+	return metrics
+}
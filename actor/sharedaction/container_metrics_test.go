@@ -0,0 +1,123 @@
+package sharedaction_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/go-log-cache/v2/rpc/logcache_v1"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Container Metrics Actions", func() {
+	var (
+		fakeLogCacheClient *sharedactionfakes.FakeLogCacheClient
+		since              time.Time
+		until              time.Time
+	)
+
+	gaugeEnvelope := func(timestamp time.Time, instanceID string, tags map[string]string, metrics map[string]float64) *loggregator_v2.Envelope {
+		gauge := &loggregator_v2.Gauge{Metrics: map[string]*loggregator_v2.GaugeValue{}}
+		for name, value := range metrics {
+			gauge.Metrics[name] = &loggregator_v2.GaugeValue{Value: value}
+		}
+		return &loggregator_v2.Envelope{
+			Timestamp:  timestamp.UnixNano(),
+			InstanceId: instanceID,
+			Tags:       tags,
+			Message:    &loggregator_v2.Envelope_Gauge{Gauge: gauge},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+		until = time.Unix(1000, 0)
+		since = until.Add(-time.Hour)
+	})
+
+	Describe("GetContainerMetrics", func() {
+		var (
+			metrics []sharedaction.ContainerMetric
+			err     error
+		)
+
+		JustBeforeEach(func() {
+			metrics, err = sharedaction.GetContainerMetrics("some-app-guid", fakeLogCacheClient, since, until)
+		})
+
+		When("Log Cache returns gauges", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+					gaugeEnvelope(since.Add(time.Minute), "0", map[string]string{"process_type": "worker"}, map[string]float64{
+						"cpu":          12.5,
+						"memory":       1024,
+						"memory_quota": 4096,
+					}),
+					gaugeEnvelope(since.Add(2*time.Minute), "1", nil, map[string]float64{
+						"disk":     2048,
+						"log_rate": 512,
+					}),
+				}, nil)
+			})
+
+			It("returns the container metrics", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(metrics).To(ConsistOf(
+					sharedaction.ContainerMetric{Name: "cpu", Value: 12.5, Timestamp: since.Add(time.Minute), InstanceID: "0", ProcessType: "worker"},
+					sharedaction.ContainerMetric{Name: "memory", Value: 1024, Timestamp: since.Add(time.Minute), InstanceID: "0", ProcessType: "worker"},
+					sharedaction.ContainerMetric{Name: "disk", Value: 2048, Timestamp: since.Add(2 * time.Minute), InstanceID: "1", ProcessType: "web"},
+					sharedaction.ContainerMetric{Name: "log_rate", Value: 512, Timestamp: since.Add(2 * time.Minute), InstanceID: "1", ProcessType: "web"},
+				))
+			})
+
+			It("reads gauges in the window", func() {
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+				_, sourceID, start, opts := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+				Expect(start).To(Equal(since))
+
+				query := map[string][]string{}
+				for _, opt := range opts {
+					opt(nil, query)
+				}
+				Expect(query).To(HaveKeyWithValue("envelope_types", []string{logcache_v1.EnvelopeType_GAUGE.String()}))
+				Expect(query).To(HaveKeyWithValue("end_time", []string{"1000000000000"}))
+			})
+		})
+
+		When("there are more gauges than fit in a page", func() {
+			BeforeEach(func() {
+				page := make([]*loggregator_v2.Envelope, 1000)
+				for i := range page {
+					page[i] = gaugeEnvelope(since.Add(time.Duration(i)*time.Second), "0", nil, map[string]float64{"cpu": 1})
+				}
+				fakeLogCacheClient.ReadReturnsOnCall(0, page, nil)
+				fakeLogCacheClient.ReadReturnsOnCall(1, []*loggregator_v2.Envelope{
+					gaugeEnvelope(since.Add(1000*time.Second), "0", nil, map[string]float64{"cpu": 2}),
+				}, nil)
+			})
+
+			It("reads the next page from after the last gauge", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(metrics).To(HaveLen(1001))
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(2))
+
+				_, _, start, _ := fakeLogCacheClient.ReadArgsForCall(1)
+				Expect(start).To(Equal(since.Add(999*time.Second + time.Nanosecond)))
+			})
+		})
+
+		When("Log Cache returns an error", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, errors.New("read-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("Failed to retrieve metrics from Log Cache: read-error"))
+			})
+		})
+	})
+})
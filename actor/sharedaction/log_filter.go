@@ -0,0 +1,105 @@
+package sharedaction
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+)
+
+// LogFilter narrows the logs returned from Log Cache. The time window and the
+// limit are sent to Log Cache; the remaining fields are applied to each
+// envelope as it is received, because Log Cache's read API cannot filter on
+// them.
+type LogFilter struct {
+	// Since and Until bound the log timestamps. A zero value leaves that end
+	// of the window open.
+	Since time.Time
+	Until time.Time
+
+	// SourceTypes keeps logs whose source type equals, or is nested under, one
+	// of the given source types. For example "APP/PROC" matches
+	// "APP/PROC/WEB".
+	SourceTypes []string
+
+	// Instance keeps logs from a single instance index when InstanceSet is
+	// true.
+	Instance    int
+	InstanceSet bool
+
+	// ProcessType keeps logs emitted by processes of the given type.
+	ProcessType string
+
+	// Pattern keeps logs whose message matches the regular expression.
+	Pattern *regexp.Regexp
+
+	// Limit caps the number of returned logs. Zero uses the default for the
+	// kind of request.
+	Limit int
+}
+
+func (filter LogFilter) filtersEnvelopes() bool {
+	return len(filter.SourceTypes) > 0 ||
+		filter.InstanceSet ||
+		filter.ProcessType != "" ||
+		filter.Pattern != nil
+}
+
+func (filter LogFilter) matches(envelope *loggregator_v2.Envelope) bool {
+	logEnvelope, ok := envelope.GetMessage().(*loggregator_v2.Envelope_Log)
+	if !ok {
+		return false
+	}
+
+	timestamp := time.Unix(0, envelope.GetTimestamp())
+	if !filter.Since.IsZero() && timestamp.Before(filter.Since) {
+		return false
+	}
+	if !filter.Until.IsZero() && timestamp.After(filter.Until) {
+		return false
+	}
+
+	sourceType := envelope.GetTags()["source_type"]
+	if len(filter.SourceTypes) > 0 && !matchesAnySourceType(sourceType, filter.SourceTypes) {
+		return false
+	}
+
+	if filter.InstanceSet && envelope.GetInstanceId() != strconv.Itoa(filter.Instance) {
+		return false
+	}
+
+	if filter.ProcessType != "" && !matchesProcessType(envelope.GetTags(), filter.ProcessType) {
+		return false
+	}
+
+	if filter.Pattern != nil && !filter.Pattern.Match(logEnvelope.Log.GetPayload()) {
+		return false
+	}
+
+	return true
+}
+
+func matchesAnySourceType(sourceType string, sourceTypes []string) bool {
+	sourceType = strings.ToUpper(sourceType)
+	for _, wanted := range sourceTypes {
+		wanted = strings.ToUpper(wanted)
+		if sourceType == wanted || strings.HasPrefix(sourceType, wanted+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesProcessType(tags map[string]string, processType string) bool {
+	if tagged, ok := tags["process_type"]; ok {
+		return strings.EqualFold(tagged, processType)
+	}
+
+	// Older Diego cells only record the process type in the source type,
+	// for example APP/PROC/WORKER.
+	sourceType := strings.ToUpper(tags["source_type"])
+	prefix := "APP/PROC/" + strings.ToUpper(processType)
+	return sourceType == prefix || strings.HasPrefix(sourceType, prefix+"/")
+}
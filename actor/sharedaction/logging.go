@@ -89,10 +89,12 @@ func (c channelWriter) Write(bytes []byte) (n int, err error) {
 // cliRetryBackoff returns true for OnErr after sleeping the given interval for a limited number of times,
 // and returns true for OnEmpty always.
 // Basically: retry x times on connection failures, and wait forever for logs to show up.
+// When until is set, OnEmpty gives up once that time has passed.
 type cliRetryBackoff struct {
 	interval time.Duration
 	maxCount int
 	count    int
+	until    time.Time
 }
 
 func newCliRetryBackoff(interval time.Duration, maxCount int) *cliRetryBackoff {
@@ -113,6 +115,10 @@ func (b *cliRetryBackoff) OnErr(error) bool {
 }
 
 func (b *cliRetryBackoff) OnEmpty() bool {
+	if !b.until.IsZero() && time.Now().After(b.until) {
+		return false
+	}
+
 	time.Sleep(b.interval)
 	return true
 }
@@ -122,6 +128,13 @@ func (b *cliRetryBackoff) Reset() {
 }
 
 func GetStreamingLogs(appGUID string, client LogCacheClient) (<-chan LogMessage, <-chan error, context.CancelFunc) {
+	return GetStreamingLogsWithFilter(appGUID, client, LogFilter{})
+}
+
+// GetStreamingLogsWithFilter tails the logs that match the filter. Without a
+// Since time it starts from the most recent log; with an Until time the
+// stream ends once that time is reached.
+func GetStreamingLogsWithFilter(appGUID string, client LogCacheClient, filter LogFilter) (<-chan LogMessage, <-chan error, context.CancelFunc) {
 
 	logrus.Info("Start Tailing Logs")
 
@@ -132,21 +145,41 @@ func GetStreamingLogs(appGUID string, client LogCacheClient) (<-chan LogMessage,
 		defer close(outgoingLogStream)
 		defer close(outgoingErrStream)
 
-		ts := latestEnvelopeTimestamp(client, outgoingErrStream, ctx, appGUID)
+		walkStartTime := filter.Since
+		if walkStartTime.IsZero() {
+			ts := latestEnvelopeTimestamp(client, outgoingErrStream, ctx, appGUID)
+
+			// if the context was cancelled we may not have seen an envelope
+			if ts.IsZero() {
+				return
+			}
 
-		// if the context was cancelled we may not have seen an envelope
-		if ts.IsZero() {
-			return
+			const offset = 1 * time.Second
+			walkStartTime = ts.Add(-offset)
 		}
 
-		const offset = 1 * time.Second
-		walkStartTime := ts.Add(-offset)
+		backoff := newCliRetryBackoff(retryInterval, retryCount)
+		backoff.until = filter.Until
+
+		walkOptions := []logcache.WalkOption{
+			logcache.WithWalkDelay(2 * time.Second),
+			logcache.WithWalkStartTime(walkStartTime),
+			logcache.WithWalkEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
+			logcache.WithWalkBackoff(backoff),
+			logcache.WithWalkLogger(log.New(channelWriter{
+				errChannel: outgoingErrStream,
+			}, "", 0)),
+		}
+		if !filter.Until.IsZero() {
+			walkOptions = append(walkOptions, logcache.WithWalkEndTime(filter.Until))
+		}
 
+		var sent int
 		logcache.Walk(
 			ctx,
 			appGUID,
 			logcache.Visitor(func(envelopes []*loggregator_v2.Envelope) bool {
-				logMessages := convertEnvelopesToLogMessages(envelopes)
+				logMessages := convertEnvelopesToLogMessages(filterEnvelopes(envelopes, filter))
 				for _, logMessage := range logMessages {
 					select {
 					case <-ctx.Done():
@@ -154,17 +187,16 @@ func GetStreamingLogs(appGUID string, client LogCacheClient) (<-chan LogMessage,
 					default:
 						outgoingLogStream <- *logMessage
 					}
+
+					sent++
+					if filter.Limit > 0 && sent >= filter.Limit {
+						return false
+					}
 				}
 				return true
 			}),
 			client.Read,
-			logcache.WithWalkDelay(2*time.Second),
-			logcache.WithWalkStartTime(walkStartTime),
-			logcache.WithWalkEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
-			logcache.WithWalkBackoff(newCliRetryBackoff(retryInterval, retryCount)),
-			logcache.WithWalkLogger(log.New(channelWriter{
-				errChannel: outgoingErrStream,
-			}, "", 0)),
+			walkOptions...,
 		)
 	}()
 
@@ -209,35 +241,95 @@ func latestEnvelopeTimestamp(client LogCacheClient, errs chan error, ctx context
 }
 
 func GetRecentLogs(appGUID string, client LogCacheClient) ([]LogMessage, error) {
-	logLineRequestCount := RecentLogsLines
+	return GetRecentLogsWithFilter(appGUID, client, LogFilter{})
+}
+
+// GetRecentLogsWithFilter returns up to filter.Limit of the most recent logs
+// that match the filter, oldest first. When the filter has to be applied
+// client side, Log Cache is paged backwards until enough logs match or the
+// window is exhausted.
+func GetRecentLogsWithFilter(appGUID string, client LogCacheClient, filter LogFilter) ([]LogMessage, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = RecentLogsLines
+	}
+
+	var matched []*loggregator_v2.Envelope
+	end := filter.Until
+	for len(matched) < limit {
+		logLineRequestCount := RecentLogsLines
+		if !filter.filtersEnvelopes() && limit-len(matched) < logLineRequestCount {
+			logLineRequestCount = limit - len(matched)
+		}
+
+		envelopes, requested, err := readRecentEnvelopes(client, appGUID, filter.Since, end, logLineRequestCount)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to retrieve logs from Log Cache: %s", err)
+		}
+
+		for _, envelope := range envelopes {
+			if len(matched) == limit {
+				break
+			}
+			if filter.matches(envelope) {
+				matched = append(matched, envelope)
+			}
+		}
+
+		if len(envelopes) < requested {
+			break
+		}
+
+		end = time.Unix(0, envelopes[len(envelopes)-1].GetTimestamp()-1)
+		if !filter.Since.IsZero() && end.Before(filter.Since) {
+			break
+		}
+	}
+
+	logMessages := convertEnvelopesToLogMessages(matched)
+	var reorderedLogMessages []LogMessage
+	for i := len(logMessages) - 1; i >= 0; i-- {
+		reorderedLogMessages = append(reorderedLogMessages, *logMessages[i])
+	}
+
+	return reorderedLogMessages, nil
+}
+
+// readRecentEnvelopes reads the newest log envelopes in the window, halving
+// the request size while Log Cache is rate limiting. It returns the request
+// size that succeeded.
+func readRecentEnvelopes(client LogCacheClient, appGUID string, start time.Time, end time.Time, logLineRequestCount int) ([]*loggregator_v2.Envelope, int, error) {
 	var envelopes []*loggregator_v2.Envelope
 	var err error
 
 	for logLineRequestCount >= 1 {
-		envelopes, err = client.Read(
-			context.Background(),
-			appGUID,
-			time.Time{},
+		options := []logcache.ReadOption{
 			logcache.WithEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
 			logcache.WithLimit(logLineRequestCount),
 			logcache.WithDescending(),
-		)
+		}
+		if !end.IsZero() {
+			options = append(options, logcache.WithEndTime(end))
+		}
+
+		envelopes, err = client.Read(context.Background(), appGUID, start, options...)
 		if err == nil || err.Error() != "unexpected status code 429" {
 			break
 		}
 		logLineRequestCount /= 2
 	}
-	if err != nil {
-		return nil, fmt.Errorf("Failed to retrieve logs from Log Cache: %s", err)
-	}
 
-	logMessages := convertEnvelopesToLogMessages(envelopes)
-	var reorderedLogMessages []LogMessage
-	for i := len(logMessages) - 1; i >= 0; i-- {
-		reorderedLogMessages = append(reorderedLogMessages, *logMessages[i])
-	}
+	return envelopes, logLineRequestCount, err
+}
 
-	return reorderedLogMessages, nil
+func filterEnvelopes(envelopes []*loggregator_v2.Envelope, filter LogFilter) []*loggregator_v2.Envelope {
+	var filtered []*loggregator_v2.Envelope
+	for _, envelope := range envelopes {
+		if filter.matches(envelope) {
+			filtered = append(filtered, envelope)
+		}
+	}
+	return filtered
 }
 
 func convertEnvelopesToLogMessages(envelopes []*loggregator_v2.Envelope) []*LogMessage {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -366,4 +367,198 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("GetRecentLogsWithFilter", func() {
+		var (
+			filter    sharedaction.LogFilter
+			envelopes []*loggregator_v2.Envelope
+			messages  []sharedaction.LogMessage
+			err       error
+		)
+
+		logEnvelope := func(timestamp int64, payload string, sourceType string, instance string, tags map[string]string) *loggregator_v2.Envelope {
+			allTags := map[string]string{"source_type": sourceType}
+			for key, value := range tags {
+				allTags[key] = value
+			}
+			return &loggregator_v2.Envelope{
+				Timestamp:  timestamp,
+				SourceId:   "some-app-guid",
+				InstanceId: instance,
+				Message: &loggregator_v2.Envelope_Log{
+					Log: &loggregator_v2.Log{Payload: []byte(payload), Type: loggregator_v2.Log_OUT},
+				},
+				Tags: allTags,
+			}
+		}
+
+		readQuery := func(call int) url.Values {
+			values := make(url.Values)
+			_, _, _, readOptions := fakeLogCacheClient.ReadArgsForCall(call)
+			for _, option := range readOptions {
+				option(new(url.URL), values)
+			}
+			return values
+		}
+
+		BeforeEach(func() {
+			filter = sharedaction.LogFilter{}
+			envelopes = []*loggregator_v2.Envelope{
+				logEnvelope(50, "GET /health 200", "RTR", "0", nil),
+				logEnvelope(40, "connection timeout", "APP/PROC/WORKER", "1", map[string]string{"process_type": "worker"}),
+				logEnvelope(30, "request served", "APP/PROC/WEB", "0", map[string]string{"process_type": "web"}),
+				logEnvelope(20, "Staging complete", "STG", "0", nil),
+				logEnvelope(10, "read timeout", "APP/PROC/WORKER", "0", nil),
+			}
+			fakeLogCacheClient.ReadStub = func(_ context.Context, _ string, _ time.Time, _ ...logcache.ReadOption) ([]*loggregator_v2.Envelope, error) {
+				return envelopes, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			messages, err = sharedaction.GetRecentLogsWithFilter("some-app-guid", fakeLogCacheClient, filter)
+		})
+
+		payloads := func() []string {
+			var result []string
+			for _, message := range messages {
+				result = append(result, message.Message())
+			}
+			return result
+		}
+
+		When("the filter is empty", func() {
+			It("returns every log oldest first", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(payloads()).To(Equal([]string{"read timeout", "Staging complete", "request served", "connection timeout", "GET /health 200"}))
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+			})
+		})
+
+		When("a time window and limit are provided", func() {
+			BeforeEach(func() {
+				filter.Since = time.Unix(0, 5)
+				filter.Until = time.Unix(0, 60)
+				filter.Limit = 3
+				envelopes = envelopes[:3]
+			})
+
+			It("sends them to Log Cache", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(payloads()).To(Equal([]string{"request served", "connection timeout", "GET /health 200"}))
+
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+				_, _, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(start).To(Equal(time.Unix(0, 5)))
+				query := readQuery(0)
+				Expect(query.Get("limit")).To(Equal("3"))
+				Expect(query.Get("end_time")).To(Equal("60"))
+				Expect(query.Get("descending")).To(Equal("true"))
+			})
+		})
+
+		DescribeTable("filtering on the client",
+			func(update func(*sharedaction.LogFilter), expected []string) {
+				update(&filter)
+				messages, err = sharedaction.GetRecentLogsWithFilter("some-app-guid", fakeLogCacheClient, filter)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(payloads()).To(Equal(expected))
+			},
+			Entry("by nested source type", func(f *sharedaction.LogFilter) { f.SourceTypes = []string{"APP/PROC"} },
+				[]string{"read timeout", "request served", "connection timeout"}),
+			Entry("by several source types", func(f *sharedaction.LogFilter) { f.SourceTypes = []string{"rtr", "STG"} },
+				[]string{"Staging complete", "GET /health 200"}),
+			Entry("by instance", func(f *sharedaction.LogFilter) { f.Instance, f.InstanceSet = 1, true },
+				[]string{"connection timeout"}),
+			Entry("by process type tag or source type", func(f *sharedaction.LogFilter) { f.ProcessType = "worker" },
+				[]string{"read timeout", "connection timeout"}),
+			Entry("by pattern", func(f *sharedaction.LogFilter) { f.Pattern = regexp.MustCompile("time(out)?") },
+				[]string{"read timeout", "connection timeout"}),
+			Entry("by pattern with a limit", func(f *sharedaction.LogFilter) {
+				f.Pattern = regexp.MustCompile("timeout")
+				f.Limit = 1
+			}, []string{"connection timeout"}),
+		)
+
+		When("filtering on the client and Log Cache returns a full page", func() {
+			BeforeEach(func() {
+				filter.Pattern = regexp.MustCompile("^match")
+
+				var fullPage []*loggregator_v2.Envelope
+				for i := 0; i < sharedaction.RecentLogsLines; i++ {
+					fullPage = append(fullPage, logEnvelope(int64(5000-i), "noise", "APP/PROC/WEB", "0", nil))
+				}
+				fakeLogCacheClient.ReadStub = func(_ context.Context, _ string, _ time.Time, _ ...logcache.ReadOption) ([]*loggregator_v2.Envelope, error) {
+					if fakeLogCacheClient.ReadCallCount() == 1 {
+						return fullPage, nil
+					}
+					return []*loggregator_v2.Envelope{logEnvelope(100, "match", "APP/PROC/WEB", "0", nil)}, nil
+				}
+			})
+
+			It("pages backwards from the oldest envelope it has seen", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(payloads()).To(Equal([]string{"match"}))
+
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(2))
+				Expect(readQuery(0).Get("end_time")).To(BeEmpty())
+				Expect(readQuery(1).Get("end_time")).To(Equal(fmt.Sprint(5000 - sharedaction.RecentLogsLines)))
+			})
+		})
+	})
+
+	Describe("GetStreamingLogsWithFilter", func() {
+		var (
+			filter   sharedaction.LogFilter
+			since    time.Time
+			messages <-chan sharedaction.LogMessage
+			errs     <-chan error
+			starts   []time.Time
+		)
+
+		BeforeEach(func() {
+			since = time.Now().Add(-time.Minute)
+			filter = sharedaction.LogFilter{
+				Since:   since,
+				Pattern: regexp.MustCompile("keep"),
+				Limit:   1,
+			}
+			starts = nil
+
+			fakeLogCacheClient.ReadStub = func(ctx context.Context, _ string, start time.Time, _ ...logcache.ReadOption) ([]*loggregator_v2.Envelope, error) {
+				starts = append(starts, start)
+				return []*loggregator_v2.Envelope{
+					{
+						Timestamp: since.Add(time.Second).UnixNano(),
+						Message:   &loggregator_v2.Envelope_Log{Log: &loggregator_v2.Log{Payload: []byte("drop me")}},
+					},
+					{
+						Timestamp: since.Add(2 * time.Second).UnixNano(),
+						Message:   &loggregator_v2.Envelope_Log{Log: &loggregator_v2.Log{Payload: []byte("keep me")}},
+					},
+					{
+						Timestamp: since.Add(3 * time.Second).UnixNano(),
+						Message:   &loggregator_v2.Envelope_Log{Log: &loggregator_v2.Log{Payload: []byte("keep me too")}},
+					},
+				}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			messages, errs, _ = sharedaction.GetStreamingLogsWithFilter("some-app-guid", fakeLogCacheClient, filter)
+		})
+
+		It("walks from the since time and stops once the limit of matching logs is reached", func() {
+			var received []string
+			for message := range messages {
+				received = append(received, message.Message())
+			}
+			Eventually(errs).Should(BeClosed())
+
+			Expect(received).To(Equal([]string{"keep me"}))
+
+			Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+			Expect(starts[0].UnixNano()).To(Equal(since.UnixNano()))
+		})
+	})
+
 })
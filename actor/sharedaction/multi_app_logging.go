@@ -0,0 +1,171 @@
+package sharedaction
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// logReorderWindow is how long merged streaming logs are held back so that
+// logs from different apps can be written in timestamp order. It is longer
+// than the Log Cache walk delay, after which no more logs for a given moment
+// are expected to arrive.
+const logReorderWindow = 3 * time.Second
+
+// AppLogSource is one of the apps whose logs are merged into a single stream.
+type AppLogSource struct {
+	GUID string
+	Name string
+}
+
+// AppLogMessage is a log message tagged with the name of the app that emitted
+// it.
+type AppLogMessage struct {
+	LogMessage
+	AppName string
+}
+
+// GetRecentLogsForApps returns up to filter.Limit of the most recent logs that
+// match the filter across all of the apps, oldest first.
+func GetRecentLogsForApps(apps []AppLogSource, client LogCacheClient, filter LogFilter) ([]AppLogMessage, error) {
+	var merged []AppLogMessage
+	for _, app := range apps {
+		logMessages, err := GetRecentLogsWithFilter(app.GUID, client, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, logMessage := range logMessages {
+			merged = append(merged, AppLogMessage{LogMessage: logMessage, AppName: app.Name})
+		}
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Timestamp().Before(merged[j].Timestamp())
+	})
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = RecentLogsLines
+	}
+	if len(merged) > limit {
+		merged = merged[len(merged)-limit:]
+	}
+
+	return merged, nil
+}
+
+// GetStreamingLogsForApps tails the logs of all of the apps as a single
+// stream. Logs are held back for a few seconds so they can be written in
+// timestamp order; filter.Limit applies to the merged stream.
+func GetStreamingLogsForApps(apps []AppLogSource, client LogCacheClient, filter LogFilter) (<-chan AppLogMessage, <-chan error, context.CancelFunc) {
+	outgoingLogStream := make(chan AppLogMessage, 1000)
+	outgoingErrStream := make(chan error, 1000)
+	ctx, cancelMerge := context.WithCancel(context.Background())
+
+	var cancelFuncs []context.CancelFunc
+	cancelFunc := func() {
+		for _, cancel := range cancelFuncs {
+			cancel()
+		}
+		cancelMerge()
+	}
+
+	incoming := make(chan AppLogMessage)
+	var forwarders sync.WaitGroup
+	for _, app := range apps {
+		logMessages, logErrs, cancel := GetStreamingLogsWithFilter(app.GUID, client, filter)
+		cancelFuncs = append(cancelFuncs, cancel)
+
+		forwarders.Add(2)
+		go func(appName string) {
+			defer forwarders.Done()
+			for logMessage := range logMessages {
+				incoming <- AppLogMessage{LogMessage: logMessage, AppName: appName}
+			}
+		}(app.Name)
+		go func() {
+			defer forwarders.Done()
+			for logErr := range logErrs {
+				select {
+				case outgoingErrStream <- logErr:
+				case <-ctx.Done():
+				}
+			}
+		}()
+	}
+
+	go func() {
+		forwarders.Wait()
+		close(incoming)
+	}()
+
+	go func() {
+		defer close(outgoingLogStream)
+		defer close(outgoingErrStream)
+
+		mergeLogStreams(ctx, incoming, outgoingLogStream, filter.Limit, cancelFunc)
+	}()
+
+	return outgoingLogStream, outgoingErrStream, cancelFunc
+}
+
+type bufferedAppLogMessage struct {
+	message  AppLogMessage
+	received time.Time
+}
+
+// mergeLogStreams writes the incoming logs to outgoing in timestamp order,
+// holding each log for logReorderWindow after it was received. Once limit logs
+// have been written, stop is called; incoming is drained until it is closed.
+func mergeLogStreams(ctx context.Context, incoming <-chan AppLogMessage, outgoing chan<- AppLogMessage, limit int, stop func()) {
+	ticker := time.NewTicker(250 * time.Millisecond)
+	defer ticker.Stop()
+
+	var buffer []bufferedAppLogMessage
+	var sent int
+	done := false
+
+	send := func(ready func(bufferedAppLogMessage) bool) {
+		for len(buffer) > 0 && !done && ready(buffer[0]) {
+			select {
+			case outgoing <- buffer[0].message:
+			case <-ctx.Done():
+				done = true
+				return
+			}
+			buffer = buffer[1:]
+
+			sent++
+			if limit > 0 && sent >= limit {
+				done = true
+				stop()
+			}
+		}
+	}
+
+	for {
+		select {
+		case message, ok := <-incoming:
+			if !ok {
+				send(func(bufferedAppLogMessage) bool { return true })
+				return
+			}
+			if done {
+				continue
+			}
+
+			i := sort.Search(len(buffer), func(i int) bool {
+				return buffer[i].message.Timestamp().After(message.Timestamp())
+			})
+			buffer = append(buffer, bufferedAppLogMessage{})
+			copy(buffer[i+1:], buffer[i:])
+			buffer[i] = bufferedAppLogMessage{message: message, received: time.Now()}
+		case now := <-ticker.C:
+			send(func(buffered bufferedAppLogMessage) bool {
+				return now.Sub(buffered.received) >= logReorderWindow
+			})
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package sharedaction_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	logcache "code.cloudfoundry.org/go-log-cache/v2"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Multi-App Logging Actions", func() {
+	var (
+		fakeLogCacheClient *sharedactionfakes.FakeLogCacheClient
+		apps               []sharedaction.AppLogSource
+		base               time.Time
+	)
+
+	logEnvelope := func(timestamp time.Time, payload string) *loggregator_v2.Envelope {
+		return &loggregator_v2.Envelope{
+			Timestamp: timestamp.UnixNano(),
+			Message: &loggregator_v2.Envelope_Log{
+				Log: &loggregator_v2.Log{Payload: []byte(payload), Type: loggregator_v2.Log_OUT},
+			},
+			Tags: map[string]string{"source_type": "APP/PROC/WEB"},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+		apps = []sharedaction.AppLogSource{
+			{GUID: "web-guid", Name: "web"},
+			{GUID: "worker-guid", Name: "worker"},
+		}
+		base = time.Now().Add(-time.Minute)
+	})
+
+	Describe("GetRecentLogsForApps", func() {
+		var (
+			filter   sharedaction.LogFilter
+			messages []sharedaction.AppLogMessage
+			err      error
+		)
+
+		BeforeEach(func() {
+			filter = sharedaction.LogFilter{}
+			fakeLogCacheClient.ReadStub = func(_ context.Context, sourceID string, _ time.Time, _ ...logcache.ReadOption) ([]*loggregator_v2.Envelope, error) {
+				switch sourceID {
+				case "web-guid":
+					return []*loggregator_v2.Envelope{
+						logEnvelope(base.Add(3*time.Second), "web-2"),
+						logEnvelope(base.Add(1*time.Second), "web-1"),
+					}, nil
+				default:
+					return []*loggregator_v2.Envelope{
+						logEnvelope(base.Add(4*time.Second), "worker-2"),
+						logEnvelope(base.Add(2*time.Second), "worker-1"),
+					}, nil
+				}
+			}
+		})
+
+		JustBeforeEach(func() {
+			messages, err = sharedaction.GetRecentLogsForApps(apps, fakeLogCacheClient, filter)
+		})
+
+		It("interleaves the logs of all apps in timestamp order", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(messages).To(HaveLen(4))
+
+			var lines []string
+			for _, message := range messages {
+				lines = append(lines, message.AppName+": "+message.Message())
+			}
+			Expect(lines).To(Equal([]string{"web: web-1", "worker: worker-1", "web: web-2", "worker: worker-2"}))
+		})
+
+		When("a limit is provided", func() {
+			BeforeEach(func() {
+				filter.Limit = 3
+			})
+
+			It("keeps the most recent logs across all apps", func() {
+				Expect(messages).To(HaveLen(3))
+				Expect(messages[0].Message()).To(Equal("worker-1"))
+				Expect(messages[2].Message()).To(Equal("worker-2"))
+			})
+		})
+
+		When("reading the logs of an app fails", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadStub = nil
+				fakeLogCacheClient.ReadReturns(nil, errors.New("read-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("Failed to retrieve logs from Log Cache: read-error"))
+			})
+		})
+	})
+
+	Describe("GetStreamingLogsForApps", func() {
+		var (
+			filter   sharedaction.LogFilter
+			messages <-chan sharedaction.AppLogMessage
+			errs     <-chan error
+		)
+
+		BeforeEach(func() {
+			filter = sharedaction.LogFilter{
+				Since: base,
+				Until: base.Add(30 * time.Second),
+			}
+
+			fakeLogCacheClient.ReadStub = func(_ context.Context, sourceID string, start time.Time, _ ...logcache.ReadOption) ([]*loggregator_v2.Envelope, error) {
+				if start.After(base) {
+					return nil, nil
+				}
+
+				switch sourceID {
+				case "web-guid":
+					return []*loggregator_v2.Envelope{
+						logEnvelope(base.Add(1*time.Second), "web-1"),
+						logEnvelope(base.Add(3*time.Second), "web-2"),
+					}, nil
+				default:
+					return []*loggregator_v2.Envelope{
+						logEnvelope(base.Add(2*time.Second), "worker-1"),
+						logEnvelope(base.Add(4*time.Second), "worker-2"),
+					}, nil
+				}
+			}
+		})
+
+		JustBeforeEach(func() {
+			messages, errs, _ = sharedaction.GetStreamingLogsForApps(apps, fakeLogCacheClient, filter)
+		})
+
+		receiveAll := func() []string {
+			var lines []string
+			for message := range messages {
+				lines = append(lines, message.AppName+": "+message.Message())
+			}
+			Eventually(errs).Should(BeClosed())
+			return lines
+		}
+
+		It("merges the streams of all apps in timestamp order", func() {
+			Expect(receiveAll()).To(Equal([]string{"web: web-1", "worker: worker-1", "web: web-2", "worker: worker-2"}))
+		})
+
+		When("a limit is provided", func() {
+			BeforeEach(func() {
+				filter.Limit = 3
+			})
+
+			It("stops after that many logs across all apps", func() {
+				Expect(receiveAll()).To(Equal([]string{"web: web-1", "worker: worker-1", "web: web-2"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,76 @@
+package sharedaction
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RouterLog is the source type of the access logs gorouter emits for every
+// request to an app.
+const RouterLog = "RTR"
+
+var (
+	routerRequestPattern      = regexp.MustCompile(`\] "(\S+) (\S+) [^"]*" (\d{3}) `)
+	routerResponseTimePattern = regexp.MustCompile(`\bresponse_time:([0-9.]+)`)
+	routerAppIndexPattern     = regexp.MustCompile(`\bapp_index:"(\d+)"`)
+)
+
+// RouterAccessLog is a parsed gorouter access log line.
+type RouterAccessLog struct {
+	Method       string
+	Path         string
+	StatusCode   int
+	ResponseTime time.Duration
+
+	// AppIndex is the index of the instance that served the request. It is
+	// -1 when gorouter could not route the request to an instance.
+	AppIndex int
+}
+
+// ParseRouterAccessLog parses a gorouter access log line such as
+//
+//	app.example.com - [2021-03-04T05:06:07.123Z] "GET /path HTTP/1.1" 200 0 12 "-" "curl" "10.0.0.1:1234" "10.0.1.2:61000" ... response_time:0.012 ... app_index:"0" ...
+//
+// The query string is dropped from the path. It returns false when the
+// message is not an access log line.
+func ParseRouterAccessLog(message string) (RouterAccessLog, bool) {
+	request := routerRequestPattern.FindStringSubmatch(message)
+	if request == nil {
+		return RouterAccessLog{}, false
+	}
+
+	statusCode, err := strconv.Atoi(request[3])
+	if err != nil {
+		return RouterAccessLog{}, false
+	}
+
+	accessLog := RouterAccessLog{
+		Method:     request[1],
+		Path:       request[2],
+		StatusCode: statusCode,
+		AppIndex:   -1,
+	}
+	if requestURL, err := url.ParseRequestURI(request[2]); err == nil {
+		accessLog.Path = requestURL.Path
+	} else if i := strings.IndexByte(accessLog.Path, '?'); i >= 0 {
+		accessLog.Path = accessLog.Path[:i]
+	}
+
+	if responseTime := routerResponseTimePattern.FindStringSubmatch(message); responseTime != nil {
+		seconds, err := strconv.ParseFloat(responseTime[1], 64)
+		if err == nil {
+			accessLog.ResponseTime = time.Duration(seconds * float64(time.Second))
+		}
+	}
+
+	if appIndex := routerAppIndexPattern.FindStringSubmatch(message); appIndex != nil {
+		if index, err := strconv.Atoi(appIndex[1]); err == nil {
+			accessLog.AppIndex = index
+		}
+	}
+
+	return accessLog, true
+}
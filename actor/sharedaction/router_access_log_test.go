@@ -0,0 +1,40 @@
+package sharedaction_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseRouterAccessLog", func() {
+	It("parses a gorouter access log line", func() {
+		accessLog, ok := sharedaction.ParseRouterAccessLog(`app.example.com - [2021-03-04T05:06:07.123456789Z] "GET /api/things?page=2 HTTP/1.1" 503 0 67 "-" "curl/7.64.1" "10.0.0.1:51234" "10.0.1.2:61000" x_forwarded_for:"1.2.3.4" x_forwarded_proto:"https" vcap_request_id:"some-id" response_time:0.012345 gorouter_time:0.000123 app_id:"some-app-guid" app_index:"2" instance_id:"some-instance" x_cf_routererror:"-"`)
+		Expect(ok).To(BeTrue())
+		Expect(accessLog).To(Equal(sharedaction.RouterAccessLog{
+			Method:       "GET",
+			Path:         "/api/things",
+			StatusCode:   503,
+			ResponseTime: 12345 * time.Microsecond,
+			AppIndex:     2,
+		}))
+	})
+
+	When("the request was not routed to an instance", func() {
+		It("leaves the app index unset", func() {
+			accessLog, ok := sharedaction.ParseRouterAccessLog(`app.example.com - [2021-03-04T05:06:07Z] "POST / HTTP/1.1" 502 0 0 "-" "curl" "10.0.0.1:51234" "-" response_time:0.5 app_index:"-"`)
+			Expect(ok).To(BeTrue())
+			Expect(accessLog.StatusCode).To(Equal(502))
+			Expect(accessLog.Path).To(Equal("/"))
+			Expect(accessLog.AppIndex).To(Equal(-1))
+		})
+	})
+
+	When("the message is not an access log line", func() {
+		It("returns false", func() {
+			_, ok := sharedaction.ParseRouterAccessLog("Updated app with guid some-app-guid")
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,164 @@
+package v7action
+
+import (
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// AppCrashesWithOutput is the number of most recent crashes whose output is
+// read from Log Cache.
+const AppCrashesWithOutput = 10
+
+// crashOutputWindow is how long before a crash Log Cache is searched for the
+// output of the crashed instance.
+const crashOutputWindow = 5 * time.Minute
+
+// AppCrash is a single crash of a process instance.
+type AppCrash struct {
+	Time            time.Time
+	ProcessType     string
+	Index           int
+	InstanceGUID    string
+	ExitStatus      int
+	ExitDescription string
+	Reason          string
+
+	// Output is the last lines the instance logged before crashing, oldest
+	// first. It is only read for the most recent crashes.
+	Output []sharedaction.LogMessage
+}
+
+// CrashReason groups the crashes that exited with the same description.
+type CrashReason struct {
+	ExitDescription string
+	Crashes         int
+	First           time.Time
+	Last            time.Time
+}
+
+// AppCrashes is the crash history of an app.
+type AppCrashes struct {
+	// Crashes lists every crash in the window, most recent first.
+	Crashes []AppCrash
+
+	// Reasons groups the crashes by exit description, most frequent first.
+	Reasons []CrashReason
+}
+
+// GetAppCrashesByNameAndSpace returns the crashes of the app since the given
+// time, with up to outputLines lines of output for the most recent ones.
+// Failing to read the output from Log Cache is reported as a warning.
+func (actor Actor) GetAppCrashesByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, since time.Time, outputLines int) (AppCrashes, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return AppCrashes{}, allWarnings, err
+	}
+
+	events, warnings, err := actor.CloudControllerClient.GetEvents(
+		ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{app.GUID}},
+		ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{ProcessCrashEventType}},
+		ccv3.Query{Key: ccv3.CreatedAtsGreaterThanFilter, Values: []string{since.UTC().Format(time.RFC3339)}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return AppCrashes{}, allWarnings, err
+	}
+
+	var crashes AppCrashes
+	for _, event := range events {
+		crashes.Crashes = append(crashes.Crashes, newAppCrash(event))
+	}
+
+	for i := range crashes.Crashes {
+		if i == AppCrashesWithOutput {
+			break
+		}
+
+		crash := &crashes.Crashes[i]
+		crash.Output, err = sharedaction.GetRecentLogsWithFilter(app.GUID, client, sharedaction.LogFilter{
+			Since:       crash.Time.Add(-crashOutputWindow),
+			Until:       crash.Time,
+			SourceTypes: []string{"APP/PROC"},
+			Instance:    crash.Index,
+			InstanceSet: true,
+			ProcessType: crash.ProcessType,
+			Limit:       outputLines,
+		})
+		if err != nil {
+			allWarnings = append(allWarnings, err.Error())
+			break
+		}
+	}
+
+	crashes.Reasons = groupCrashReasons(crashes.Crashes)
+	return crashes, allWarnings, nil
+}
+
+func newAppCrash(event ccv3.Event) AppCrash {
+	crash := AppCrash{
+		Time:        event.CreatedAt,
+		ProcessType: event.ActorName,
+	}
+	if crash.ProcessType == "" {
+		crash.ProcessType = constant.ProcessTypeWeb
+	}
+
+	if index, ok := event.Data["index"].(float64); ok {
+		crash.Index = int(index)
+	}
+	if exitStatus, ok := event.Data["exit_status"].(float64); ok {
+		crash.ExitStatus = int(exitStatus)
+	}
+	crash.InstanceGUID, _ = event.Data["instance"].(string)
+	crash.ExitDescription, _ = event.Data["exit_description"].(string)
+	crash.Reason, _ = event.Data["reason"].(string)
+
+	// The event is recorded after the crash; prefer the time Diego reported.
+	if timestamp, ok := event.Data["crash_timestamp"].(float64); ok && timestamp > 0 {
+		crash.Time = time.Unix(0, int64(timestamp)).UTC()
+	}
+
+	return crash
+}
+
+func groupCrashReasons(crashes []AppCrash) []CrashReason {
+	var reasons []CrashReason
+	positions := map[string]int{}
+	for _, crash := range crashes {
+		description := crash.ExitDescription
+		if description == "" {
+			description = crash.Reason
+		}
+
+		position, ok := positions[description]
+		if !ok {
+			position = len(reasons)
+			positions[description] = position
+			reasons = append(reasons, CrashReason{
+				ExitDescription: description,
+				First:           crash.Time,
+				Last:            crash.Time,
+			})
+		}
+
+		reason := &reasons[position]
+		reason.Crashes++
+		if crash.Time.Before(reason.First) {
+			reason.First = crash.Time
+		}
+		if crash.Time.After(reason.Last) {
+			reason.Last = crash.Time
+		}
+	}
+
+	sort.SliceStable(reasons, func(i, j int) bool {
+		return reasons[i].Crashes > reasons[j].Crashes
+	})
+	return reasons
+}
@@ -0,0 +1,214 @@
+package v7action_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	logcache "code.cloudfoundry.org/go-log-cache/v2"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("App Crashes Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeLogCacheClient        *sharedactionfakes.FakeLogCacheClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+	})
+
+	Describe("GetAppCrashesByNameAndSpace", func() {
+		var (
+			since     time.Time
+			crashTime time.Time
+			crashes   AppCrashes
+			warnings  Warnings
+			err       error
+		)
+
+		appLog := func(timestamp time.Time, instance string, message string) *loggregator_v2.Envelope {
+			return &loggregator_v2.Envelope{
+				Timestamp:  timestamp.UnixNano(),
+				InstanceId: instance,
+				Tags:       map[string]string{"source_type": "APP/PROC/WEB", "process_type": "web"},
+				Message: &loggregator_v2.Envelope_Log{Log: &loggregator_v2.Log{
+					Payload: []byte(message),
+					Type:    loggregator_v2.Log_ERR,
+				}},
+			}
+		}
+
+		BeforeEach(func() {
+			since = time.Date(2021, 3, 4, 5, 0, 0, 0, time.UTC)
+			crashTime = time.Date(2021, 3, 4, 5, 30, 0, 0, time.UTC)
+		})
+
+		JustBeforeEach(func() {
+			crashes, warnings, err = actor.GetAppCrashesByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient, since, 2)
+		})
+
+		When("the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-app-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetEventsReturns(
+					[]ccv3.Event{
+						{
+							CreatedAt: crashTime.Add(5 * time.Second),
+							ActorName: "web",
+							Data: map[string]interface{}{
+								"index":            float64(1),
+								"instance":         "instance-guid-1",
+								"exit_status":      float64(137),
+								"exit_description": "APP/PROC/WEB: Exited with status 137 (out of memory)",
+								"reason":           "CRASHED",
+								"crash_timestamp":  float64(crashTime.UnixNano()),
+							},
+						},
+						{
+							CreatedAt: crashTime.Add(-10 * time.Minute),
+							ActorName: "worker",
+							Data: map[string]interface{}{
+								"index":            float64(0),
+								"exit_status":      float64(1),
+								"exit_description": "APP/PROC/WORKER: Exited with status 1",
+								"reason":           "CRASHED",
+							},
+						},
+						{
+							CreatedAt: crashTime.Add(-20 * time.Minute),
+							Data: map[string]interface{}{
+								"index":            float64(1),
+								"exit_status":      float64(137),
+								"exit_description": "APP/PROC/WEB: Exited with status 137 (out of memory)",
+								"reason":           "CRASHED",
+							},
+						},
+					},
+					ccv3.Warnings{"events-warning"},
+					nil,
+				)
+
+				fakeLogCacheClient.ReadStub = func(_ context.Context, _ string, _ time.Time, _ ...logcache.ReadOption) ([]*loggregator_v2.Envelope, error) {
+					// Log Cache returns the newest logs first.
+					return []*loggregator_v2.Envelope{
+						appLog(crashTime.Add(time.Second), "1", "after the crash"),
+						appLog(crashTime.Add(-time.Second), "1", "fatal error: out of memory"),
+						appLog(crashTime.Add(-2*time.Second), "0", "other instance"),
+						appLog(crashTime.Add(-3*time.Second), "1", "allocating"),
+						appLog(crashTime.Add(-4*time.Second), "1", "starting"),
+					}, nil
+				}
+			})
+
+			It("returns the crash events most recent first", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warning", "events-warning"))
+
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"some-app-guid"}},
+					ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{ProcessCrashEventType}},
+					ccv3.Query{Key: ccv3.CreatedAtsGreaterThanFilter, Values: []string{"2021-03-04T05:00:00Z"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+
+				Expect(crashes.Crashes).To(HaveLen(3))
+				Expect(crashes.Crashes[0].Time).To(Equal(crashTime))
+				Expect(crashes.Crashes[0].ProcessType).To(Equal("web"))
+				Expect(crashes.Crashes[0].Index).To(Equal(1))
+				Expect(crashes.Crashes[0].InstanceGUID).To(Equal("instance-guid-1"))
+				Expect(crashes.Crashes[0].ExitStatus).To(Equal(137))
+				Expect(crashes.Crashes[0].ExitDescription).To(Equal("APP/PROC/WEB: Exited with status 137 (out of memory)"))
+				Expect(crashes.Crashes[0].Reason).To(Equal("CRASHED"))
+
+				Expect(crashes.Crashes[1].ProcessType).To(Equal("worker"))
+				Expect(crashes.Crashes[1].Time).To(Equal(crashTime.Add(-10 * time.Minute)))
+				Expect(crashes.Crashes[2].ProcessType).To(Equal("web"))
+			})
+
+			It("reads the last lines the crashed instance logged", func() {
+				Expect(crashes.Crashes[0].Output).To(HaveLen(2))
+				Expect(crashes.Crashes[0].Output[0].Message()).To(Equal("allocating"))
+				Expect(crashes.Crashes[0].Output[1].Message()).To(Equal("fatal error: out of memory"))
+
+				Expect(crashes.Crashes[1].Output).To(BeEmpty())
+			})
+
+			It("groups the crashes by exit description", func() {
+				Expect(crashes.Reasons).To(Equal([]CrashReason{
+					{
+						ExitDescription: "APP/PROC/WEB: Exited with status 137 (out of memory)",
+						Crashes:         2,
+						First:           crashTime.Add(-20 * time.Minute),
+						Last:            crashTime,
+					},
+					{
+						ExitDescription: "APP/PROC/WORKER: Exited with status 1",
+						Crashes:         1,
+						First:           crashTime.Add(-10 * time.Minute),
+						Last:            crashTime.Add(-10 * time.Minute),
+					},
+				}))
+			})
+
+			When("reading from Log Cache fails", func() {
+				BeforeEach(func() {
+					fakeLogCacheClient.ReadStub = nil
+					fakeLogCacheClient.ReadReturns(nil, errors.New("log-cache-error"))
+				})
+
+				It("returns the crashes without output and a warning", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ContainElement(ContainSubstring("log-cache-error")))
+					Expect(crashes.Crashes).To(HaveLen(3))
+					Expect(crashes.Crashes[0].Output).To(BeEmpty())
+					Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		When("getting the events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-app-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"events-warning"}, errors.New("events-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("events-error"))
+				Expect(warnings).To(ConsistOf("some-app-warning", "events-warning"))
+			})
+		})
+
+		When("the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(err).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
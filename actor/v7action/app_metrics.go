@@ -0,0 +1,187 @@
+package v7action
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// MaxAppMetricsSteps is the largest number of steps a metrics window is split
+// into.
+const MaxAppMetricsSteps = 1000
+
+// AppMetricsQuery selects the process and the time window of the container
+// metrics to summarise.
+type AppMetricsQuery struct {
+	ProcessType string
+	Since       time.Time
+	Until       time.Time
+
+	// Step splits the window into buckets whose averages are returned in
+	// MetricSummary.Steps. A zero step returns no buckets. Steps that would
+	// split the window into more than MaxAppMetricsSteps buckets are widened.
+	Step time.Duration
+}
+
+// MetricSummary summarises the samples of one metric of one instance.
+type MetricSummary struct {
+	Samples int
+	Min     float64
+	Avg     float64
+	Max     float64
+
+	// Steps holds the average of the samples in each step of the window,
+	// oldest first. Steps without samples are not set.
+	Steps []types.NullFloat64
+}
+
+// InstanceMetrics summarises the container metrics of a process instance.
+type InstanceMetrics struct {
+	Index   int
+	CPU     MetricSummary
+	Memory  MetricSummary
+	Disk    MetricSummary
+	LogRate MetricSummary
+}
+
+// GetApplicationMetricsByNameAndSpace returns a summary of the cpu, memory,
+// disk and log rate of each instance of the app's process, ordered by
+// instance index.
+func (actor Actor) GetApplicationMetricsByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, query AppMetricsQuery) ([]InstanceMetrics, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	metrics, err := sharedaction.GetContainerMetrics(app.GUID, client, query.Since, query.Until)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	steps := 0
+	stepWidth := query.Step
+	if stepWidth > 0 {
+		window := float64(query.Until.Sub(query.Since))
+		if window/float64(stepWidth) > MaxAppMetricsSteps {
+			stepWidth = time.Duration(math.Ceil(window / MaxAppMetricsSteps))
+		}
+		steps = int(math.Ceil(window / float64(stepWidth)))
+	}
+
+	accumulators := map[int]*instanceMetricsAccumulator{}
+	for _, metric := range metrics {
+		if !strings.EqualFold(metric.ProcessType, query.ProcessType) {
+			continue
+		}
+
+		index, err := strconv.Atoi(metric.InstanceID)
+		if err != nil {
+			continue
+		}
+
+		accumulator, ok := accumulators[index]
+		if !ok {
+			accumulator = newInstanceMetricsAccumulator(steps)
+			accumulators[index] = accumulator
+		}
+
+		step := -1
+		if steps > 0 {
+			step = int(metric.Timestamp.Sub(query.Since) / stepWidth)
+			if step < 0 || step >= steps {
+				step = -1
+			}
+		}
+		accumulator.add(metric.Name, metric.Value, step)
+	}
+
+	var instances []InstanceMetrics
+	for index, accumulator := range accumulators {
+		instances = append(instances, InstanceMetrics{
+			Index:   index,
+			CPU:     accumulator.metrics[sharedaction.ContainerMetricCPU].summary(),
+			Memory:  accumulator.metrics[sharedaction.ContainerMetricMemory].summary(),
+			Disk:    accumulator.metrics[sharedaction.ContainerMetricDisk].summary(),
+			LogRate: accumulator.metrics[sharedaction.ContainerMetricLogRate].summary(),
+		})
+	}
+	sort.Slice(instances, func(i, j int) bool {
+		return instances[i].Index < instances[j].Index
+	})
+
+	return instances, allWarnings, nil
+}
+
+type instanceMetricsAccumulator struct {
+	metrics map[string]*metricAccumulator
+}
+
+func newInstanceMetricsAccumulator(steps int) *instanceMetricsAccumulator {
+	accumulator := &instanceMetricsAccumulator{metrics: map[string]*metricAccumulator{}}
+	for _, name := range []string{
+		sharedaction.ContainerMetricCPU,
+		sharedaction.ContainerMetricMemory,
+		sharedaction.ContainerMetricDisk,
+		sharedaction.ContainerMetricLogRate,
+	} {
+		accumulator.metrics[name] = &metricAccumulator{
+			stepSums:   make([]float64, steps),
+			stepCounts: make([]int, steps),
+		}
+	}
+	return accumulator
+}
+
+func (accumulator *instanceMetricsAccumulator) add(name string, value float64, step int) {
+	metric, ok := accumulator.metrics[name]
+	if !ok {
+		return
+	}
+
+	if metric.count == 0 || value < metric.min {
+		metric.min = value
+	}
+	if metric.count == 0 || value > metric.max {
+		metric.max = value
+	}
+	metric.sum += value
+	metric.count++
+
+	if step >= 0 {
+		metric.stepSums[step] += value
+		metric.stepCounts[step]++
+	}
+}
+
+type metricAccumulator struct {
+	count      int
+	min        float64
+	max        float64
+	sum        float64
+	stepSums   []float64
+	stepCounts []int
+}
+
+func (metric *metricAccumulator) summary() MetricSummary {
+	summary := MetricSummary{
+		Samples: metric.count,
+		Min:     metric.min,
+		Max:     metric.max,
+		Steps:   make([]types.NullFloat64, len(metric.stepSums)),
+	}
+	if metric.count > 0 {
+		summary.Avg = metric.sum / float64(metric.count)
+	}
+
+	for i, sum := range metric.stepSums {
+		if metric.stepCounts[i] > 0 {
+			summary.Steps[i] = types.NullFloat64{IsSet: true, Value: sum / float64(metric.stepCounts[i])}
+		}
+	}
+	return summary
+}
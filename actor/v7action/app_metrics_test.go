@@ -0,0 +1,164 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("App Metrics Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeLogCacheClient        *sharedactionfakes.FakeLogCacheClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+	})
+
+	Describe("GetApplicationMetricsByNameAndSpace", func() {
+		var (
+			query     AppMetricsQuery
+			instances []InstanceMetrics
+			warnings  Warnings
+			err       error
+		)
+
+		gaugeEnvelope := func(timestamp time.Time, instanceID string, processType string, name string, value float64) *loggregator_v2.Envelope {
+			return &loggregator_v2.Envelope{
+				Timestamp:  timestamp.UnixNano(),
+				InstanceId: instanceID,
+				Tags:       map[string]string{"process_type": processType},
+				Message: &loggregator_v2.Envelope_Gauge{Gauge: &loggregator_v2.Gauge{
+					Metrics: map[string]*loggregator_v2.GaugeValue{name: {Value: value}},
+				}},
+			}
+		}
+
+		BeforeEach(func() {
+			until := time.Unix(3600, 0)
+			query = AppMetricsQuery{
+				ProcessType: "web",
+				Since:       until.Add(-3 * time.Minute),
+				Until:       until,
+				Step:        time.Minute,
+			}
+		})
+
+		JustBeforeEach(func() {
+			instances, warnings, err = actor.GetApplicationMetricsByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient, query)
+		})
+
+		When("the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-app-warning"},
+					nil,
+				)
+
+				fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+					gaugeEnvelope(query.Since.Add(10*time.Second), "1", "web", "cpu", 4),
+					gaugeEnvelope(query.Since.Add(20*time.Second), "0", "web", "cpu", 1),
+					gaugeEnvelope(query.Since.Add(30*time.Second), "0", "web", "memory", 100),
+					gaugeEnvelope(query.Since.Add(70*time.Second), "0", "web", "cpu", 3),
+					gaugeEnvelope(query.Since.Add(130*time.Second), "0", "web", "cpu", 2),
+					gaugeEnvelope(query.Since.Add(140*time.Second), "0", "web", "cpu", 6),
+					gaugeEnvelope(query.Since.Add(150*time.Second), "0", "worker", "cpu", 99),
+				}, nil)
+			})
+
+			It("summarises the metrics of each instance of the process", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+
+				_, sourceID, _, _ := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+
+				Expect(instances).To(HaveLen(2))
+				Expect(instances[0].Index).To(Equal(0))
+				Expect(instances[0].CPU.Samples).To(Equal(4))
+				Expect(instances[0].CPU.Min).To(Equal(1.0))
+				Expect(instances[0].CPU.Avg).To(Equal(3.0))
+				Expect(instances[0].CPU.Max).To(Equal(6.0))
+				Expect(instances[0].CPU.Steps).To(Equal([]types.NullFloat64{
+					{IsSet: true, Value: 1},
+					{IsSet: true, Value: 3},
+					{IsSet: true, Value: 4},
+				}))
+				Expect(instances[0].Memory.Samples).To(Equal(1))
+				Expect(instances[0].Memory.Steps).To(Equal([]types.NullFloat64{{IsSet: true, Value: 100}, {}, {}}))
+				Expect(instances[0].Disk.Samples).To(Equal(0))
+
+				Expect(instances[1].Index).To(Equal(1))
+				Expect(instances[1].CPU.Max).To(Equal(4.0))
+			})
+
+			When("there is no step", func() {
+				BeforeEach(func() {
+					query.Step = 0
+				})
+
+				It("summarises the metrics without steps", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(instances[0].CPU.Avg).To(Equal(3.0))
+					Expect(instances[0].CPU.Steps).To(BeEmpty())
+					Expect(instances[0].Memory.Steps).To(BeEmpty())
+				})
+			})
+
+			When("the step would split the window into too many steps", func() {
+				BeforeEach(func() {
+					query.Step = time.Nanosecond
+				})
+
+				It("widens the steps", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(instances[0].CPU.Steps).To(HaveLen(MaxAppMetricsSteps))
+					Expect(instances[0].CPU.Steps[0]).To(Equal(types.NullFloat64{}))
+					Expect(instances[0].CPU.Steps[MaxAppMetricsSteps*20/180]).To(Equal(types.NullFloat64{IsSet: true, Value: 1}))
+				})
+			})
+		})
+
+		When("reading the metrics fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-app-warning"},
+					nil,
+				)
+				fakeLogCacheClient.ReadReturns(nil, errors.New("read-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("Failed to retrieve metrics from Log Cache: read-error"))
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+			})
+		})
+
+		When("the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and warnings", func() {
+				Expect(err).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,180 @@
+package v7action
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+)
+
+// appRequestsLogLimit caps the number of router access logs read for a
+// request summary. When more requests were made in the window, only the most
+// recent ones are summarised.
+const appRequestsLogLimit = 10000
+
+// appRequestsTopPaths is the number of paths listed in a RequestSummary.
+const appRequestsTopPaths = 5
+
+// PathRequests is the number of requests made to a path.
+type PathRequests struct {
+	Path     string
+	Requests int
+}
+
+// RequestSummary aggregates router access logs.
+type RequestSummary struct {
+	Requests int
+
+	// Rate is the number of requests per second over the summarised window.
+	Rate float64
+
+	// StatusCodes counts the requests by response status code.
+	StatusCodes map[int]int
+
+	P50 time.Duration
+	P95 time.Duration
+	P99 time.Duration
+
+	// TopPaths lists the most requested paths, most requested first.
+	TopPaths []PathRequests
+}
+
+// StatusClassCount returns the number of requests whose status code is in
+// the given class, for example 5 for 5xx responses.
+func (summary RequestSummary) StatusClassCount(class int) int {
+	var count int
+	for code, requests := range summary.StatusCodes {
+		if code/100 == class {
+			count += requests
+		}
+	}
+	return count
+}
+
+// InstanceRequests summarises the requests served by one app instance.
+type InstanceRequests struct {
+	Index int
+	RequestSummary
+}
+
+// AppRequests summarises the requests routed to an app between Since and
+// Until. Requests gorouter failed to route to an instance are only counted
+// in Total.
+type AppRequests struct {
+	Since     time.Time
+	Until     time.Time
+	Total     RequestSummary
+	Instances []InstanceRequests
+
+	// Truncated is true when there were too many requests to summarise them
+	// all; Since is then the time of the oldest summarised request.
+	Truncated bool
+}
+
+// GetAppRequestsByNameAndSpace summarises the gorouter access logs of the
+// app since the given time.
+func (actor Actor) GetAppRequestsByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, since time.Time) (AppRequests, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return AppRequests{}, allWarnings, err
+	}
+
+	until := time.Now()
+	logMessages, err := sharedaction.GetRecentLogsWithFilter(app.GUID, client, sharedaction.LogFilter{
+		Since:       since,
+		Until:       until,
+		SourceTypes: []string{sharedaction.RouterLog},
+		Limit:       appRequestsLogLimit,
+	})
+	if err != nil {
+		return AppRequests{}, allWarnings, err
+	}
+
+	requests := AppRequests{Since: since, Until: until}
+	if len(logMessages) == appRequestsLogLimit {
+		requests.Since = logMessages[0].Timestamp()
+		requests.Truncated = true
+	}
+
+	var all []sharedaction.RouterAccessLog
+	byInstance := map[int][]sharedaction.RouterAccessLog{}
+	for _, logMessage := range logMessages {
+		accessLog, ok := sharedaction.ParseRouterAccessLog(logMessage.Message())
+		if !ok {
+			continue
+		}
+
+		all = append(all, accessLog)
+		if accessLog.AppIndex >= 0 {
+			byInstance[accessLog.AppIndex] = append(byInstance[accessLog.AppIndex], accessLog)
+		}
+	}
+
+	window := requests.Until.Sub(requests.Since)
+	requests.Total = summariseRequests(all, window)
+	for index, accessLogs := range byInstance {
+		requests.Instances = append(requests.Instances, InstanceRequests{
+			Index:          index,
+			RequestSummary: summariseRequests(accessLogs, window),
+		})
+	}
+	sort.Slice(requests.Instances, func(i, j int) bool {
+		return requests.Instances[i].Index < requests.Instances[j].Index
+	})
+
+	return requests, allWarnings, nil
+}
+
+func summariseRequests(accessLogs []sharedaction.RouterAccessLog, window time.Duration) RequestSummary {
+	summary := RequestSummary{
+		Requests:    len(accessLogs),
+		StatusCodes: map[int]int{},
+	}
+	if window > 0 {
+		summary.Rate = float64(len(accessLogs)) / window.Seconds()
+	}
+
+	var responseTimes []time.Duration
+	pathCounts := map[string]int{}
+	for _, accessLog := range accessLogs {
+		summary.StatusCodes[accessLog.StatusCode]++
+		responseTimes = append(responseTimes, accessLog.ResponseTime)
+		pathCounts[accessLog.Path]++
+	}
+
+	sort.Slice(responseTimes, func(i, j int) bool {
+		return responseTimes[i] < responseTimes[j]
+	})
+	summary.P50 = percentile(responseTimes, 0.50)
+	summary.P95 = percentile(responseTimes, 0.95)
+	summary.P99 = percentile(responseTimes, 0.99)
+
+	for path, requests := range pathCounts {
+		summary.TopPaths = append(summary.TopPaths, PathRequests{Path: path, Requests: requests})
+	}
+	sort.Slice(summary.TopPaths, func(i, j int) bool {
+		if summary.TopPaths[i].Requests != summary.TopPaths[j].Requests {
+			return summary.TopPaths[i].Requests > summary.TopPaths[j].Requests
+		}
+		return summary.TopPaths[i].Path < summary.TopPaths[j].Path
+	})
+	if len(summary.TopPaths) > appRequestsTopPaths {
+		summary.TopPaths = summary.TopPaths[:appRequestsTopPaths]
+	}
+
+	return summary
+}
+
+// percentile returns the nearest-rank percentile of the sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
@@ -0,0 +1,143 @@
+package v7action_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	logcache "code.cloudfoundry.org/go-log-cache/v2"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("App Requests Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeLogCacheClient        *sharedactionfakes.FakeLogCacheClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+	})
+
+	Describe("GetAppRequestsByNameAndSpace", func() {
+		var (
+			since    time.Time
+			requests AppRequests
+			warnings Warnings
+			err      error
+		)
+
+		accessLog := func(timestamp time.Time, path string, status int, responseTime string, appIndex string) *loggregator_v2.Envelope {
+			return &loggregator_v2.Envelope{
+				Timestamp: timestamp.UnixNano(),
+				Tags:      map[string]string{"source_type": "RTR"},
+				Message: &loggregator_v2.Envelope_Log{Log: &loggregator_v2.Log{
+					Payload: []byte(fmt.Sprintf(`app.example.com - [2021-03-04T05:06:07Z] "GET %s HTTP/1.1" %d 0 12 "-" "curl" "10.0.0.1:1234" "10.0.1.2:61000" response_time:%s app_index:"%s"`, path, status, responseTime, appIndex)),
+					Type:    loggregator_v2.Log_OUT,
+				}},
+			}
+		}
+
+		BeforeEach(func() {
+			since = time.Now().Add(-100 * time.Second)
+		})
+
+		JustBeforeEach(func() {
+			requests, warnings, err = actor.GetAppRequestsByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient, since)
+		})
+
+		When("the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-app-warning"},
+					nil,
+				)
+
+				fakeLogCacheClient.ReadStub = func(_ context.Context, _ string, _ time.Time, _ ...logcache.ReadOption) ([]*loggregator_v2.Envelope, error) {
+					base := since.Add(time.Second)
+					// Log Cache returns the newest logs first.
+					return []*loggregator_v2.Envelope{
+						accessLog(base.Add(6*time.Second), "/", 502, "0.500", "-"),
+						accessLog(base.Add(5*time.Second), "/health", 200, "0.001", "1"),
+						accessLog(base.Add(4*time.Second), "/api?page=2", 500, "0.400", "0"),
+						accessLog(base.Add(3*time.Second), "/api", 404, "0.030", "0"),
+						accessLog(base.Add(2*time.Second), "/api", 200, "0.020", "0"),
+						accessLog(base.Add(1*time.Second), "/api", 200, "0.010", "0"),
+					}, nil
+				}
+			})
+
+			It("summarises the requests of the app and of each instance", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+				Expect(requests.Since).To(Equal(since))
+				Expect(requests.Until).To(BeTemporally("~", time.Now(), time.Second))
+				Expect(requests.Truncated).To(BeFalse())
+
+				Expect(requests.Total.Requests).To(Equal(6))
+				Expect(requests.Total.Rate).To(BeNumerically("~", 0.06, 0.001))
+				Expect(requests.Total.StatusCodes).To(Equal(map[int]int{200: 3, 404: 1, 500: 1, 502: 1}))
+				Expect(requests.Total.StatusClassCount(5)).To(Equal(2))
+				Expect(requests.Total.P50).To(Equal(20 * time.Millisecond))
+				Expect(requests.Total.P95).To(Equal(500 * time.Millisecond))
+				Expect(requests.Total.TopPaths).To(Equal([]PathRequests{
+					{Path: "/api", Requests: 4},
+					{Path: "/", Requests: 1},
+					{Path: "/health", Requests: 1},
+				}))
+
+				Expect(requests.Instances).To(HaveLen(2))
+				Expect(requests.Instances[0].Index).To(Equal(0))
+				Expect(requests.Instances[0].Requests).To(Equal(4))
+				Expect(requests.Instances[0].P99).To(Equal(400 * time.Millisecond))
+				Expect(requests.Instances[1].Index).To(Equal(1))
+				Expect(requests.Instances[1].TopPaths).To(Equal([]PathRequests{{Path: "/health", Requests: 1}}))
+			})
+
+			It("only reads router logs", func() {
+				_, sourceID, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+				Expect(start).To(Equal(since))
+			})
+		})
+
+		When("reading the logs fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-app-warning"},
+					nil,
+				)
+				fakeLogCacheClient.ReadReturns(nil, errors.New("read-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("Failed to retrieve logs from Log Cache: read-error"))
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+			})
+		})
+
+		When("the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and warnings", func() {
+				Expect(err).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+			})
+		})
+	})
+})
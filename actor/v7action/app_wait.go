@@ -0,0 +1,168 @@
+package v7action
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// AppWaitCondition is the state WaitForApplication waits for an app to
+// reach.
+type AppWaitCondition struct {
+	// ProcessType is the process whose instances are checked.
+	ProcessType string
+
+	// State is either constant.ProcessInstanceRunning or
+	// constant.ProcessInstanceDown.
+	State constant.ProcessInstanceState
+
+	// Instances is the number of instances that must be running. Zero waits
+	// for all of the instances of the process. It is ignored when waiting
+	// for the instances to be down.
+	Instances int
+
+	// DeploymentStatus, when set, also waits for the most recent deployment
+	// of the app to have this status reason.
+	DeploymentStatus constant.DeploymentStatusReason
+}
+
+// WaitForApplication polls the app until it meets the condition. When the
+// timeout is reached first, it returns an AppWaitTimeoutError explaining
+// which instances, or which deployment, had not reached the awaited state.
+func (actor Actor) WaitForApplication(app resources.Application, condition AppWaitCondition, timeout time.Duration) (Warnings, error) {
+	var (
+		allWarnings Warnings
+		reasons     []string
+	)
+
+	timer := actor.Clock.NewTimer(time.Millisecond)
+	defer timer.Stop()
+	timeoutChan := actor.Clock.After(timeout)
+
+	for {
+		select {
+		case <-timeoutChan:
+			return allWarnings, actionerror.AppWaitTimeoutError{Name: app.Name, Reasons: reasons}
+		case <-timer.C():
+			var (
+				warnings Warnings
+				err      error
+			)
+			reasons, warnings, err = actor.unmetAppWaitConditions(app, condition)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil || len(reasons) == 0 {
+				return allWarnings, err
+			}
+
+			timer.Reset(actor.Config.PollingInterval())
+		}
+	}
+}
+
+// unmetAppWaitConditions returns one line for every part of the condition
+// the app does not meet yet.
+func (actor Actor) unmetAppWaitConditions(app resources.Application, condition AppWaitCondition) ([]string, Warnings, error) {
+	var (
+		allWarnings Warnings
+		reasons     []string
+	)
+
+	if condition.DeploymentStatus != "" {
+		reason, warnings, err := actor.unmetDeploymentStatus(app.GUID, condition.DeploymentStatus)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		if reason != "" {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	process, warnings, err := actor.CloudControllerClient.GetApplicationProcessByType(app.GUID, condition.ProcessType)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.ProcessNotFoundError); ok {
+			return nil, allWarnings, actionerror.ProcessNotFoundError{ProcessType: condition.ProcessType}
+		}
+		return nil, allWarnings, err
+	}
+
+	ccInstances, warnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+	instances := ProcessInstances(ccInstances)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if condition.State == constant.ProcessInstanceDown {
+		for _, instance := range instances {
+			if instance.State != constant.ProcessInstanceDown {
+				reasons = append(reasons, describeWaitingInstance(instance))
+			}
+		}
+		return reasons, allWarnings, nil
+	}
+
+	// Like PollProcesses, stop waiting once every instance has crashed.
+	if !instances.Empty() && instances.AllCrashed() {
+		return nil, allWarnings, actionerror.AllInstancesCrashedError{}
+	}
+
+	wanted := condition.Instances
+	if wanted == 0 {
+		wanted = process.Instances.Value
+	}
+
+	if running := instances.RunningCount(); running < wanted {
+		reasons = append(reasons, fmt.Sprintf("%d of %d instances of process %s running", running, wanted, condition.ProcessType))
+		for _, instance := range instances {
+			if instance.State != constant.ProcessInstanceRunning {
+				reasons = append(reasons, describeWaitingInstance(instance))
+			}
+		}
+	}
+
+	return reasons, allWarnings, nil
+}
+
+func (actor Actor) unmetDeploymentStatus(appGUID string, status constant.DeploymentStatusReason) (string, Warnings, error) {
+	deployments, warnings, err := actor.CloudControllerClient.GetDeployments(
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+		ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+	)
+	if err != nil {
+		return "", Warnings(warnings), err
+	}
+
+	if len(deployments) == 0 {
+		return "no deployment found", Warnings(warnings), nil
+	}
+
+	deployment := deployments[0]
+	if deployment.StatusReason == status {
+		return "", Warnings(warnings), nil
+	}
+
+	// A finalized deployment never changes status again.
+	if deployment.StatusValue == constant.DeploymentStatusValueFinalized {
+		return "", Warnings(warnings), fmt.Errorf("Deployment %s finished with status %s instead of %s", deployment.GUID, deployment.StatusReason, status)
+	}
+
+	return fmt.Sprintf("deployment %s is %s", deployment.GUID, strings.ToLower(string(deployment.StatusReason))), Warnings(warnings), nil
+}
+
+func describeWaitingInstance(instance ccv3.ProcessInstance) string {
+	description := fmt.Sprintf("instance #%d is %s", instance.Index, strings.ToLower(string(instance.State)))
+	if instance.Details != "" {
+		description += ": " + instance.Details
+	}
+	return description
+}
@@ -0,0 +1,306 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("App Wait Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
+		fakeClock                 *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, fakeConfig, _, _, _, fakeClock = NewTestActor()
+		fakeConfig.PollingIntervalReturns(1 * time.Second)
+	})
+
+	Describe("WaitForApplication", func() {
+		var (
+			app       resources.Application
+			condition AppWaitCondition
+			timeout   time.Duration
+
+			done       chan bool
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			app = resources.Application{Name: "some-app", GUID: "some-app-guid"}
+			condition = AppWaitCondition{ProcessType: "web", State: constant.ProcessInstanceRunning}
+			timeout = 2 * time.Millisecond
+			done = make(chan bool)
+
+			fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+				resources.Process{GUID: "process-guid", Type: "web", Instances: types.NullInt{IsSet: true, Value: 2}},
+				ccv3.Warnings{"process-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			go func() {
+				defer close(done)
+				warnings, executeErr = actor.WaitForApplication(app, condition, timeout)
+				done <- true
+			}()
+		})
+
+		When("every instance of the process is running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns(
+					[]ccv3.ProcessInstance{
+						{Index: 0, State: constant.ProcessInstanceRunning},
+						{Index: 1, State: constant.ProcessInstanceRunning},
+					},
+					ccv3.Warnings{"instances-warning"},
+					nil,
+				)
+			})
+
+			It("returns once the condition is met", func() {
+				fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("process-warning", "instances-warning"))
+
+				appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(processType).To(Equal("web"))
+				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("process-guid"))
+				Expect(fakeCloudControllerClient.GetDeploymentsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("fewer instances than expected are running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns(
+					[]ccv3.ProcessInstance{
+						{Index: 0, State: constant.ProcessInstanceRunning},
+						{Index: 1, State: constant.ProcessInstanceCrashed, Details: "insufficient resources: memory"},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("times out explaining each instance that is not running", func() {
+				fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+				fakeClock.Increment(1 * time.Millisecond)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError(actionerror.AppWaitTimeoutError{
+					Name: "some-app",
+					Reasons: []string{
+						"1 of 2 instances of process web running",
+						"instance #1 is crashed: insufficient resources: memory",
+					},
+				}))
+			})
+
+			When("only some instances are required", func() {
+				BeforeEach(func() {
+					condition.Instances = 1
+				})
+
+				It("returns once that many are running", func() {
+					fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).ToNot(HaveOccurred())
+				})
+			})
+		})
+
+		When("every instance of the process has crashed", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns(
+					[]ccv3.ProcessInstance{
+						{Index: 0, State: constant.ProcessInstanceCrashed},
+						{Index: 1, State: constant.ProcessInstanceCrashed},
+					},
+					ccv3.Warnings{"instances-warning"},
+					nil,
+				)
+			})
+
+			It("fails without waiting for the timeout", func() {
+				fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError(actionerror.AllInstancesCrashedError{}))
+				Expect(warnings).To(ConsistOf("process-warning", "instances-warning"))
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(1))
+			})
+
+			When("waiting for the instances to be down", func() {
+				BeforeEach(func() {
+					condition.State = constant.ProcessInstanceDown
+				})
+
+				It("keeps waiting", func() {
+					fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+					fakeClock.Increment(1 * time.Millisecond)
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).To(BeAssignableToTypeOf(actionerror.AppWaitTimeoutError{}))
+				})
+			})
+		})
+
+		When("waiting for the instances to be down", func() {
+			BeforeEach(func() {
+				condition.State = constant.ProcessInstanceDown
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0,
+					[]ccv3.ProcessInstance{
+						{Index: 0, State: constant.ProcessInstanceDown},
+						{Index: 1, State: constant.ProcessInstanceRunning},
+					},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1,
+					[]ccv3.ProcessInstance{
+						{Index: 0, State: constant.ProcessInstanceDown},
+						{Index: 1, State: constant.ProcessInstanceDown},
+					},
+					nil,
+					nil,
+				)
+				timeout = time.Minute
+			})
+
+			It("polls until no instance is up", func() {
+				fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+				Eventually(fakeCloudControllerClient.GetProcessInstancesCallCount).Should(Equal(1))
+				fakeClock.WaitForNWatchersAndIncrement(1*time.Second, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(2))
+			})
+		})
+
+		When("waiting for a deployment status", func() {
+			BeforeEach(func() {
+				condition.DeploymentStatus = constant.DeploymentStatusReasonDeployed
+				fakeCloudControllerClient.GetProcessInstancesReturns(
+					[]ccv3.ProcessInstance{
+						{Index: 0, State: constant.ProcessInstanceRunning},
+						{Index: 1, State: constant.ProcessInstanceRunning},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			When("the latest deployment is still deploying", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetDeploymentsReturns(
+						[]resources.Deployment{{GUID: "deployment-guid", StatusValue: constant.DeploymentStatusValueActive, StatusReason: constant.DeploymentStatusReasonDeploying}},
+						ccv3.Warnings{"deployments-warning"},
+						nil,
+					)
+				})
+
+				It("times out naming the deployment", func() {
+					fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+					fakeClock.Increment(1 * time.Millisecond)
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).To(MatchError(actionerror.AppWaitTimeoutError{
+						Name:    "some-app",
+						Reasons: []string{"deployment deployment-guid is deploying"},
+					}))
+					Expect(warnings).To(ContainElement("deployments-warning"))
+
+					Expect(fakeCloudControllerClient.GetDeploymentsArgsForCall(0)).To(ConsistOf(
+						ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+						ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+						ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+						ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+					))
+				})
+			})
+
+			When("the latest deployment has the status", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetDeploymentsReturns(
+						[]resources.Deployment{{GUID: "deployment-guid", StatusValue: constant.DeploymentStatusValueFinalized, StatusReason: constant.DeploymentStatusReasonDeployed}},
+						nil,
+						nil,
+					)
+				})
+
+				It("returns once the instances are running too", func() {
+					fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).ToNot(HaveOccurred())
+				})
+			})
+
+			When("the latest deployment finished with another status", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetDeploymentsReturns(
+						[]resources.Deployment{{GUID: "deployment-guid", StatusValue: constant.DeploymentStatusValueFinalized, StatusReason: constant.DeploymentStatusReasonCanceled}},
+						nil,
+						nil,
+					)
+				})
+
+				It("fails without waiting for the timeout", func() {
+					fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).To(MatchError("Deployment deployment-guid finished with status CANCELED instead of DEPLOYED"))
+				})
+			})
+		})
+
+		When("the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(resources.Process{}, ccv3.Warnings{"process-warning"}, ccerror.ProcessNotFoundError{})
+			})
+
+			It("returns a ProcessNotFoundError", func() {
+				fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "web"}))
+				Expect(warnings).To(ConsistOf("process-warning"))
+			})
+		})
+
+		When("getting the instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"instances-warning"}, errors.New("instances-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError("instances-error"))
+				Expect(warnings).To(ConsistOf("process-warning", "instances-warning"))
+			})
+		})
+	})
+})
@@ -25,7 +25,7 @@ func NewDefaultAuthActor(config Config, uaaClient UAAClient) AuthActor {
 }
 
 func (actor defaultAuthActor) Authenticate(credentials map[string]string, origin string, grantType constant.GrantType) error {
-	if (grantType == constant.GrantTypePassword || grantType == constant.GrantTypeJwtBearer) && actor.config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
+	if (grantType == constant.GrantTypePassword || grantType == constant.GrantTypeAuthorizationCode || grantType == constant.GrantTypeDeviceCode || grantType == constant.GrantTypeJwtBearer) && actor.config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
 		return actionerror.PasswordGrantTypeLogoutRequiredError{}
 	}
 
@@ -39,7 +39,7 @@ func (actor defaultAuthActor) Authenticate(credentials map[string]string, origin
 	accessToken = fmt.Sprintf("bearer %s", accessToken)
 	actor.config.SetTokenInformation(accessToken, refreshToken, "")
 
-	if grantType == constant.GrantTypePassword {
+	if grantType == constant.GrantTypePassword || grantType == constant.GrantTypeAuthorizationCode || grantType == constant.GrantTypeDeviceCode {
 		actor.config.SetUAAGrantType("")
 	} else {
 		actor.config.SetUAAGrantType(string(grantType))
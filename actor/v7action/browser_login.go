@@ -0,0 +1,140 @@
+package v7action
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
+
+// BrowserLoginTimeout is how long FinishBrowserLogin waits for the browser to
+// come back with an authorization code.
+const BrowserLoginTimeout = 5 * time.Minute
+
+const (
+	browserLoginCallbackPath = "/callback"
+	browserLoginSuccessPage  = "<html><body><p>Login successful. You can close this window and return to the CLI.</p></body></html>"
+	browserLoginFailurePage  = "<html><body><p>Login failed. You can close this window and return to the CLI.</p></body></html>"
+)
+
+// BrowserLogin is an authorization code login started by StartBrowserLogin.
+// URL is the page the user opens in a browser to log in.
+type BrowserLogin struct {
+	URL string
+
+	listener     net.Listener
+	redirectURI  string
+	state        string
+	codeVerifier string
+}
+
+type browserLoginResult struct {
+	code string
+	err  error
+}
+
+// StartBrowserLogin listens on a loopback port for the redirect of the
+// authorization endpoint and returns the URL to open in a browser. The login
+// uses PKCE, so the authorization code is of no use to anyone who does not
+// also hold the code verifier kept in the returned BrowserLogin.
+func (actor Actor) StartBrowserLogin(origin string) (BrowserLogin, error) {
+	codeVerifier, err := randomURLSafeString(32)
+	if err != nil {
+		return BrowserLogin{}, err
+	}
+
+	state, err := randomURLSafeString(16)
+	if err != nil {
+		return BrowserLogin{}, err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return BrowserLogin{}, err
+	}
+
+	redirectURI := fmt.Sprintf("http://%s%s", listener.Addr().String(), browserLoginCallbackPath)
+	challenge := sha256.Sum256([]byte(codeVerifier))
+
+	return BrowserLogin{
+		URL:          actor.UAAClient.AuthorizationCodeURL(redirectURI, state, base64.RawURLEncoding.EncodeToString(challenge[:]), origin),
+		listener:     listener,
+		redirectURI:  redirectURI,
+		state:        state,
+		codeVerifier: codeVerifier,
+	}, nil
+}
+
+// FinishBrowserLogin waits for the browser to be redirected to the listener
+// of the login, then exchanges the authorization code for tokens. It returns
+// a BrowserLoginError when the authorization endpoint reports an error or no
+// code arrives within BrowserLoginTimeout.
+func (actor Actor) FinishBrowserLogin(login BrowserLogin) error {
+	results := make(chan browserLoginResult, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(browserLoginCallbackPath, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("state") != login.state {
+			http.Error(w, "Unexpected login state.", http.StatusBadRequest)
+			return
+		}
+
+		var result browserLoginResult
+		if errorCode := query.Get("error"); errorCode != "" {
+			reason := errorCode
+			if description := query.Get("error_description"); description != "" {
+				reason = fmt.Sprintf("%s: %s", errorCode, description)
+			}
+			result.err = actionerror.BrowserLoginError{Reason: reason}
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(browserLoginFailurePage))
+		} else {
+			result.code = query.Get("code")
+			_, _ = w.Write([]byte(browserLoginSuccessPage))
+		}
+
+		select {
+		case results <- result:
+		default:
+		}
+	})
+
+	server := &http.Server{Handler: mux}
+	go func() {
+		_ = server.Serve(login.listener)
+	}()
+	defer server.Close()
+
+	var result browserLoginResult
+	select {
+	case result = <-results:
+	case <-actor.Clock.After(BrowserLoginTimeout):
+		return actionerror.BrowserLoginError{Reason: "timed out waiting for the browser"}
+	}
+
+	if result.err != nil {
+		return result.err
+	}
+
+	return actor.Authenticate(map[string]string{
+		"code":          result.code,
+		"code_verifier": login.codeVerifier,
+		"redirect_uri":  login.redirectURI,
+	}, "", constant.GrantTypeAuthorizationCode)
+}
+
+func randomURLSafeString(length int) (string, error) {
+	randomBytes := make([]byte, length)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(randomBytes), nil
+}
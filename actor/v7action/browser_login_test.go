@@ -0,0 +1,152 @@
+package v7action_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Browser Login Actions", func() {
+	var (
+		actor         *Actor
+		fakeConfig    *v7actionfakes.FakeConfig
+		fakeUAAClient *v7actionfakes.FakeUAAClient
+		fakeClock     *fakeclock.FakeClock
+
+		login    BrowserLogin
+		startErr error
+	)
+
+	BeforeEach(func() {
+		actor, _, fakeConfig, _, fakeUAAClient, _, fakeClock = NewTestActor()
+		fakeUAAClient.AuthorizationCodeURLReturns("https://login.example.com/oauth/authorize?some=query")
+		fakeUAAClient.AuthenticateReturns("some-access-token", "some-refresh-token", nil)
+	})
+
+	JustBeforeEach(func() {
+		login, startErr = actor.StartBrowserLogin("some-origin")
+	})
+
+	Describe("StartBrowserLogin", func() {
+		It("returns the authorization URL for a loopback redirect with a PKCE challenge", func() {
+			Expect(startErr).ToNot(HaveOccurred())
+			Expect(login.URL).To(Equal("https://login.example.com/oauth/authorize?some=query"))
+
+			Expect(fakeUAAClient.AuthorizationCodeURLCallCount()).To(Equal(1))
+			redirectURI, state, challenge, origin := fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+			Expect(redirectURI).To(MatchRegexp(`^http://127\.0\.0\.1:\d+/callback$`))
+			Expect(state).ToNot(BeEmpty())
+			Expect(challenge).To(HaveLen(43))
+			Expect(origin).To(Equal("some-origin"))
+		})
+	})
+
+	Describe("FinishBrowserLogin", func() {
+		var (
+			redirectURI string
+			state       string
+			challenge   string
+
+			finished  chan error
+			finishErr error
+		)
+
+		callback := func(query url.Values) int {
+			response, err := http.Get(redirectURI + "?" + query.Encode())
+			Expect(err).ToNot(HaveOccurred())
+			defer response.Body.Close()
+			return response.StatusCode
+		}
+
+		JustBeforeEach(func() {
+			Expect(startErr).ToNot(HaveOccurred())
+			redirectURI, state, challenge, _ = fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+
+			finished = make(chan error, 1)
+			go func() {
+				finished <- actor.FinishBrowserLogin(login)
+			}()
+		})
+
+		When("the browser comes back with a code", func() {
+			JustBeforeEach(func() {
+				Expect(callback(url.Values{"state": {state}, "code": {"some-code"}})).To(Equal(http.StatusOK))
+				Eventually(finished).Should(Receive(&finishErr))
+			})
+
+			It("exchanges the code and the code verifier for tokens", func() {
+				Expect(finishErr).ToNot(HaveOccurred())
+
+				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(1))
+				credentials, origin, grantType := fakeUAAClient.AuthenticateArgsForCall(0)
+				Expect(origin).To(BeEmpty())
+				Expect(grantType).To(Equal(constant.GrantTypeAuthorizationCode))
+				Expect(credentials["code"]).To(Equal("some-code"))
+				Expect(credentials["redirect_uri"]).To(Equal(redirectURI))
+
+				verifierHash := sha256.Sum256([]byte(credentials["code_verifier"]))
+				Expect(base64.RawURLEncoding.EncodeToString(verifierHash[:])).To(Equal(challenge))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(BeEmpty())
+			})
+
+			When("the code cannot be exchanged", func() {
+				BeforeEach(func() {
+					fakeUAAClient.AuthenticateReturns("", "", errors.New("invalid code"))
+				})
+
+				It("returns the error", func() {
+					Expect(finishErr).To(MatchError("invalid code"))
+				})
+			})
+		})
+
+		When("the browser comes back with a different state", func() {
+			It("ignores the request and keeps waiting", func() {
+				Expect(callback(url.Values{"state": {"other-state"}, "code": {"some-code"}})).To(Equal(http.StatusBadRequest))
+				Consistently(finished).ShouldNot(Receive())
+
+				Expect(callback(url.Values{"state": {state}, "code": {"some-code"}})).To(Equal(http.StatusOK))
+				Eventually(finished).Should(Receive(BeNil()))
+			})
+		})
+
+		When("the authorization endpoint reports an error", func() {
+			It("returns a BrowserLoginError", func() {
+				Expect(callback(url.Values{
+					"state":             {state},
+					"error":             {"access_denied"},
+					"error_description": {"User denied access"},
+				})).To(Equal(http.StatusUnauthorized))
+
+				Eventually(finished).Should(Receive(&finishErr))
+				Expect(finishErr).To(MatchError(actionerror.BrowserLoginError{Reason: "access_denied: User denied access"}))
+				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the browser does not come back in time", func() {
+			It("returns a BrowserLoginError", func() {
+				fakeClock.WaitForWatcherAndIncrement(BrowserLoginTimeout + time.Second)
+
+				Eventually(finished).Should(Receive(&finishErr))
+				Expect(finishErr).To(MatchError(actionerror.BrowserLoginError{Reason: "timed out waiting for the browser"}))
+			})
+		})
+	})
+})
@@ -25,6 +25,7 @@ type CloudControllerClient interface {
 	CreateApplicationProcessScale(appGUID string, process resources.Process) (resources.Process, ccv3.Warnings, error)
 	CreateApplicationTask(appGUID string, task resources.Task) (resources.Task, ccv3.Warnings, error)
 	CreateBuild(build resources.Build) (resources.Build, ccv3.Warnings, error)
+	CreateApplicationSidecar(appGUID string, sidecar resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)
 	CreateBuildpack(bp resources.Buildpack) (resources.Buildpack, ccv3.Warnings, error)
 	CreateDomain(domain resources.Domain) (resources.Domain, ccv3.Warnings, error)
 	CreateDroplet(appGUID string) (resources.Droplet, ccv3.Warnings, error)
@@ -59,9 +60,11 @@ type CloudControllerClient interface {
 	DeleteServiceBroker(serviceBrokerGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteServiceInstance(serviceInstanceGUID string, query ...ccv3.Query) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteSpaceQuota(spaceQuotaGUID string) (ccv3.JobURL, ccv3.Warnings, error)
+	DeleteSidecar(sidecarGUID string) (ccv3.Warnings, error)
 	DeleteSpace(guid string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteUser(userGUID string) (ccv3.JobURL, ccv3.Warnings, error)
-	DownloadDroplet(dropletGUID string) ([]byte, ccv3.Warnings, error)
+	DownloadDroplet(dropletGUID string, offset int64, writer io.Writer) (ccv3.Warnings, error)
+	DownloadPackage(packageGUID string, offset int64, writer io.Writer) (ccv3.Warnings, error)
 	EntitleIsolationSegmentToOrganizations(isoGUID string, orgGUIDs []string) (resources.RelationshipList, ccv3.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (resources.Application, ccv3.Warnings, error)
 	GetApplicationDropletCurrent(appGUID string) (resources.Droplet, ccv3.Warnings, error)
@@ -72,6 +75,7 @@ type CloudControllerClient interface {
 	GetApplicationRevisions(appGUID string, query ...ccv3.Query) ([]resources.Revision, ccv3.Warnings, error)
 	GetApplicationRevisionsDeployed(appGUID string) ([]resources.Revision, ccv3.Warnings, error)
 	GetApplicationRoutes(appGUID string) ([]resources.Route, ccv3.Warnings, error)
+	GetApplicationSidecars(appGUID string, query ...ccv3.Query) ([]resources.Sidecar, ccv3.Warnings, error)
 	GetApplicationTasks(appGUID string, query ...ccv3.Query) ([]resources.Task, ccv3.Warnings, error)
 	GetApplications(query ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error)
 	GetBuild(guid string) (resources.Build, ccv3.Warnings, error)
@@ -183,6 +187,7 @@ type CloudControllerClient interface {
 	UpdateSecurityGroupStagingSpace(securityGroupGUID string, spaceGUIDs []string) (ccv3.Warnings, error)
 	UpdateSecurityGroup(securityGroup resources.SecurityGroup) (resources.SecurityGroup, ccv3.Warnings, error)
 	UpdateServiceInstance(serviceInstanceGUID string, serviceInstanceUpdates resources.ServiceInstance) (ccv3.JobURL, ccv3.Warnings, error)
+	UpdateSidecar(sidecar resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)
 	UpdateSpace(space resources.Space) (resources.Space, ccv3.Warnings, error)
 	UpdateSpaceApplyManifest(spaceGUID string, rawManifest []byte) (ccv3.JobURL, ccv3.Warnings, error)
 	UpdateSpaceFeature(spaceGUID string, enabled bool, featureName string) (ccv3.Warnings, error)
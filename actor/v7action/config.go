@@ -15,6 +15,7 @@ type Config interface {
 	DialTimeout() time.Duration
 	PollingInterval() time.Duration
 	RefreshToken() string
+	RequestRetryCount() int
 	SSHOAuthClient() string
 	SetAccessToken(token string)
 	SetRefreshToken(token string)
@@ -1,6 +1,8 @@
 package v7action
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
@@ -41,3 +43,89 @@ func (actor Actor) ContinueDeployment(deploymentGUID string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.ContinueDeployment(deploymentGUID)
 	return Warnings(warnings), err
 }
+
+// DeploymentInstanceCounts counts the instances of the web processes on one
+// side of a deployment by state.
+type DeploymentInstanceCounts struct {
+	Desired  int
+	Running  int
+	Starting int
+	Crashed  int
+	Down     int
+}
+
+// DeploymentStatus is a deployment together with the health of the instances
+// it is replacing and the instances it has started.
+type DeploymentStatus struct {
+	Deployment resources.Deployment
+
+	// StatusChangedAt is when the deployment last changed status, or the
+	// zero time when Cloud Controller did not report it.
+	StatusChangedAt time.Time
+
+	Previous DeploymentInstanceCounts
+	New      DeploymentInstanceCounts
+}
+
+// GetDeploymentStatus returns the deployment and counts the instances of the
+// app's web processes, splitting them between the processes the deployment
+// created and the ones it is replacing.
+func (actor Actor) GetDeploymentStatus(app resources.Application, deploymentGUID string) (DeploymentStatus, Warnings, error) {
+	deployment, ccWarnings, err := actor.CloudControllerClient.GetDeployment(deploymentGUID)
+	allWarnings := Warnings(ccWarnings)
+	if err != nil {
+		return DeploymentStatus{}, allWarnings, err
+	}
+
+	status := DeploymentStatus{Deployment: deployment}
+	if deployment.LastStatusChange != "" {
+		status.StatusChangedAt, _ = time.Parse(time.RFC3339, deployment.LastStatusChange)
+	}
+
+	newProcessGUIDs := map[string]bool{}
+	for _, process := range deployment.NewProcesses {
+		newProcessGUIDs[process.GUID] = true
+	}
+
+	processes, ccWarnings, err := actor.CloudControllerClient.GetApplicationProcesses(app.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return DeploymentStatus{}, allWarnings, err
+	}
+
+	for _, process := range processes {
+		if process.Type != constant.ProcessTypeWeb {
+			continue
+		}
+
+		instances, ccWarnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return DeploymentStatus{}, allWarnings, err
+		}
+
+		counts := &status.Previous
+		if newProcessGUIDs[process.GUID] {
+			counts = &status.New
+		}
+		counts.add(process, instances)
+	}
+
+	return status, allWarnings, nil
+}
+
+func (counts *DeploymentInstanceCounts) add(process resources.Process, instances []ccv3.ProcessInstance) {
+	counts.Desired += process.Instances.Value
+	for _, instance := range instances {
+		switch instance.State {
+		case constant.ProcessInstanceRunning:
+			counts.Running++
+		case constant.ProcessInstanceStarting:
+			counts.Starting++
+		case constant.ProcessInstanceCrashed:
+			counts.Crashed++
+		default:
+			counts.Down++
+		}
+	}
+}
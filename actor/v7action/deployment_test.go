@@ -2,6 +2,7 @@ package v7action_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
@@ -10,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -259,4 +261,93 @@ var _ = Describe("Deployment Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetDeploymentStatus", func() {
+		var status DeploymentStatus
+
+		JustBeforeEach(func() {
+			status, warnings, executeErr = actor.GetDeploymentStatus(resources.Application{GUID: "some-app-guid"}, "some-deployment-guid")
+		})
+
+		When("the deployment is in progress", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(
+					resources.Deployment{
+						GUID:             "some-deployment-guid",
+						Strategy:         constant.DeploymentStrategyCanary,
+						StatusValue:      constant.DeploymentStatusValueActive,
+						StatusReason:     constant.DeploymentStatusReasonPaused,
+						LastStatusChange: "2021-03-04T05:06:07Z",
+						NewProcesses:     []resources.Process{{GUID: "new-web-guid", Type: constant.ProcessTypeWeb}},
+					},
+					ccv3.Warnings{"deployment-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]resources.Process{
+						{GUID: "old-web-guid", Type: constant.ProcessTypeWeb, Instances: types.NullInt{IsSet: true, Value: 3}},
+						{GUID: "worker-guid", Type: "worker", Instances: types.NullInt{IsSet: true, Value: 1}},
+						{GUID: "new-web-guid", Type: constant.ProcessTypeWeb, Instances: types.NullInt{IsSet: true, Value: 2}},
+					},
+					ccv3.Warnings{"processes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetProcessInstancesStub = func(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error) {
+					switch processGUID {
+					case "old-web-guid":
+						return []ccv3.ProcessInstance{
+							{State: constant.ProcessInstanceRunning},
+							{State: constant.ProcessInstanceRunning},
+							{State: constant.ProcessInstanceDown},
+						}, ccv3.Warnings{"old-instances-warning"}, nil
+					case "new-web-guid":
+						return []ccv3.ProcessInstance{
+							{State: constant.ProcessInstanceRunning},
+							{State: constant.ProcessInstanceStarting},
+							{State: constant.ProcessInstanceCrashed},
+						}, ccv3.Warnings{"new-instances-warning"}, nil
+					}
+					return nil, nil, errors.New("unexpected process")
+				}
+			})
+
+			It("splits the web instances between the previous and the new processes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("deployment-warning", "processes-warning", "old-instances-warning", "new-instances-warning"))
+
+				Expect(fakeCloudControllerClient.GetDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(2))
+
+				Expect(status.Deployment.GUID).To(Equal("some-deployment-guid"))
+				Expect(status.StatusChangedAt).To(Equal(time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)))
+				Expect(status.Previous).To(Equal(DeploymentInstanceCounts{Desired: 3, Running: 2, Down: 1}))
+				Expect(status.New).To(Equal(DeploymentInstanceCounts{Desired: 2, Running: 1, Starting: 1, Crashed: 1}))
+			})
+		})
+
+		When("getting the deployment fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(resources.Deployment{}, ccv3.Warnings{"deployment-warning"}, errors.New("deployment-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("deployment-error"))
+				Expect(warnings).To(ConsistOf("deployment-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns([]resources.Process{{GUID: "old-web-guid", Type: constant.ProcessTypeWeb}}, nil, nil)
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"instances-warning"}, errors.New("instances-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("instances-error"))
+				Expect(warnings).To(ConsistOf("instances-warning"))
+			})
+		})
+	})
 })
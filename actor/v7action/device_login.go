@@ -0,0 +1,91 @@
+package v7action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
+
+const (
+	// DeviceLoginPollInterval is the time between two token requests when the
+	// device authorization does not set one.
+	DeviceLoginPollInterval = 5 * time.Second
+
+	// deviceLoginSlowDownIncrement is added to the poll interval every time
+	// the authorization server asks to slow down.
+	deviceLoginSlowDownIncrement = 5 * time.Second
+)
+
+// DeviceLogin is a device authorization started by StartDeviceLogin. The user
+// approves it by entering UserCode at VerificationURI, or by opening
+// VerificationURIComplete when the authorization server provides one.
+type DeviceLogin struct {
+	UserCode                string
+	VerificationURI         string
+	VerificationURIComplete string
+	ExpiresAt               time.Time
+
+	deviceCode string
+	interval   time.Duration
+}
+
+// StartDeviceLogin requests a device authorization for a login on another
+// device.
+func (actor Actor) StartDeviceLogin() (DeviceLogin, error) {
+	authorization, err := actor.UAAClient.StartDeviceAuthorization()
+	if err != nil {
+		return DeviceLogin{}, err
+	}
+
+	login := DeviceLogin{
+		UserCode:                authorization.UserCode,
+		VerificationURI:         authorization.VerificationURI,
+		VerificationURIComplete: authorization.VerificationURIComplete,
+		deviceCode:              authorization.DeviceCode,
+		interval:                time.Duration(authorization.Interval) * time.Second,
+	}
+	if login.interval <= 0 {
+		login.interval = DeviceLoginPollInterval
+	}
+	if authorization.ExpiresIn > 0 {
+		login.ExpiresAt = actor.Clock.Now().Add(time.Duration(authorization.ExpiresIn) * time.Second)
+	}
+
+	return login, nil
+}
+
+// FinishDeviceLogin polls the token endpoint until the user approves the
+// device login, backing off whenever the authorization server asks to slow
+// down. It returns a DeviceLoginError when the user denies the login or the
+// device code expires first.
+func (actor Actor) FinishDeviceLogin(login DeviceLogin) error {
+	interval := login.interval
+	credentials := map[string]string{
+		"device_code": login.deviceCode,
+	}
+
+	for {
+		<-actor.Clock.After(interval)
+
+		err := actor.Authenticate(credentials, "", constant.GrantTypeDeviceCode)
+		switch err.(type) {
+		case nil:
+			return nil
+		case uaa.AuthorizationPendingError:
+		case uaa.SlowDownError:
+			interval += deviceLoginSlowDownIncrement
+		case uaa.AccessDeniedError:
+			return actionerror.DeviceLoginError{Reason: "the login was denied"}
+		case uaa.ExpiredDeviceCodeError:
+			return actionerror.DeviceLoginError{Reason: "the user code expired"}
+		default:
+			return err
+		}
+
+		if !login.ExpiresAt.IsZero() && !actor.Clock.Now().Before(login.ExpiresAt) {
+			return actionerror.DeviceLoginError{Reason: "the user code expired"}
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Device Login Actions", func() {
+	var (
+		actor         *Actor
+		fakeConfig    *v7actionfakes.FakeConfig
+		fakeUAAClient *v7actionfakes.FakeUAAClient
+		fakeClock     *fakeclock.FakeClock
+
+		authorization    uaa.DeviceAuthorization
+		authorizationErr error
+		login            DeviceLogin
+		startErr         error
+	)
+
+	BeforeEach(func() {
+		actor, _, fakeConfig, _, fakeUAAClient, _, fakeClock = NewTestActor()
+		authorization = uaa.DeviceAuthorization{
+			DeviceCode:              "some-device-code",
+			UserCode:                "ABCD-EFGH",
+			VerificationURI:         "https://login.example.com/device",
+			VerificationURIComplete: "https://login.example.com/device?user_code=ABCD-EFGH",
+			ExpiresIn:               60,
+			Interval:                2,
+		}
+		authorizationErr = nil
+	})
+
+	JustBeforeEach(func() {
+		fakeUAAClient.StartDeviceAuthorizationReturns(authorization, authorizationErr)
+		login, startErr = actor.StartDeviceLogin()
+	})
+
+	Describe("StartDeviceLogin", func() {
+		It("returns the user code and where to enter it", func() {
+			Expect(startErr).ToNot(HaveOccurred())
+			Expect(login.UserCode).To(Equal("ABCD-EFGH"))
+			Expect(login.VerificationURI).To(Equal("https://login.example.com/device"))
+			Expect(login.VerificationURIComplete).To(Equal("https://login.example.com/device?user_code=ABCD-EFGH"))
+			Expect(login.ExpiresAt).To(Equal(fakeClock.Now().Add(time.Minute)))
+		})
+
+		When("the device authorization fails", func() {
+			BeforeEach(func() {
+				authorizationErr = errors.New("no device flow")
+			})
+
+			It("returns the error", func() {
+				Expect(startErr).To(MatchError("no device flow"))
+			})
+		})
+	})
+
+	Describe("FinishDeviceLogin", func() {
+		var finished chan error
+
+		JustBeforeEach(func() {
+			Expect(startErr).ToNot(HaveOccurred())
+
+			finished = make(chan error, 1)
+			go func() {
+				finished <- actor.FinishDeviceLogin(login)
+			}()
+		})
+
+		When("the user approves the login after a while", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturnsOnCall(0, "", "", uaa.AuthorizationPendingError{})
+				fakeUAAClient.AuthenticateReturnsOnCall(1, "", "", uaa.SlowDownError{})
+				fakeUAAClient.AuthenticateReturnsOnCall(2, "some-access-token", "some-refresh-token", nil)
+			})
+
+			It("polls at the interval, backs off when asked to and stores the tokens", func() {
+				fakeClock.WaitForWatcherAndIncrement(2 * time.Second)
+				Eventually(fakeUAAClient.AuthenticateCallCount).Should(Equal(1))
+
+				fakeClock.WaitForWatcherAndIncrement(2 * time.Second)
+				Eventually(fakeUAAClient.AuthenticateCallCount).Should(Equal(2))
+
+				fakeClock.WaitForWatcherAndIncrement(2 * time.Second)
+				Consistently(fakeUAAClient.AuthenticateCallCount).Should(Equal(2))
+				fakeClock.Increment(5 * time.Second)
+				Eventually(finished).Should(Receive(BeNil()))
+
+				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(3))
+				credentials, origin, grantType := fakeUAAClient.AuthenticateArgsForCall(2)
+				Expect(credentials).To(Equal(map[string]string{"device_code": "some-device-code"}))
+				Expect(origin).To(BeEmpty())
+				Expect(grantType).To(Equal(constant.GrantTypeDeviceCode))
+
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(fakeConfig.SetTokenInformationCallCount() - 1)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+			})
+		})
+
+		When("the user denies the login", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturns("", "", uaa.AccessDeniedError{})
+			})
+
+			It("returns a DeviceLoginError", func() {
+				fakeClock.WaitForWatcherAndIncrement(2 * time.Second)
+				Eventually(finished).Should(Receive(MatchError(actionerror.DeviceLoginError{Reason: "the login was denied"})))
+			})
+		})
+
+		When("the device code expires before the user approves it", func() {
+			BeforeEach(func() {
+				authorization.ExpiresIn = 3
+				fakeUAAClient.AuthenticateReturns("", "", uaa.AuthorizationPendingError{})
+			})
+
+			It("returns a DeviceLoginError", func() {
+				fakeClock.WaitForWatcherAndIncrement(2 * time.Second)
+				Consistently(finished).ShouldNot(Receive())
+
+				fakeClock.WaitForWatcherAndIncrement(2 * time.Second)
+				Eventually(finished).Should(Receive(MatchError(actionerror.DeviceLoginError{Reason: "the user code expired"})))
+			})
+		})
+
+		When("the token request fails", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturns("", "", errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				fakeClock.WaitForWatcherAndIncrement(2 * time.Second)
+				Eventually(finished).Should(Receive(MatchError("some-error")))
+			})
+		})
+	})
+})
@@ -0,0 +1,98 @@
+package v7action
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . DownloadProgressBar
+
+// DownloadProgressBar displays the progress of bits being written to disk.
+type DownloadProgressBar interface {
+	NewProgressBarWriterWrapper(writer io.Writer, sizeOfFile int64) io.Writer
+	Complete()
+}
+
+// streamFunc writes the bits being downloaded into writer, starting offset
+// bytes into them.
+type streamFunc func(offset int64, writer io.Writer) (ccv3.Warnings, error)
+
+// downloadBits streams bits into writer. When the connection breaks after
+// some bits have been written, the download is resumed from where it stopped,
+// up to the configured number of request retries. Once complete, the bits are
+// verified against the checksum, unless its type is not one the CLI supports.
+func (actor Actor) downloadBits(stream streamFunc, checksumType string, checksumValue string, writer io.Writer, progressBar DownloadProgressBar) (Warnings, error) {
+	var allWarnings Warnings
+
+	destination := progressBar.NewProgressBarWriterWrapper(writer, 0)
+	defer progressBar.Complete()
+
+	hasher := newChecksumHash(checksumType)
+	if hasher != nil {
+		destination = io.MultiWriter(destination, hasher)
+	}
+
+	counter := &countingWriter{writer: destination}
+	for retries := 0; ; retries++ {
+		offset := counter.written
+
+		warnings, err := stream(offset, counter)
+		allWarnings = append(allWarnings, warnings...)
+		if err == nil {
+			break
+		}
+
+		resumable := counter.writeErr == nil && counter.written > offset
+		if !resumable || retries >= actor.Config.RequestRetryCount() {
+			return allWarnings, err
+		}
+	}
+
+	if hasher != nil {
+		actual := hex.EncodeToString(hasher.Sum(nil))
+		if !strings.EqualFold(actual, checksumValue) {
+			return allWarnings, actionerror.DownloadChecksumMismatchError{
+				Type:     checksumType,
+				Expected: checksumValue,
+				Actual:   actual,
+			}
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func newChecksumHash(checksumType string) hash.Hash {
+	switch strings.ToLower(checksumType) {
+	case "sha256":
+		return sha256.New()
+	case "sha1":
+		return sha1.New()
+	default:
+		return nil
+	}
+}
+
+// countingWriter records how many bytes have been written, and whether the
+// underlying writer failed, so that a broken download can be resumed.
+type countingWriter struct {
+	writer   io.Writer
+	written  int64
+	writeErr error
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.written += int64(n)
+	if err != nil {
+		w.writeErr = err
+	}
+	return n, err
+}
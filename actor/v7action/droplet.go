@@ -116,13 +116,15 @@ func (actor Actor) UploadDroplet(dropletGUID string, dropletPath string, progres
 	return allWarnings, nil
 }
 
-func (actor Actor) DownloadCurrentDropletByAppName(appName string, spaceGUID string) ([]byte, string, Warnings, error) {
+// GetCurrentDropletByAppName returns the current droplet of the app with the
+// given name in the given space.
+func (actor Actor) GetCurrentDropletByAppName(appName string, spaceGUID string) (resources.Droplet, Warnings, error) {
 	var allWarnings Warnings
 
 	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
-		return []byte{}, "", allWarnings, err
+		return resources.Droplet{}, allWarnings, err
 	}
 
 	droplet, ccWarnings, err := actor.CloudControllerClient.GetApplicationDropletCurrent(app.GUID)
@@ -130,27 +132,23 @@ func (actor Actor) DownloadCurrentDropletByAppName(appName string, spaceGUID str
 
 	if err != nil {
 		if _, ok := err.(ccerror.DropletNotFoundError); ok {
-			return []byte{}, "", allWarnings, actionerror.DropletNotFoundError{}
+			return resources.Droplet{}, allWarnings, actionerror.DropletNotFoundError{}
 		}
-		return []byte{}, "", allWarnings, err
+		return resources.Droplet{}, allWarnings, err
 	}
 
-	rawDropletBytes, ccWarnings, err := actor.CloudControllerClient.DownloadDroplet(droplet.GUID)
-	allWarnings = append(allWarnings, ccWarnings...)
-	if err != nil {
-		return []byte{}, "", allWarnings, err
-	}
-
-	return rawDropletBytes, droplet.GUID, allWarnings, nil
+	return droplet, allWarnings, nil
 }
 
-func (actor Actor) DownloadDropletByGUIDAndAppName(dropletGUID string, appName string, spaceGUID string) ([]byte, Warnings, error) {
+// GetDropletByGUIDAndAppName returns the droplet with the given GUID, provided
+// it belongs to the app with the given name in the given space.
+func (actor Actor) GetDropletByGUIDAndAppName(dropletGUID string, appName string, spaceGUID string) (resources.Droplet, Warnings, error) {
 	var allWarnings Warnings
 
 	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
-		return []byte{}, allWarnings, err
+		return resources.Droplet{}, allWarnings, err
 	}
 
 	droplets, getDropletWarnings, err := actor.CloudControllerClient.GetDroplets(
@@ -161,18 +159,27 @@ func (actor Actor) DownloadDropletByGUIDAndAppName(dropletGUID string, appName s
 	)
 	allWarnings = append(allWarnings, getDropletWarnings...)
 	if err != nil {
-		return []byte{}, allWarnings, err
+		return resources.Droplet{}, allWarnings, err
 	}
 
 	if len(droplets) == 0 {
-		return []byte{}, allWarnings, actionerror.DropletNotFoundError{}
+		return resources.Droplet{}, allWarnings, actionerror.DropletNotFoundError{}
 	}
 
-	rawDropletBytes, ccWarnings, err := actor.CloudControllerClient.DownloadDroplet(dropletGUID)
-	allWarnings = append(allWarnings, ccWarnings...)
-	if err != nil {
-		return []byte{}, allWarnings, err
-	}
+	return droplets[0], allWarnings, nil
+}
 
-	return rawDropletBytes, allWarnings, nil
+// DownloadDroplet streams the bits of the droplet into writer, resuming the
+// download if the connection breaks and verifying the bits against the
+// droplet's checksum.
+func (actor Actor) DownloadDroplet(droplet resources.Droplet, writer io.Writer, progressBar DownloadProgressBar) (Warnings, error) {
+	return actor.downloadBits(
+		func(offset int64, writer io.Writer) (ccv3.Warnings, error) {
+			return actor.CloudControllerClient.DownloadDroplet(droplet.GUID, offset, writer)
+		},
+		droplet.Checksum.Type,
+		droplet.Checksum.Value,
+		writer,
+		progressBar,
+	)
 }
@@ -1,6 +1,9 @@
 package v7action_test
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"io"
 	"strings"
@@ -639,13 +642,12 @@ var _ = Describe("Droplet Actions", func() {
 		})
 	})
 
-	Describe("DownloadCurrentDropletByAppName", func() {
+	Describe("GetCurrentDropletByAppName", func() {
 		var (
 			appName   string
 			spaceGUID string
 
-			dropletBytes []byte
-			dropletGUID  string
+			droplet      resources.Droplet
 			warnings     Warnings
 			executionErr error
 		)
@@ -668,19 +670,14 @@ var _ = Describe("Droplet Actions", func() {
 		})
 
 		JustBeforeEach(func() {
-			dropletBytes, dropletGUID, warnings, executionErr = actor.DownloadCurrentDropletByAppName(appName, spaceGUID)
+			droplet, warnings, executionErr = actor.GetCurrentDropletByAppName(appName, spaceGUID)
 		})
 
-		When("there is a current droplet available for download", func() {
-			BeforeEach(func() {
-				fakeCloudControllerClient.DownloadDropletReturns([]byte{'d', 'r', 'o', 'p'}, ccv3.Warnings{"some-droplet-warning"}, nil)
-			})
-
-			It("successfully downloads", func() {
+		When("there is a current droplet", func() {
+			It("returns the droplet and all warnings", func() {
 				Expect(executionErr).ToNot(HaveOccurred())
-				Expect(warnings).To(ConsistOf("get-app-warning", "some-droplet-warning", "some-warning"))
-				Expect(dropletBytes).To(Equal([]byte{'d', 'r', 'o', 'p'}))
-				Expect(dropletGUID).To(Equal("some-droplet-guid"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "some-warning"))
+				Expect(droplet).To(Equal(resources.Droplet{GUID: "some-droplet-guid"}))
 
 				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
 				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
@@ -690,9 +687,6 @@ var _ = Describe("Droplet Actions", func() {
 
 				Expect(fakeCloudControllerClient.GetApplicationDropletCurrentCallCount()).To(Equal(1))
 				Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
-				Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(1))
-				Expect(fakeCloudControllerClient.DownloadDropletArgsForCall(0)).To(Equal("some-droplet-guid"))
-
 			})
 		})
 
@@ -717,26 +711,15 @@ var _ = Describe("Droplet Actions", func() {
 				Expect(warnings).To(ConsistOf("get-app-warning", "some-warning"))
 			})
 		})
-
-		When("an error occurs downloading the droplet", func() {
-			BeforeEach(func() {
-				fakeCloudControllerClient.DownloadDropletReturns([]byte{}, ccv3.Warnings{"some-droplet-warning"}, errors.New("droplet-download-err"))
-			})
-
-			It("returns the error and warnings", func() {
-				Expect(executionErr).To(MatchError("droplet-download-err"))
-				Expect(warnings).To(ConsistOf("get-app-warning", "some-warning", "some-droplet-warning"))
-			})
-		})
 	})
 
-	Describe("DownloadDropletByGUIDAndAppName", func() {
+	Describe("GetDropletByGUIDAndAppName", func() {
 		var (
-			appName   string
-			spaceGUID string
+			appName     string
+			spaceGUID   string
+			dropletGUID string
 
-			dropletBytes []byte
-			dropletGUID  string
+			droplet      resources.Droplet
 			warnings     Warnings
 			executionErr error
 		)
@@ -760,24 +743,14 @@ var _ = Describe("Droplet Actions", func() {
 		})
 
 		JustBeforeEach(func() {
-			dropletBytes, warnings, executionErr = actor.DownloadDropletByGUIDAndAppName(dropletGUID, appName, spaceGUID)
+			droplet, warnings, executionErr = actor.GetDropletByGUIDAndAppName(dropletGUID, appName, spaceGUID)
 		})
 
-		When("there is a droplet available for download", func() {
-			BeforeEach(func() {
-				fakeCloudControllerClient.DownloadDropletReturns([]byte{'d', 'r', 'o', 'p'}, ccv3.Warnings{"some-droplet-warning"}, nil)
-			})
-
-			It("successfully downloads", func() {
+		When("the droplet belongs to the app", func() {
+			It("returns the droplet and all warnings", func() {
 				Expect(executionErr).ToNot(HaveOccurred())
-				Expect(warnings).To(ConsistOf("get-app-warning", "some-droplet-warning", "some-warning"))
-				Expect(dropletBytes).To(Equal([]byte{'d', 'r', 'o', 'p'}))
-
-				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
-				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
-					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-app-name"}},
-					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
-				))
+				Expect(warnings).To(ConsistOf("get-app-warning", "some-warning"))
+				Expect(droplet).To(Equal(resources.Droplet{GUID: "some-droplet-guid"}))
 
 				Expect(fakeCloudControllerClient.GetDropletsCallCount()).To(Equal(1))
 				Expect(fakeCloudControllerClient.GetDropletsArgsForCall(0)).To(ConsistOf(
@@ -786,10 +759,6 @@ var _ = Describe("Droplet Actions", func() {
 					ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
 					ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
 				))
-
-				Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(1))
-				Expect(fakeCloudControllerClient.DownloadDropletArgsForCall(0)).To(Equal("some-droplet-guid"))
-
 			})
 		})
 
@@ -814,16 +783,177 @@ var _ = Describe("Droplet Actions", func() {
 				Expect(warnings).To(ConsistOf("get-app-warning", "some-warning"))
 			})
 		})
+	})
+
+	Describe("DownloadDroplet", func() {
+		var (
+			fakeConfig      *v7actionfakes.FakeConfig
+			fakeProgressBar *v7actionfakes.FakeDownloadProgressBar
+
+			droplet resources.Droplet
+			output  *bytes.Buffer
+
+			warnings     Warnings
+			executionErr error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v7actionfakes.FakeConfig)
+			fakeConfig.RequestRetryCountReturns(2)
+			actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil, nil, nil)
+
+			fakeProgressBar = new(v7actionfakes.FakeDownloadProgressBar)
+			fakeProgressBar.NewProgressBarWriterWrapperStub = func(writer io.Writer, _ int64) io.Writer {
+				return writer
+			}
+
+			sum := sha256.Sum256([]byte("drop"))
+			droplet = resources.Droplet{
+				GUID: "some-droplet-guid",
+				Checksum: resources.BitsChecksum{
+					Type:  "sha256",
+					Value: hex.EncodeToString(sum[:]),
+				},
+			}
+
+			output = new(bytes.Buffer)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executionErr = actor.DownloadDroplet(droplet, output, fakeProgressBar)
+		})
 
-		When("an error occurs downloading the droplet", func() {
+		When("the download succeeds", func() {
 			BeforeEach(func() {
-				fakeCloudControllerClient.DownloadDropletReturns([]byte{}, ccv3.Warnings{"some-droplet-warning"}, errors.New("droplet-download-err"))
+				fakeCloudControllerClient.DownloadDropletStub = func(_ string, _ int64, writer io.Writer) (ccv3.Warnings, error) {
+					_, err := writer.Write([]byte("drop"))
+					return ccv3.Warnings{"some-droplet-warning"}, err
+				}
 			})
 
-			It("returns the error and warnings", func() {
+			It("streams the droplet through the progress bar into the writer", func() {
+				Expect(executionErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-droplet-warning"))
+				Expect(output.String()).To(Equal("drop"))
+
+				Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(1))
+				guid, offset, _ := fakeCloudControllerClient.DownloadDropletArgsForCall(0)
+				Expect(guid).To(Equal("some-droplet-guid"))
+				Expect(offset).To(BeZero())
+
+				Expect(fakeProgressBar.NewProgressBarWriterWrapperCallCount()).To(Equal(1))
+				Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the connection breaks after part of the droplet was written", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadDropletStub = func(_ string, offset int64, writer io.Writer) (ccv3.Warnings, error) {
+					if offset == 0 {
+						_, err := writer.Write([]byte("dr"))
+						Expect(err).ToNot(HaveOccurred())
+						return ccv3.Warnings{"first-warning"}, io.ErrUnexpectedEOF
+					}
+					_, err := writer.Write([]byte("drop")[offset:])
+					return ccv3.Warnings{"second-warning"}, err
+				}
+			})
+
+			It("resumes the download from where it stopped", func() {
+				Expect(executionErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("first-warning", "second-warning"))
+				Expect(output.String()).To(Equal("drop"))
+
+				Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(2))
+				_, offset, _ := fakeCloudControllerClient.DownloadDropletArgsForCall(1)
+				Expect(offset).To(BeEquivalentTo(2))
+			})
+		})
+
+		When("the connection keeps breaking", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadDropletStub = func(_ string, _ int64, writer io.Writer) (ccv3.Warnings, error) {
+					_, err := writer.Write([]byte("d"))
+					Expect(err).ToNot(HaveOccurred())
+					return ccv3.Warnings{"some-warning"}, io.ErrUnexpectedEOF
+				}
+			})
+
+			It("gives up after the configured number of retries", func() {
+				Expect(executionErr).To(MatchError(io.ErrUnexpectedEOF))
+				Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(3))
+				Expect(warnings).To(HaveLen(3))
+			})
+		})
+
+		When("the request fails before anything was written", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadDropletReturns(ccv3.Warnings{"some-droplet-warning"}, errors.New("droplet-download-err"))
+			})
+
+			It("returns the error and warnings without retrying", func() {
 				Expect(executionErr).To(MatchError("droplet-download-err"))
-				Expect(warnings).To(ConsistOf("get-app-warning", "some-warning", "some-droplet-warning"))
+				Expect(warnings).To(ConsistOf("some-droplet-warning"))
+				Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(1))
+				Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+			})
+		})
+
+		When("writing the droplet fails", func() {
+			BeforeEach(func() {
+				fakeProgressBar.NewProgressBarWriterWrapperStub = func(io.Writer, int64) io.Writer {
+					return failingWriter{}
+				}
+				fakeCloudControllerClient.DownloadDropletStub = func(_ string, _ int64, writer io.Writer) (ccv3.Warnings, error) {
+					_, err := writer.Write([]byte("drop"))
+					return nil, err
+				}
+			})
+
+			It("returns the error without retrying", func() {
+				Expect(executionErr).To(MatchError("disk full"))
+				Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the downloaded bits do not match the checksum", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadDropletStub = func(_ string, _ int64, writer io.Writer) (ccv3.Warnings, error) {
+					_, err := writer.Write([]byte("nope"))
+					return ccv3.Warnings{"some-droplet-warning"}, err
+				}
+			})
+
+			It("returns a DownloadChecksumMismatchError", func() {
+				sum := sha256.Sum256([]byte("nope"))
+				Expect(executionErr).To(MatchError(actionerror.DownloadChecksumMismatchError{
+					Type:     "sha256",
+					Expected: droplet.Checksum.Value,
+					Actual:   hex.EncodeToString(sum[:]),
+				}))
+				Expect(warnings).To(ConsistOf("some-droplet-warning"))
+			})
+		})
+
+		When("the checksum type is not supported", func() {
+			BeforeEach(func() {
+				droplet.Checksum = resources.BitsChecksum{Type: "md5", Value: "whatever"}
+				fakeCloudControllerClient.DownloadDropletStub = func(_ string, _ int64, writer io.Writer) (ccv3.Warnings, error) {
+					_, err := writer.Write([]byte("nope"))
+					return nil, err
+				}
+			})
+
+			It("skips verification", func() {
+				Expect(executionErr).ToNot(HaveOccurred())
+				Expect(output.String()).To(Equal("nope"))
 			})
 		})
 	})
 })
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
@@ -11,46 +11,114 @@ import (
 )
 
 type Event struct {
-	GUID        string
-	Time        time.Time
-	Type        string
-	ActorName   string
-	Description string
+	GUID             string
+	Time             time.Time
+	Type             string
+	ActorGUID        string
+	ActorType        string
+	ActorName        string
+	TargetGUID       string
+	TargetType       string
+	TargetName       string
+	SpaceGUID        string
+	OrganizationGUID string
+	Description      string
 }
 
-func (actor Actor) GetRecentEventsByApplicationNameAndSpace(appName string, spaceGUID string) ([]Event, Warnings, error) {
-	var allWarnings Warnings
+// EventsQuery selects audit events. At most one of TargetGUIDs, SpaceGUID and
+// OrganizationGUID is expected to be set; when none are, events from the whole
+// foundation visible to the user are returned.
+type EventsQuery struct {
+	TargetGUIDs      []string
+	SpaceGUID        string
+	OrganizationGUID string
 
-	app, appWarnings, appErr := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
-	allWarnings = append(allWarnings, appWarnings...)
-	if appErr != nil {
-		return nil, allWarnings, appErr
-	}
+	// Types keeps events of any of the given types, such as audit.app.update.
+	Types []string
+
+	// Actor keeps events whose actor has this name or GUID. Cloud Controller
+	// cannot filter on the actor, so it is applied to each returned page.
+	Actor string
+
+	// TargetType keeps events whose target is of this type, such as app or
+	// service_instance. Like Actor, it is applied to each returned page.
+	TargetType string
 
-	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(
-		ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{app.GUID}},
-		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
-		ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
-	)
-	allWarnings = append(allWarnings, warnings...)
+	// Since and Until bound the event creation times. A zero value leaves
+	// that end of the window open.
+	Since time.Time
+	Until time.Time
 
+	// Page selects a single page of events, most recent first. Zero returns
+	// every matching event.
+	Page    int
+	PerPage int
+}
+
+// GetEvents returns the audit events matching the query, most recent first.
+func (actor Actor) GetEvents(query EventsQuery) ([]Event, Warnings, error) {
+	queries := []ccv3.Query{
+		{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+	}
+	if len(query.TargetGUIDs) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: query.TargetGUIDs})
+	}
+	if query.SpaceGUID != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{query.SpaceGUID}})
+	}
+	if query.OrganizationGUID != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{query.OrganizationGUID}})
+	}
+	if len(query.Types) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.EventTypesFilter, Values: query.Types})
+	}
+	if !query.Since.IsZero() {
+		queries = append(queries, ccv3.Query{Key: ccv3.CreatedAtsGreaterThanFilter, Values: []string{query.Since.UTC().Format(time.RFC3339)}})
+	}
+	if !query.Until.IsZero() {
+		queries = append(queries, ccv3.Query{Key: ccv3.CreatedAtsLessThanFilter, Values: []string{query.Until.UTC().Format(time.RFC3339)}})
+	}
+	if query.PerPage > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.PerPage, Values: []string{strconv.Itoa(query.PerPage)}})
+	}
+	if query.Page > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.Page, Values: []string{strconv.Itoa(query.Page)}})
+	}
+
+	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(queries...)
 	if err != nil {
-		return nil, allWarnings, err
+		return nil, Warnings(warnings), err
 	}
 
 	var events []Event
 	for _, ccEvent := range ccEvents {
-		events = append(events, Event{
-			GUID:        ccEvent.GUID,
-			Time:        ccEvent.CreatedAt,
-			Type:        ccEvent.Type,
-			ActorName:   ccEvent.ActorName,
-			Description: generateDescription(ccEvent.Data),
-		})
-
+		if query.Actor != "" && ccEvent.ActorName != query.Actor && ccEvent.ActorGUID != query.Actor {
+			continue
+		}
+		if query.TargetType != "" && ccEvent.TargetType != query.TargetType {
+			continue
+		}
+		events = append(events, newEvent(ccEvent))
 	}
 
-	return events, allWarnings, nil
+	return events, Warnings(warnings), nil
+}
+
+func newEvent(ccEvent ccv3.Event) Event {
+	return Event{
+		GUID:             ccEvent.GUID,
+		Time:             ccEvent.CreatedAt,
+		Type:             ccEvent.Type,
+		ActorGUID:        ccEvent.ActorGUID,
+		ActorType:        ccEvent.ActorType,
+		ActorName:        ccEvent.ActorName,
+		TargetGUID:       ccEvent.TargetGUID,
+		TargetType:       ccEvent.TargetType,
+		TargetName:       ccEvent.TargetName,
+		SpaceGUID:        ccEvent.SpaceGUID,
+		OrganizationGUID: ccEvent.OrganizationGUID,
+		Description:      generateDescription(ccEvent.Data),
+	}
 }
 
 var knownMetadataKeys = []string{
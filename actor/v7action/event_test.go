@@ -2,11 +2,11 @@ package v7action_test
 
 import (
 	"errors"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
-	"code.cloudfoundry.org/cli/resources"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -21,105 +21,173 @@ var _ = Describe("Event Actions", func() {
 		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
 	})
 
-	Describe("GetRecentEventsForApp", func() {
+	Describe("GetEvents", func() {
 		var (
-			appName   string
-			spaceGUID string
-			events    []Event
-			warnings  Warnings
-			err       error
+			query    EventsQuery
+			events   []Event
+			warnings Warnings
+			err      error
 		)
 
 		BeforeEach(func() {
-			appName = "some-app-name"
-			spaceGUID = "some-space-guid"
+			query = EventsQuery{}
+			fakeCloudControllerClient.GetEventsReturns(
+				[]ccv3.Event{
+					{
+						GUID:       "event-1",
+						Type:       "audit.app.update",
+						ActorGUID:  "user-guid",
+						ActorType:  "user",
+						ActorName:  "admin",
+						TargetGUID: "app-guid",
+						TargetType: "app",
+						TargetName: "some-app",
+						SpaceGUID:  "space-guid",
+						Data:       map[string]interface{}{"request": map[string]interface{}{"instances": float64(2)}},
+					},
+					{
+						GUID:       "event-2",
+						Type:       "audit.service_instance.create",
+						ActorGUID:  "other-user-guid",
+						ActorType:  "user",
+						ActorName:  "someone",
+						TargetGUID: "service-instance-guid",
+						TargetType: "service_instance",
+					},
+				},
+				ccv3.Warnings{"events-warning"},
+				nil,
+			)
 		})
 
 		JustBeforeEach(func() {
-			events, warnings, err = actor.GetRecentEventsByApplicationNameAndSpace(appName, spaceGUID)
+			events, warnings, err = actor.GetEvents(query)
 		})
 
-		When("getting the app succeeds", func() {
+		It("returns every event, most recent first", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("events-warning"))
+			Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+			))
+
+			Expect(events).To(Equal([]Event{
+				{
+					GUID:        "event-1",
+					Type:        "audit.app.update",
+					ActorGUID:   "user-guid",
+					ActorType:   "user",
+					ActorName:   "admin",
+					TargetGUID:  "app-guid",
+					TargetType:  "app",
+					TargetName:  "some-app",
+					SpaceGUID:   "space-guid",
+					Description: "instances: 2",
+				},
+				{
+					GUID:       "event-2",
+					Type:       "audit.service_instance.create",
+					ActorGUID:  "other-user-guid",
+					ActorType:  "user",
+					ActorName:  "someone",
+					TargetGUID: "service-instance-guid",
+					TargetType: "service_instance",
+				},
+			}))
+		})
+
+		When("the query has filters and a page", func() {
 			BeforeEach(func() {
-				apps := []resources.Application{
-					{GUID: "some-app-guid"},
+				query = EventsQuery{
+					OrganizationGUID: "org-guid",
+					Types:            []string{"audit.app.update", "audit.app.delete-request"},
+					Since:            time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC),
+					Until:            time.Date(2021, 3, 5, 5, 6, 7, 0, time.UTC),
+					Page:             2,
+					PerPage:          100,
 				}
+			})
 
-				ccWarnings := ccv3.Warnings{
-					"some-app-warnings",
-				}
+			It("passes them to Cloud Controller", func() {
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+					ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{"audit.app.update", "audit.app.delete-request"}},
+					ccv3.Query{Key: ccv3.CreatedAtsGreaterThanFilter, Values: []string{"2021-03-04T05:06:07Z"}},
+					ccv3.Query{Key: ccv3.CreatedAtsLessThanFilter, Values: []string{"2021-03-05T05:06:07Z"}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{"100"}},
+					ccv3.Query{Key: ccv3.Page, Values: []string{"2"}},
+				))
+			})
+		})
 
-				fakeCloudControllerClient.GetApplicationsReturns(
-					apps,
-					ccWarnings,
-					nil,
-				)
+		When("the query selects targets or a space", func() {
+			BeforeEach(func() {
+				query = EventsQuery{TargetGUIDs: []string{"app-guid"}, SpaceGUID: "space-guid"}
 			})
 
-			When("the cc client returns the list of events", func() {
-				BeforeEach(func() {
-					ccEvents := []ccv3.Event{
-						{GUID: "event-1", Type: "audit.app.wow", Data: map[string]interface{}{"index": "17"}},
-						{GUID: "event-2", Type: "audit.app.cool", Data: map[string]interface{}{"unimportant_key": "23"}},
-					}
-
-					ccWarnings := ccv3.Warnings{
-						"some-event-warnings",
-					}
-
-					fakeCloudControllerClient.GetEventsReturns(
-						ccEvents,
-						ccWarnings,
-						nil,
-					)
-				})
-
-				It("returns the events and warnings", func() {
-					Expect(events).To(Equal([]Event{
-						{GUID: "event-1", Type: "audit.app.wow", Description: "index: 17"},
-						{GUID: "event-2", Type: "audit.app.cool", Description: ""},
-					}))
-					Expect(warnings).To(ConsistOf("some-app-warnings", "some-event-warnings"))
-					Expect(err).NotTo(HaveOccurred())
-				})
+			It("filters on them", func() {
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"app-guid"}},
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+				))
 			})
+		})
 
-			When("the cc client returns an error", func() {
-				BeforeEach(func() {
-					ccWarnings := ccv3.Warnings{
-						"some-event-warnings",
-					}
-
-					fakeCloudControllerClient.GetEventsReturns(
-						nil,
-						ccWarnings,
-						errors.New("failed to get events"),
-					)
-				})
-
-				It("returns the err and warnings", func() {
-					Expect(warnings).To(ConsistOf("some-app-warnings", "some-event-warnings"))
-					Expect(err).To(MatchError("failed to get events"))
-				})
+		When("filtering by actor", func() {
+			It("matches the actor name", func() {
+				events, _, _ = actor.GetEvents(EventsQuery{Actor: "admin"})
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].GUID).To(Equal("event-1"))
+			})
+
+			It("matches the actor GUID", func() {
+				events, _, _ = actor.GetEvents(EventsQuery{Actor: "other-user-guid"})
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].GUID).To(Equal("event-2"))
 			})
 		})
 
-		When("getting the app fails", func() {
+		When("filtering by target type", func() {
 			BeforeEach(func() {
-				ccWarnings := ccv3.Warnings{
-					"some-app-warnings",
-				}
+				query = EventsQuery{TargetType: "service_instance"}
+			})
+
+			It("keeps the events with that target type", func() {
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].GUID).To(Equal("event-2"))
+			})
+		})
 
-				fakeCloudControllerClient.GetApplicationsReturns(
+		When("the event data is not wrapped in a request", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(
+					[]ccv3.Event{
+						{GUID: "event-1", Type: "audit.app.crash", Data: map[string]interface{}{"index": "17"}},
+						{GUID: "event-2", Type: "audit.app.cool", Data: map[string]interface{}{"unimportant_key": "23"}},
+					},
+					nil,
 					nil,
-					ccWarnings,
-					errors.New("failed to get app"),
 				)
 			})
 
-			It("returns the err and warnings", func() {
-				Expect(warnings).To(ConsistOf("some-app-warnings"))
-				Expect(err).To(MatchError("failed to get app"))
+			It("describes the known keys", func() {
+				Expect(events).To(Equal([]Event{
+					{GUID: "event-1", Type: "audit.app.crash", Description: "index: 17"},
+					{GUID: "event-2", Type: "audit.app.cool", Description: ""},
+				}))
+			})
+		})
+
+		When("getting the events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"events-warning"}, errors.New("events-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("events-error"))
+				Expect(warnings).To(ConsistOf("events-warning"))
 			})
 		})
 	})
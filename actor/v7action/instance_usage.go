@@ -0,0 +1,180 @@
+package v7action
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/batcher"
+)
+
+// ProcessCrashEventType is the audit event recorded each time a process
+// instance crashes.
+const ProcessCrashEventType = "audit.app.process.crash"
+
+// cpuSampleWindow is how far back Log Cache is searched for the most recent
+// cpu gauge of each instance.
+const cpuSampleWindow = time.Minute
+
+// InstanceUsage is the current state and resource usage of a process
+// instance. Apps without running instances are represented by a single
+// InstanceUsage whose Index is -1.
+type InstanceUsage struct {
+	AppName      string
+	AppState     constant.ApplicationState
+	ProcessType  string
+	Index        int64
+	State        constant.ProcessInstanceState
+	Uptime       time.Duration
+	CPU          types.NullFloat64
+	MemoryUsage  uint64
+	MemoryQuota  uint64
+	DiskUsage    uint64
+	DiskQuota    uint64
+	LogRate      uint64
+	LogRateLimit int64
+
+	// Crashes is the number of times the instance crashed since the time
+	// passed to GetInstanceUsageForSpace.
+	Crashes int
+}
+
+// GetInstanceUsageForSpace returns the usage of every process instance of
+// every app in the space, ordered by app name, process type and instance
+// index. Failing to read cpu gauges from Log Cache is reported as a warning,
+// leaving CPU unset.
+func (actor Actor) GetInstanceUsageForSpace(spaceGUID string, client sharedaction.LogCacheClient, crashesSince time.Time) ([]InstanceUsage, Warnings, error) {
+	summaries, allWarnings, err := actor.GetAppSummariesForSpace(spaceGUID, "", false)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	crashes, warnings, err := actor.getProcessCrashCounts(summaries, crashesSince)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var usages []InstanceUsage
+	for _, summary := range summaries {
+		var cpu map[string]float64
+		if summary.State == constant.ApplicationStarted {
+			cpu, err = latestCPUSamples(summary.GUID, client)
+			if err != nil {
+				allWarnings = append(allWarnings, err.Error())
+			}
+		}
+
+		var instanceCount int
+		for _, process := range summary.ProcessSummaries {
+			for _, instance := range process.InstanceDetails {
+				usage := InstanceUsage{
+					AppName:      summary.Name,
+					AppState:     summary.State,
+					ProcessType:  process.Type,
+					Index:        instance.Index,
+					State:        instance.State,
+					Uptime:       instance.Uptime,
+					MemoryUsage:  instance.MemoryUsage,
+					MemoryQuota:  instance.MemoryQuota,
+					DiskUsage:    instance.DiskUsage,
+					DiskQuota:    instance.DiskQuota,
+					LogRate:      instance.LogRate,
+					LogRateLimit: instance.LogRateLimit,
+					Crashes:      crashes[processCrashKey(summary.GUID, process.Type, instance.Index)],
+				}
+				if value, ok := cpu[processInstanceKey(process.Type, instance.Index)]; ok {
+					usage.CPU = types.NullFloat64{IsSet: true, Value: value}
+				}
+
+				usages = append(usages, usage)
+				instanceCount++
+			}
+		}
+
+		if instanceCount == 0 {
+			usages = append(usages, InstanceUsage{
+				AppName:  summary.Name,
+				AppState: summary.State,
+				Index:    -1,
+			})
+		}
+	}
+
+	return usages, allWarnings, nil
+}
+
+func (actor Actor) getProcessCrashCounts(summaries []ApplicationSummary, since time.Time) (map[string]int, Warnings, error) {
+	var appGUIDs []string
+	for _, summary := range summaries {
+		appGUIDs = append(appGUIDs, summary.GUID)
+	}
+
+	var events []ccv3.Event
+	warnings, err := batcher.RequestByGUID(appGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		batch, warnings, err := actor.CloudControllerClient.GetEvents(
+			ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: guids},
+			ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{ProcessCrashEventType}},
+			ccv3.Query{Key: ccv3.CreatedAtsGreaterThanFilter, Values: []string{since.UTC().Format(time.RFC3339)}},
+			ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+		)
+		events = append(events, batch...)
+		return warnings, err
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	crashes := map[string]int{}
+	for _, event := range events {
+		index, ok := event.Data["index"].(float64)
+		if !ok {
+			continue
+		}
+
+		// Crash events are recorded against the app, with the crashed
+		// process as the actor.
+		processType := event.ActorName
+		if processType == "" {
+			processType = constant.ProcessTypeWeb
+		}
+		crashes[processCrashKey(event.TargetGUID, processType, int64(index))]++
+	}
+
+	return crashes, Warnings(warnings), nil
+}
+
+func latestCPUSamples(appGUID string, client sharedaction.LogCacheClient) (map[string]float64, error) {
+	now := time.Now()
+	metrics, err := sharedaction.GetContainerMetrics(appGUID, client, now.Add(-cpuSampleWindow), now)
+	if err != nil {
+		return nil, err
+	}
+
+	cpu := map[string]float64{}
+	for _, metric := range metrics {
+		if metric.Name != sharedaction.ContainerMetricCPU {
+			continue
+		}
+
+		index, err := strconv.ParseInt(metric.InstanceID, 10, 64)
+		if err != nil {
+			continue
+		}
+		cpu[processInstanceKey(metric.ProcessType, index)] = metric.Value
+	}
+	return cpu, nil
+}
+
+func processInstanceKey(processType string, index int64) string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(processType), index)
+}
+
+func processCrashKey(appGUID string, processType string, index int64) string {
+	return appGUID + "/" + processInstanceKey(processType, index)
+}
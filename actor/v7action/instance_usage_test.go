@@ -0,0 +1,173 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Instance Usage Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeLogCacheClient        *sharedactionfakes.FakeLogCacheClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+	})
+
+	Describe("GetInstanceUsageForSpace", func() {
+		var (
+			crashesSince time.Time
+			usages       []InstanceUsage
+			warnings     Warnings
+			err          error
+		)
+
+		BeforeEach(func() {
+			crashesSince = time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{
+					{Name: "app-1", GUID: "app-1-guid", State: constant.ApplicationStarted},
+					{Name: "app-2", GUID: "app-2-guid", State: constant.ApplicationStopped},
+				},
+				ccv3.Warnings{"apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessesReturns(
+				[]resources.Process{{GUID: "web-guid", Type: "web", AppGUID: "app-1-guid"}},
+				ccv3.Warnings{"processes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessInstancesReturns(
+				[]ccv3.ProcessInstance{
+					{Index: 0, State: constant.ProcessInstanceRunning, MemoryUsage: 100, MemoryQuota: 200, DiskUsage: 300, DiskQuota: 400, LogRate: 50},
+					{Index: 1, State: constant.ProcessInstanceCrashed},
+				},
+				ccv3.Warnings{"instances-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetEventsReturns(
+				[]ccv3.Event{
+					{Type: ProcessCrashEventType, TargetGUID: "app-1-guid", ActorName: "web", Data: map[string]interface{}{"index": float64(1)}},
+					{Type: ProcessCrashEventType, TargetGUID: "app-1-guid", ActorName: "web", Data: map[string]interface{}{"index": float64(1)}},
+					{Type: ProcessCrashEventType, TargetGUID: "app-1-guid", ActorName: "worker", Data: map[string]interface{}{"index": float64(0)}},
+				},
+				ccv3.Warnings{"events-warning"},
+				nil,
+			)
+			fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+				{
+					Timestamp:  time.Now().Add(-30 * time.Second).UnixNano(),
+					InstanceId: "0",
+					Tags:       map[string]string{"process_type": "web"},
+					Message: &loggregator_v2.Envelope_Gauge{Gauge: &loggregator_v2.Gauge{
+						Metrics: map[string]*loggregator_v2.GaugeValue{"cpu": {Value: 12.5}},
+					}},
+				},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			usages, warnings, err = actor.GetInstanceUsageForSpace("some-space-guid", fakeLogCacheClient, crashesSince)
+		})
+
+		It("returns the usage of every instance of every app", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("apps-warning", "processes-warning", "instances-warning", "events-warning"))
+
+			Expect(usages).To(Equal([]InstanceUsage{
+				{
+					AppName:     "app-1",
+					AppState:    constant.ApplicationStarted,
+					ProcessType: "web",
+					Index:       0,
+					State:       constant.ProcessInstanceRunning,
+					CPU:         types.NullFloat64{IsSet: true, Value: 12.5},
+					MemoryUsage: 100,
+					MemoryQuota: 200,
+					DiskUsage:   300,
+					DiskQuota:   400,
+					LogRate:     50,
+				},
+				{
+					AppName:     "app-1",
+					AppState:    constant.ApplicationStarted,
+					ProcessType: "web",
+					Index:       1,
+					State:       constant.ProcessInstanceCrashed,
+					Crashes:     2,
+				},
+				{
+					AppName:  "app-2",
+					AppState: constant.ApplicationStopped,
+					Index:    -1,
+				},
+			}))
+		})
+
+		It("counts the crash events of the apps since the given time", func() {
+			Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"app-1-guid", "app-2-guid"}},
+				ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{"audit.app.process.crash"}},
+				ccv3.Query{Key: ccv3.CreatedAtsGreaterThanFilter, Values: []string{"2021-03-04T05:06:07Z"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		It("only reads cpu gauges for started apps", func() {
+			Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+			_, sourceID, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+			Expect(sourceID).To(Equal("app-1-guid"))
+			Expect(start).To(BeTemporally("~", time.Now().Add(-time.Minute), time.Second))
+		})
+
+		When("reading cpu gauges fails", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, errors.New("read-error"))
+			})
+
+			It("returns the error as a warning and leaves cpu unset", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("Failed to retrieve metrics from Log Cache: read-error"))
+				Expect(usages[0].CPU.IsSet).To(BeFalse())
+			})
+		})
+
+		When("getting the crash events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"events-warning"}, errors.New("events-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("events-error"))
+				Expect(warnings).To(ContainElement("events-warning"))
+			})
+		})
+
+		When("getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"apps-warning"}, errors.New("apps-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("apps-error"))
+				Expect(warnings).To(ConsistOf("apps-warning"))
+			})
+		})
+	})
+})
@@ -6,28 +6,38 @@ import (
 	"strings"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"github.com/SermoDigital/jose/jws"
 )
 
 func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, Warnings, error) {
+	return actor.GetFilteredStreamingLogsForApplicationByNameAndSpace(appName, spaceGUID, client, sharedaction.LogFilter{})
+}
+
+func (actor Actor) GetFilteredStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return nil, nil, nil, allWarnings, err
 	}
 
-	messages, logErrs, cancelFunc := sharedaction.GetStreamingLogs(app.GUID, client)
+	messages, logErrs, cancelFunc := sharedaction.GetStreamingLogsWithFilter(app.GUID, client, filter)
 
 	return messages, logErrs, cancelFunc, allWarnings, err
 }
 
 func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) ([]sharedaction.LogMessage, Warnings, error) {
+	return actor.GetFilteredRecentLogsForApplicationByNameAndSpace(appName, spaceGUID, client, sharedaction.LogFilter{})
+}
+
+func (actor Actor) GetFilteredRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) ([]sharedaction.LogMessage, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return nil, allWarnings, err
 	}
 
-	logCacheMessages, err := sharedaction.GetRecentLogs(app.GUID, client)
+	logCacheMessages, err := sharedaction.GetRecentLogsWithFilter(app.GUID, client, filter)
 	if err != nil {
 		return nil, allWarnings, err
 	}
@@ -47,6 +57,67 @@ func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spa
 	return logMessages, allWarnings, nil
 }
 
+// GetStreamingLogsForApplicationsInSpace tails the logs of the named apps, or
+// of the apps matching the label selector, as a single stream.
+func (actor Actor) GetStreamingLogsForApplicationsInSpace(appNames []string, labelSelector string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (<-chan sharedaction.AppLogMessage, <-chan error, context.CancelFunc, Warnings, error) {
+	sources, allWarnings, err := actor.appLogSources(appNames, labelSelector, spaceGUID)
+	if err != nil {
+		return nil, nil, nil, allWarnings, err
+	}
+
+	messages, logErrs, cancelFunc := sharedaction.GetStreamingLogsForApps(sources, client, filter)
+
+	return messages, logErrs, cancelFunc, allWarnings, nil
+}
+
+// GetRecentLogsForApplicationsInSpace returns the recent logs of the named
+// apps, or of the apps matching the label selector, in timestamp order.
+func (actor Actor) GetRecentLogsForApplicationsInSpace(appNames []string, labelSelector string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) ([]sharedaction.AppLogMessage, Warnings, error) {
+	sources, allWarnings, err := actor.appLogSources(appNames, labelSelector, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	messages, err := sharedaction.GetRecentLogsForApps(sources, client, filter)
+	return messages, allWarnings, err
+}
+
+func (actor Actor) appLogSources(appNames []string, labelSelector string, spaceGUID string) ([]sharedaction.AppLogSource, Warnings, error) {
+	var allWarnings Warnings
+	var sources []sharedaction.AppLogSource
+
+	if labelSelector != "" {
+		apps, warnings, err := actor.CloudControllerClient.GetApplications(
+			ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+			ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{labelSelector}},
+			ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		if len(apps) == 0 {
+			return nil, allWarnings, actionerror.ApplicationsNotFoundForLabelSelectorError{LabelSelector: labelSelector}
+		}
+
+		for _, app := range apps {
+			sources = append(sources, sharedaction.AppLogSource{GUID: app.GUID, Name: app.Name})
+		}
+		return sources, allWarnings, nil
+	}
+
+	for _, appName := range appNames {
+		app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		sources = append(sources, sharedaction.AppLogSource{GUID: app.GUID, Name: app.Name})
+	}
+
+	return sources, allWarnings, nil
+}
+
 func (actor Actor) ScheduleTokenRefresh(
 	after func(time.Duration) <-chan time.Time,
 	stop chan struct{},
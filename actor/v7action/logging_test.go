@@ -5,6 +5,7 @@ import (
 	"errors"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	. "code.cloudfoundry.org/cli/actor/v7action"
@@ -137,6 +138,107 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("GetRecentLogsForApplicationsInSpace", func() {
+		var (
+			appNames      []string
+			labelSelector string
+			messages      []sharedaction.AppLogMessage
+			warnings      Warnings
+			executeErr    error
+		)
+
+		BeforeEach(func() {
+			appNames = []string{"web", "worker"}
+			labelSelector = ""
+
+			fakeCloudControllerClient.GetApplicationsStub = func(queries ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error) {
+				for _, query := range queries {
+					if query.Key == ccv3.NameFilter {
+						name := query.Values[0]
+						return []resources.Application{{Name: name, GUID: name + "-guid"}}, ccv3.Warnings{"get-" + name + "-warning"}, nil
+					}
+				}
+				return []resources.Application{
+					{Name: "web", GUID: "web-guid"},
+					{Name: "worker", GUID: "worker-guid"},
+				}, ccv3.Warnings{"get-apps-warning"}, nil
+			}
+
+			fakeLogCacheClient.ReadStub = func(_ context.Context, sourceID string, _ time.Time, _ ...logcache.ReadOption) ([]*loggregator_v2.Envelope, error) {
+				timestamp := int64(10)
+				if sourceID == "worker-guid" {
+					timestamp = 5
+				}
+				return []*loggregator_v2.Envelope{
+					{
+						Timestamp: timestamp,
+						SourceId:  sourceID,
+						Message: &loggregator_v2.Envelope_Log{
+							Log: &loggregator_v2.Log{Payload: []byte("message from " + sourceID), Type: loggregator_v2.Log_OUT},
+						},
+					},
+				}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			messages, warnings, executeErr = actor.GetRecentLogsForApplicationsInSpace(appNames, labelSelector, "some-space-guid", fakeLogCacheClient, sharedaction.LogFilter{})
+		})
+
+		It("returns the logs of the named apps in timestamp order", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-web-warning", "get-worker-warning"))
+
+			Expect(messages).To(HaveLen(2))
+			Expect(messages[0].AppName).To(Equal("worker"))
+			Expect(messages[0].Message()).To(Equal("message from worker-guid"))
+			Expect(messages[1].AppName).To(Equal("web"))
+		})
+
+		When("a label selector is provided", func() {
+			BeforeEach(func() {
+				appNames = nil
+				labelSelector = "team=payments"
+			})
+
+			It("returns the logs of the matching apps", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+				Expect(messages).To(HaveLen(2))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+					ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"team=payments"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+				))
+			})
+
+			When("no apps match", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsStub = nil
+					fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, nil)
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError(actionerror.ApplicationsNotFoundForLabelSelectorError{LabelSelector: "team=payments"}))
+					Expect(warnings).To(ConsistOf("get-apps-warning"))
+				})
+			})
+		})
+
+		When("one of the apps cannot be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsStub = nil
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an app not found error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "web"}))
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("GetStreamingLogsForApplicationByNameAndSpace", func() {
 		When("the application can be found", func() {
 			var (
@@ -173,6 +173,51 @@ func (actor *Actor) GetApplicationPackages(appName string, spaceGUID string) ([]
 	return packages, allWarnings, nil
 }
 
+// GetPackageByGUIDAndAppName returns the package with the given GUID, provided
+// it belongs to the app with the given name in the given space.
+func (actor Actor) GetPackageByGUIDAndAppName(packageGUID string, appName string, spaceGUID string) (resources.Package, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return resources.Package{}, allWarnings, err
+	}
+
+	packages, warnings, err := actor.CloudControllerClient.GetPackages(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{packageGUID}},
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{app.GUID}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+		ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return resources.Package{}, allWarnings, err
+	}
+
+	if len(packages) == 0 {
+		return resources.Package{}, allWarnings, actionerror.PackageNotFoundInAppError{GUID: packageGUID, AppName: appName}
+	}
+
+	return packages[0], allWarnings, nil
+}
+
+// DownloadPackage streams the bits of the package into writer, resuming the
+// download if the connection breaks and verifying the bits against the
+// package's checksum. Docker packages have no bits and cannot be downloaded.
+func (actor Actor) DownloadPackage(pkg resources.Package, writer io.Writer, progressBar DownloadProgressBar) (Warnings, error) {
+	if pkg.Type == constant.PackageTypeDocker {
+		return nil, actionerror.PackageNotDownloadableError{GUID: pkg.GUID}
+	}
+
+	return actor.downloadBits(
+		func(offset int64, writer io.Writer) (ccv3.Warnings, error) {
+			return actor.CloudControllerClient.DownloadPackage(pkg.GUID, offset, writer)
+		},
+		pkg.Checksum.Type,
+		pkg.Checksum.Value,
+		writer,
+		progressBar,
+	)
+}
+
 func (actor Actor) CreateBitsPackageByApplication(appGUID string) (resources.Package, Warnings, error) {
 	inputPackage := resources.Package{
 		Type: constant.PackageTypeBits,
@@ -810,6 +810,143 @@ var _ = Describe("Package Actions", func() {
 		})
 	})
 
+	Describe("GetPackageByGUIDAndAppName", func() {
+		var (
+			pkg          resources.Package
+			warnings     Warnings
+			executionErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{Name: "some-app-name", GUID: "some-app-guid"}},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetPackagesReturns(
+				[]resources.Package{{GUID: "some-package-guid"}},
+				ccv3.Warnings{"get-packages-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			pkg, warnings, executionErr = actor.GetPackageByGUIDAndAppName("some-package-guid", "some-app-name", "some-space-guid")
+		})
+
+		When("the package belongs to the app", func() {
+			It("returns the package and all warnings", func() {
+				Expect(executionErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-packages-warning"))
+				Expect(pkg).To(Equal(resources.Package{GUID: "some-package-guid"}))
+
+				Expect(fakeCloudControllerClient.GetPackagesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetPackagesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-package-guid"}},
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+					ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+				))
+			})
+		})
+
+		When("the package does not belong to the app", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"get-packages-warning"}, nil)
+			})
+
+			It("returns a PackageNotFoundInAppError and all warnings", func() {
+				Expect(executionErr).To(MatchError(actionerror.PackageNotFoundInAppError{GUID: "some-package-guid", AppName: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-packages-warning"))
+			})
+		})
+
+		When("getting the app fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, errors.New("get-app-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executionErr).To(MatchError("get-app-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.GetPackagesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DownloadPackage", func() {
+		var (
+			fakeProgressBar *v7actionfakes.FakeDownloadProgressBar
+			pkg             resources.Package
+			output          *strings.Builder
+
+			warnings     Warnings
+			executionErr error
+		)
+
+		BeforeEach(func() {
+			fakeProgressBar = new(v7actionfakes.FakeDownloadProgressBar)
+			fakeProgressBar.NewProgressBarWriterWrapperStub = func(writer io.Writer, _ int64) io.Writer {
+				return writer
+			}
+
+			pkg = resources.Package{
+				GUID:     "some-package-guid",
+				Type:     constant.PackageTypeBits,
+				Checksum: resources.BitsChecksum{Type: "sha1", Value: "some-other-checksum"},
+			}
+			output = new(strings.Builder)
+
+			fakeCloudControllerClient.DownloadPackageStub = func(_ string, _ int64, writer io.Writer) (ccv3.Warnings, error) {
+				_, err := writer.Write([]byte("some-bits"))
+				return ccv3.Warnings{"download-warning"}, err
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executionErr = actor.DownloadPackage(pkg, output, fakeProgressBar)
+		})
+
+		When("the package is a bits package", func() {
+			BeforeEach(func() {
+				pkg.Checksum = resources.BitsChecksum{}
+			})
+
+			It("streams the package bits into the writer", func() {
+				Expect(executionErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("download-warning"))
+				Expect(output.String()).To(Equal("some-bits"))
+
+				Expect(fakeCloudControllerClient.DownloadPackageCallCount()).To(Equal(1))
+				guid, offset, _ := fakeCloudControllerClient.DownloadPackageArgsForCall(0)
+				Expect(guid).To(Equal("some-package-guid"))
+				Expect(offset).To(BeZero())
+				Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the bits do not match the package checksum", func() {
+			It("returns a DownloadChecksumMismatchError", func() {
+				mismatchErr, ok := executionErr.(actionerror.DownloadChecksumMismatchError)
+				Expect(ok).To(BeTrue())
+				Expect(mismatchErr.Type).To(Equal("sha1"))
+				Expect(mismatchErr.Expected).To(Equal("some-other-checksum"))
+				Expect(warnings).To(ConsistOf("download-warning"))
+			})
+		})
+
+		When("the package is a docker package", func() {
+			BeforeEach(func() {
+				pkg.Type = constant.PackageTypeDocker
+			})
+
+			It("returns a PackageNotDownloadableError without downloading", func() {
+				Expect(executionErr).To(MatchError(actionerror.PackageNotDownloadableError{GUID: "some-package-guid"}))
+				Expect(fakeCloudControllerClient.DownloadPackageCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("CreateBitsPackageByApplication", func() {
 		var (
 			appGUID string
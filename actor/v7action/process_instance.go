@@ -46,6 +46,16 @@ func (pi ProcessInstances) Empty() bool {
 	return len(pi) == 0
 }
 
+func (pi ProcessInstances) RunningCount() int {
+	var running int
+	for _, instance := range pi {
+		if instance.State == constant.ProcessInstanceRunning {
+			running++
+		}
+	}
+	return running
+}
+
 func (actor Actor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, instanceIndex int) (Warnings, error) {
 	var allWarnings Warnings
 	app, appWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
@@ -0,0 +1,78 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// GetApplicationSidecars returns the sidecars of the app with the given name
+// in the given space.
+func (actor Actor) GetApplicationSidecars(appName string, spaceGUID string) ([]resources.Sidecar, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	sidecars, warnings, err := actor.CloudControllerClient.GetApplicationSidecars(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+
+	return sidecars, allWarnings, err
+}
+
+// CreateApplicationSidecar creates the given sidecar for the app with the
+// given name in the given space.
+func (actor Actor) CreateApplicationSidecar(appName string, spaceGUID string, sidecar resources.Sidecar) (resources.Sidecar, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return resources.Sidecar{}, allWarnings, err
+	}
+
+	createdSidecar, warnings, err := actor.CloudControllerClient.CreateApplicationSidecar(app.GUID, sidecar)
+	allWarnings = append(allWarnings, warnings...)
+
+	return createdSidecar, allWarnings, err
+}
+
+// UpdateApplicationSidecar applies the fields that are set in changes to the
+// sidecar with the given name of the app with the given name.
+func (actor Actor) UpdateApplicationSidecar(appName string, spaceGUID string, sidecarName string, changes resources.Sidecar) (resources.Sidecar, Warnings, error) {
+	sidecar, allWarnings, err := actor.getApplicationSidecarByName(appName, spaceGUID, sidecarName)
+	if err != nil {
+		return resources.Sidecar{}, allWarnings, err
+	}
+
+	changes.GUID = sidecar.GUID
+	updatedSidecar, warnings, err := actor.CloudControllerClient.UpdateSidecar(changes)
+	allWarnings = append(allWarnings, warnings...)
+
+	return updatedSidecar, allWarnings, err
+}
+
+// DeleteApplicationSidecar deletes the sidecar with the given name of the app
+// with the given name.
+func (actor Actor) DeleteApplicationSidecar(appName string, spaceGUID string, sidecarName string) (Warnings, error) {
+	sidecar, allWarnings, err := actor.getApplicationSidecarByName(appName, spaceGUID, sidecarName)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err := actor.CloudControllerClient.DeleteSidecar(sidecar.GUID)
+	allWarnings = append(allWarnings, warnings...)
+
+	return allWarnings, err
+}
+
+func (actor Actor) getApplicationSidecarByName(appName string, spaceGUID string, sidecarName string) (resources.Sidecar, Warnings, error) {
+	sidecars, warnings, err := actor.GetApplicationSidecars(appName, spaceGUID)
+	if err != nil {
+		return resources.Sidecar{}, warnings, err
+	}
+
+	for _, sidecar := range sidecars {
+		if sidecar.Name == sidecarName {
+			return sidecar, warnings, nil
+		}
+	}
+
+	return resources.Sidecar{}, warnings, actionerror.SidecarNotFoundError{Name: sidecarName, AppName: appName}
+}
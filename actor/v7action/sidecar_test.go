@@ -0,0 +1,217 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sidecar Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+
+		fakeCloudControllerClient.GetApplicationsReturns(
+			[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+			ccv3.Warnings{"get-app-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetApplicationSidecarsReturns(
+			[]resources.Sidecar{
+				{GUID: "sidecar-1-guid", Name: "sidecar-1"},
+				{GUID: "sidecar-2-guid", Name: "sidecar-2"},
+			},
+			ccv3.Warnings{"get-sidecars-warning"},
+			nil,
+		)
+	})
+
+	Describe("GetApplicationSidecars", func() {
+		var (
+			sidecars   []resources.Sidecar
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			sidecars, warnings, executeErr = actor.GetApplicationSidecars("some-app", "some-space-guid")
+		})
+
+		It("returns the sidecars of the app and all warnings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning"))
+			Expect(sidecars).To(HaveLen(2))
+
+			Expect(fakeCloudControllerClient.GetApplicationSidecarsCallCount()).To(Equal(1))
+			appGUID, _ := fakeCloudControllerClient.GetApplicationSidecarsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationSidecarsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("CreateApplicationSidecar", func() {
+		var (
+			sidecar    resources.Sidecar
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.CreateApplicationSidecarReturns(
+				resources.Sidecar{GUID: "new-sidecar-guid", Name: "new-sidecar"},
+				ccv3.Warnings{"create-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			sidecar, warnings, executeErr = actor.CreateApplicationSidecar("some-app", "some-space-guid", resources.Sidecar{
+				Name:         "new-sidecar",
+				Command:      types.FilteredString{IsSet: true, Value: "start"},
+				ProcessTypes: []string{"web"},
+			})
+		})
+
+		It("creates the sidecar for the app", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "create-warning"))
+			Expect(sidecar).To(Equal(resources.Sidecar{GUID: "new-sidecar-guid", Name: "new-sidecar"}))
+
+			Expect(fakeCloudControllerClient.CreateApplicationSidecarCallCount()).To(Equal(1))
+			appGUID, requested := fakeCloudControllerClient.CreateApplicationSidecarArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(requested.Name).To(Equal("new-sidecar"))
+			Expect(requested.ProcessTypes).To(Equal([]string{"web"}))
+		})
+
+		When("creating the sidecar fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateApplicationSidecarReturns(resources.Sidecar{}, ccv3.Warnings{"create-warning"}, errors.New("create-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("create-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "create-warning"))
+			})
+		})
+	})
+
+	Describe("UpdateApplicationSidecar", func() {
+		var (
+			sidecarName string
+			sidecar     resources.Sidecar
+			warnings    Warnings
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			sidecarName = "sidecar-2"
+			fakeCloudControllerClient.UpdateSidecarReturns(
+				resources.Sidecar{GUID: "sidecar-2-guid", Name: "sidecar-2", MemoryInMB: types.NullUint64{IsSet: true, Value: 64}},
+				ccv3.Warnings{"update-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			sidecar, warnings, executeErr = actor.UpdateApplicationSidecar("some-app", "some-space-guid", sidecarName, resources.Sidecar{
+				MemoryInMB: types.NullUint64{IsSet: true, Value: 64},
+			})
+		})
+
+		It("updates the sidecar with the given name", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning", "update-warning"))
+			Expect(sidecar.MemoryInMB.Value).To(Equal(uint64(64)))
+
+			Expect(fakeCloudControllerClient.UpdateSidecarCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateSidecarArgsForCall(0)).To(Equal(resources.Sidecar{
+				GUID:       "sidecar-2-guid",
+				MemoryInMB: types.NullUint64{IsSet: true, Value: 64},
+			}))
+		})
+
+		When("the app has no sidecar with that name", func() {
+			BeforeEach(func() {
+				sidecarName = "sidecar-3"
+			})
+
+			It("returns a SidecarNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SidecarNotFoundError{Name: "sidecar-3", AppName: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning"))
+				Expect(fakeCloudControllerClient.UpdateSidecarCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DeleteApplicationSidecar", func() {
+		var (
+			sidecarName string
+			warnings    Warnings
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			sidecarName = "sidecar-1"
+			fakeCloudControllerClient.DeleteSidecarReturns(ccv3.Warnings{"delete-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteApplicationSidecar("some-app", "some-space-guid", sidecarName)
+		})
+
+		It("deletes the sidecar with the given name", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning", "delete-warning"))
+
+			Expect(fakeCloudControllerClient.DeleteSidecarCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.DeleteSidecarArgsForCall(0)).To(Equal("sidecar-1-guid"))
+		})
+
+		When("the app has no sidecar with that name", func() {
+			BeforeEach(func() {
+				sidecarName = "sidecar-3"
+			})
+
+			It("returns a SidecarNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SidecarNotFoundError{Name: "sidecar-3", AppName: "some-app"}))
+				Expect(fakeCloudControllerClient.DeleteSidecarCallCount()).To(Equal(0))
+			})
+		})
+
+		When("deleting the sidecar fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteSidecarReturns(ccv3.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning", "delete-warning"))
+			})
+		})
+	})
+})
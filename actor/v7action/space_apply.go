@@ -0,0 +1,491 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/spacebundle"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SpaceNetworkingActor
+
+// SpaceNetworkingActor lists and adds the network policies of a space.
+type SpaceNetworkingActor interface {
+	NetworkPoliciesBySpace(spaceGUID string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+	AddNetworkPolicy(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SpacePushActor
+
+// SpacePushActor pushes an app of a space manifest. v7pushaction.Actor
+// implements it with the push plans of push.
+type SpacePushActor interface {
+	PushSpaceApplication(spaceGUID string, orgGUID string, manifest manifestparser.Manifest, appName string) (Warnings, error)
+}
+
+// SpacePlan holds the changes needed to converge a space with a space bundle.
+// Nothing in the space is ever deleted.
+type SpacePlan struct {
+	SpaceGUID string
+	SpaceName string
+	OrgGUID   string
+	OrgName   string
+
+	ServiceInstances     []spacebundle.ServiceInstance
+	UserProvidedServices []spacebundle.UserProvidedService
+	Routes               []spacebundle.Route
+	RouteMappings        []SpaceRouteMapping
+	NetworkPolicies      []spacebundle.NetworkPolicy
+
+	// ChangedEnvironmentVariableGroups lists the environment variable groups
+	// whose values differ from the bundle. They apply to every app on the
+	// platform, so they are not changed by ApplySpacePlan.
+	ChangedEnvironmentVariableGroups []constant.EnvironmentVariableGroupName
+
+	Manifest     []byte
+	ManifestDiff resources.ManifestDiff
+
+	// Apps are the apps of the manifest that have never been pushed to the
+	// space, in the order they are pushed in.
+	Apps []string
+	// AppsWithoutSource have never been pushed either, but their manifest
+	// entry has neither a path nor a docker image to push them from.
+	AppsWithoutSource []string
+
+	// ApplicationManifest is the parsed form of Manifest, which the apps are
+	// pushed from.
+	ApplicationManifest manifestparser.Manifest
+}
+
+// SpaceRouteMapping is a route of a space bundle that has to be mapped to an
+// app.
+type SpaceRouteMapping struct {
+	Route   spacebundle.Route
+	AppName string
+}
+
+// IsEmpty returns true if applying the plan would not change the space.
+func (plan SpacePlan) IsEmpty() bool {
+	return len(plan.ServiceInstances) == 0 &&
+		len(plan.UserProvidedServices) == 0 &&
+		len(plan.Routes) == 0 &&
+		len(plan.RouteMappings) == 0 &&
+		len(plan.NetworkPolicies) == 0 &&
+		len(plan.ManifestDiff.Diffs) == 0 &&
+		len(plan.Apps) == 0
+}
+
+type SpaceApplyStepType string
+
+const (
+	CreatingServiceInstanceStep     SpaceApplyStepType = "creating-service-instance"
+	CreatingUserProvidedServiceStep SpaceApplyStepType = "creating-user-provided-service"
+	CreatingRouteStep               SpaceApplyStepType = "creating-route"
+	ApplyingManifestStep            SpaceApplyStepType = "applying-manifest"
+	MappingRouteStep                SpaceApplyStepType = "mapping-route"
+	AddingNetworkPolicyStep         SpaceApplyStepType = "adding-network-policy"
+	PushingAppStep                  SpaceApplyStepType = "pushing-app"
+)
+
+// SpaceApplyStep describes a change ApplySpacePlan is about to make.
+type SpaceApplyStep struct {
+	Type SpaceApplyStepType
+	// Name is the name of the service instance or the URL of the route.
+	Name string
+	// AppName is the app the route is mapped to or the app being pushed.
+	AppName       string
+	NetworkPolicy spacebundle.NetworkPolicy
+}
+
+// PlanSpace compares a space with a space bundle and the manifest of its
+// apps, and returns the changes needed to converge them.
+func (actor Actor) PlanSpace(spaceGUID string, spaceName string, orgGUID string, orgName string, manifest manifestparser.Manifest, space spacebundle.Space, networking SpaceNetworkingActor) (SpacePlan, Warnings, error) {
+	rawManifest, err := manifestparser.ManifestParser{}.MarshalManifest(manifest)
+	if err != nil {
+		return SpacePlan{}, nil, err
+	}
+
+	plan := SpacePlan{
+		SpaceGUID:           spaceGUID,
+		SpaceName:           spaceName,
+		OrgGUID:             orgGUID,
+		OrgName:             orgName,
+		Manifest:            rawManifest,
+		ApplicationManifest: manifest,
+	}
+
+	var allWarnings Warnings
+	plan.ManifestDiff, allWarnings, err = actor.DiffSpaceManifest(spaceGUID, rawManifest)
+	if err != nil {
+		return SpacePlan{}, allWarnings, err
+	}
+
+	instances, warnings, err := actor.GetServiceInstancesForSpace(spaceGUID, true)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpacePlan{}, allWarnings, err
+	}
+
+	existingInstances := map[string]bool{}
+	for _, instance := range instances {
+		existingInstances[instance.Name] = true
+	}
+
+	for _, instance := range space.ServiceInstances {
+		if !existingInstances[instance.Name] {
+			plan.ServiceInstances = append(plan.ServiceInstances, instance)
+		}
+	}
+
+	for _, instance := range space.UserProvidedServices {
+		if !existingInstances[instance.Name] {
+			plan.UserProvidedServices = append(plan.UserProvidedServices, instance)
+		}
+	}
+
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpacePlan{}, allWarnings, err
+	}
+
+	err = actor.planSpaceApps(&plan, apps, &allWarnings)
+	if err != nil {
+		return SpacePlan{}, allWarnings, err
+	}
+
+	err = actor.planSpaceRoutes(&plan, apps, space.Routes, &allWarnings)
+	if err != nil {
+		return SpacePlan{}, allWarnings, err
+	}
+
+	policies, networkingWarnings, err := networking.NetworkPoliciesBySpace(spaceGUID)
+	allWarnings = append(allWarnings, networkingWarnings...)
+	if err != nil {
+		return SpacePlan{}, allWarnings, err
+	}
+
+	existingPolicies := map[spacebundle.NetworkPolicy]bool{}
+	for _, policy := range policies {
+		existingPolicies[plan.bundlePolicy(policy)] = true
+	}
+
+	for _, policy := range space.NetworkPolicies {
+		if !existingPolicies[plan.normalizedPolicy(policy)] {
+			plan.NetworkPolicies = append(plan.NetworkPolicies, policy)
+		}
+	}
+
+	groups := []struct {
+		name   constant.EnvironmentVariableGroupName
+		values map[string]string
+	}{
+		{constant.RunningEnvironmentVariableGroup, space.EnvironmentVariableGroups.Running},
+		{constant.StagingEnvironmentVariableGroup, space.EnvironmentVariableGroups.Staging},
+	}
+	for _, group := range groups {
+		if len(group.values) == 0 {
+			continue
+		}
+
+		existing, warnings, err := actor.GetEnvironmentVariableGroup(group.name)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return SpacePlan{}, allWarnings, err
+		}
+
+		if !environmentVariableGroupMatches(existing, group.values) {
+			plan.ChangedEnvironmentVariableGroups = append(plan.ChangedEnvironmentVariableGroups, group.name)
+		}
+	}
+
+	return plan, allWarnings, nil
+}
+
+// ApplySpacePlan makes the changes of the plan, calling handleStep before
+// each of them. It stops at the first change that fails.
+func (actor Actor) ApplySpacePlan(plan SpacePlan, networking SpaceNetworkingActor, pusher SpacePushActor, handleStep func(SpaceApplyStep)) (Warnings, error) {
+	var allWarnings Warnings
+
+	for _, instance := range plan.ServiceInstances {
+		handleStep(SpaceApplyStep{Type: CreatingServiceInstanceStep, Name: instance.Name})
+		err := actor.createSpaceServiceInstance(plan.SpaceGUID, instance, &allWarnings)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	for _, instance := range plan.UserProvidedServices {
+		handleStep(SpaceApplyStep{Type: CreatingUserProvidedServiceStep, Name: instance.Name})
+		warnings, err := actor.CreateUserProvidedServiceInstance(resources.ServiceInstance{
+			Name:            instance.Name,
+			SpaceGUID:       plan.SpaceGUID,
+			Tags:            types.NewOptionalStringSlice(instance.Tags...),
+			SyslogDrainURL:  types.NewOptionalString(instance.SyslogDrainURL),
+			RouteServiceURL: types.NewOptionalString(instance.RouteServiceURL),
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	// Routes are created before the manifest is applied, which maps the routes
+	// of its apps and creates the ones that are missing.
+	for _, route := range plan.Routes {
+		handleStep(SpaceApplyStep{Type: CreatingRouteStep, Name: route.URL()})
+		_, warnings, err := actor.CreateRoute(plan.SpaceGUID, route.Domain, route.Host, route.Path, route.Port, nil)
+		allWarnings = append(allWarnings, warnings...)
+		if _, ok := err.(actionerror.RouteAlreadyExistsError); ok {
+			continue
+		}
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	if len(plan.ManifestDiff.Diffs) > 0 {
+		handleStep(SpaceApplyStep{Type: ApplyingManifestStep})
+		warnings, err := actor.SetSpaceManifest(plan.SpaceGUID, plan.Manifest)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	for _, mapping := range plan.RouteMappings {
+		handleStep(SpaceApplyStep{Type: MappingRouteStep, Name: mapping.Route.URL(), AppName: mapping.AppName})
+		err := actor.mapSpaceRoute(plan.SpaceGUID, mapping, &allWarnings)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	for _, policy := range plan.NetworkPolicies {
+		handleStep(SpaceApplyStep{Type: AddingNetworkPolicyStep, NetworkPolicy: policy})
+		err := actor.addSpaceNetworkPolicy(plan, policy, networking, &allWarnings)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	// Apps are pushed last, so that their services, routes and policies are
+	// in place when they start.
+	for _, appName := range plan.Apps {
+		handleStep(SpaceApplyStep{Type: PushingAppStep, AppName: appName})
+		warnings, err := pusher.PushSpaceApplication(plan.SpaceGUID, plan.OrgGUID, plan.ApplicationManifest, appName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// planSpaceApps finds the apps of the manifest that have never been pushed:
+// apps that are not in the space yet, which applying the manifest creates
+// without code, and apps without a current droplet.
+func (actor Actor) planSpaceApps(plan *SpacePlan, apps []resources.Application, allWarnings *Warnings) error {
+	appGUIDs := map[string]string{}
+	for _, app := range apps {
+		appGUIDs[app.Name] = app.GUID
+	}
+
+	manifestApps, err := plan.ApplicationManifest.ApplicationsInDependencyOrder()
+	if err != nil {
+		return err
+	}
+
+	for _, manifestApp := range manifestApps {
+		if appGUID, exists := appGUIDs[manifestApp.Name]; exists {
+			_, warnings, err := actor.GetCurrentDropletByApplication(appGUID)
+			*allWarnings = append(*allWarnings, warnings...)
+			if err == nil {
+				continue
+			}
+			if _, ok := err.(actionerror.DropletNotFoundError); !ok {
+				return err
+			}
+		}
+
+		if manifestApp.Path == "" && manifestApp.Docker == nil {
+			plan.AppsWithoutSource = append(plan.AppsWithoutSource, manifestApp.Name)
+			continue
+		}
+		plan.Apps = append(plan.Apps, manifestApp.Name)
+	}
+
+	return nil
+}
+
+func (actor Actor) planSpaceRoutes(plan *SpacePlan, apps []resources.Application, bundleRoutes []spacebundle.Route, allWarnings *Warnings) error {
+	appGUIDs := map[string]string{}
+	for _, app := range apps {
+		appGUIDs[app.Name] = app.GUID
+	}
+
+	routes, warnings, err := actor.GetRoutesBySpace(plan.SpaceGUID, "")
+	*allWarnings = append(*allWarnings, warnings...)
+	if err != nil {
+		return err
+	}
+
+	existingRoutes := map[string]resources.Route{}
+	for _, route := range routes {
+		existingRoutes[route.URL] = route
+	}
+
+	for _, route := range bundleRoutes {
+		existingRoute, exists := existingRoutes[route.URL()]
+		if !exists {
+			plan.Routes = append(plan.Routes, route)
+		}
+
+		for _, appName := range route.Apps {
+			if !exists || !routeHasAppDestination(existingRoute, appGUIDs[appName]) {
+				plan.RouteMappings = append(plan.RouteMappings, SpaceRouteMapping{Route: route, AppName: appName})
+			}
+		}
+	}
+
+	return nil
+}
+
+func (actor Actor) createSpaceServiceInstance(spaceGUID string, instance spacebundle.ServiceInstance, allWarnings *Warnings) error {
+	stream, warnings, err := actor.CreateManagedServiceInstance(CreateManagedServiceInstanceParams{
+		ServiceOfferingName: instance.Offering,
+		ServicePlanName:     instance.Plan,
+		ServiceInstanceName: instance.Name,
+		ServiceBrokerName:   instance.Broker,
+		SpaceGUID:           spaceGUID,
+		Tags:                types.NewOptionalStringSlice(instance.Tags...),
+		Parameters:          types.NewOptionalObject(instance.Parameters),
+	})
+	*allWarnings = append(*allWarnings, warnings...)
+	if err != nil || stream == nil {
+		return err
+	}
+
+	// The routes and apps of the space may need the service instance, so
+	// wait for the broker to finish creating it.
+	for event := range stream {
+		*allWarnings = append(*allWarnings, event.Warnings...)
+		if event.Err != nil {
+			return event.Err
+		}
+	}
+
+	return nil
+}
+
+func (actor Actor) mapSpaceRoute(spaceGUID string, mapping SpaceRouteMapping, allWarnings *Warnings) error {
+	domain, warnings, err := actor.GetDomainByName(mapping.Route.Domain)
+	*allWarnings = append(*allWarnings, warnings...)
+	if err != nil {
+		return err
+	}
+
+	route, warnings, err := actor.GetRouteByAttributes(domain, mapping.Route.Host, mapping.Route.Path, mapping.Route.Port)
+	*allWarnings = append(*allWarnings, warnings...)
+	if err != nil {
+		return err
+	}
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(mapping.AppName, spaceGUID)
+	*allWarnings = append(*allWarnings, warnings...)
+	if err != nil {
+		return err
+	}
+
+	// The manifest may already have mapped the route.
+	_, err = actor.GetRouteDestinationByAppGUID(route, app.GUID)
+	switch err.(type) {
+	case nil:
+		return nil
+	case actionerror.RouteDestinationNotFoundError:
+	default:
+		return err
+	}
+
+	warnings, err = actor.MapRoute(route.GUID, app.GUID, "")
+	*allWarnings = append(*allWarnings, warnings...)
+	return err
+}
+
+func (actor Actor) addSpaceNetworkPolicy(plan SpacePlan, policy spacebundle.NetworkPolicy, networking SpaceNetworkingActor, allWarnings *Warnings) error {
+	destinationSpaceGUID := plan.SpaceGUID
+	if policy.DestinationSpace != "" {
+		orgName := policy.DestinationOrg
+		if orgName == "" {
+			orgName = plan.OrgName
+		}
+
+		org, warnings, err := actor.GetOrganizationByName(orgName)
+		*allWarnings = append(*allWarnings, warnings...)
+		if err != nil {
+			return err
+		}
+
+		space, warnings, err := actor.GetSpaceByNameAndOrganization(policy.DestinationSpace, org.GUID)
+		*allWarnings = append(*allWarnings, warnings...)
+		if err != nil {
+			return err
+		}
+		destinationSpaceGUID = space.GUID
+	}
+
+	warnings, err := networking.AddNetworkPolicy(plan.SpaceGUID, policy.Source, destinationSpaceGUID, policy.Destination, policy.Protocol, policy.StartPort, policy.EndPort)
+	*allWarnings = append(*allWarnings, warnings...)
+	return err
+}
+
+// bundlePolicy converts an existing policy to the form export-space writes,
+// where the destination space and org are only set for other spaces.
+func (plan SpacePlan) bundlePolicy(policy cfnetworkingaction.Policy) spacebundle.NetworkPolicy {
+	return plan.normalizedPolicy(spacebundle.NetworkPolicy{
+		Source:           policy.SourceName,
+		Destination:      policy.DestinationName,
+		DestinationSpace: policy.DestinationSpaceName,
+		DestinationOrg:   policy.DestinationOrgName,
+		Protocol:         policy.Protocol,
+		StartPort:        policy.StartPort,
+		EndPort:          policy.EndPort,
+	})
+}
+
+func (plan SpacePlan) normalizedPolicy(policy spacebundle.NetworkPolicy) spacebundle.NetworkPolicy {
+	if policy.DestinationOrg == "" || policy.DestinationOrg == plan.OrgName {
+		policy.DestinationOrg = ""
+		if policy.DestinationSpace == plan.SpaceName {
+			policy.DestinationSpace = ""
+		}
+	}
+	return policy
+}
+
+func routeHasAppDestination(route resources.Route, appGUID string) bool {
+	for _, destination := range route.Destinations {
+		if appGUID != "" && destination.App.GUID == appGUID {
+			return true
+		}
+	}
+	return false
+}
+
+func environmentVariableGroupMatches(group EnvironmentVariableGroup, values map[string]string) bool {
+	if len(group) != len(values) {
+		return false
+	}
+
+	for key, value := range values {
+		existing, ok := group[key]
+		if !ok || existing.Value != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,490 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/spacebundle"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Apply Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeNetworkingActor       *v7actionfakes.FakeSpaceNetworkingActor
+		fakePushActor             *v7actionfakes.FakeSpacePushActor
+		webDestination            resources.RouteDestination
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+		fakeNetworkingActor = new(v7actionfakes.FakeSpaceNetworkingActor)
+		fakePushActor = new(v7actionfakes.FakeSpacePushActor)
+
+		webDestination = resources.RouteDestination{App: resources.RouteDestinationApp{GUID: "web-guid"}}
+		webDestination.App.Process.Type = constant.ProcessTypeWeb
+	})
+
+	Describe("PlanSpace", func() {
+		var (
+			manifest   manifestparser.Manifest
+			space      spacebundle.Space
+			plan       SpacePlan
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			manifest = manifestparser.Manifest{
+				PathToManifest: "/bundle/manifest.yml",
+				Applications: []manifestparser.Application{
+					{Name: "web", Path: "/bundle/web"},
+					{Name: "worker", Docker: &manifestparser.Docker{Image: "worker-image"}},
+					{Name: "admin"},
+				},
+			}
+			space = spacebundle.Space{
+				ServiceInstances: []spacebundle.ServiceInstance{
+					{Name: "db", Offering: "postgres", Plan: "small"},
+					{Name: "existing-db", Offering: "postgres", Plan: "small"},
+				},
+				UserProvidedServices: []spacebundle.UserProvidedService{
+					{Name: "logs"},
+				},
+				Routes: []spacebundle.Route{
+					{Host: "web", Domain: "example.com", Apps: []string{"web"}},
+					{Host: "api", Domain: "example.com", Path: "/v1", Apps: []string{"web"}},
+					{Host: "admin", Domain: "example.com", Apps: []string{"web"}},
+				},
+				NetworkPolicies: []spacebundle.NetworkPolicy{
+					{Source: "web", Destination: "worker", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+					{Source: "web", Destination: "billing", DestinationSpace: "other-space", Protocol: "tcp", StartPort: 9000, EndPort: 9010},
+				},
+			}
+
+			fakeCloudControllerClient.GetSpaceManifestDiffReturns(
+				resources.ManifestDiff{Diffs: []resources.Diff{{Op: resources.AddOperation, Path: "/applications/0"}}},
+				ccv3.Warnings{"diff-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{{Name: "existing-db"}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"instances-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{Name: "web", GUID: "web-guid"}},
+				ccv3.Warnings{"apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRoutesReturns(
+				[]resources.Route{
+					{URL: "web.example.com", Destinations: []resources.RouteDestination{webDestination}},
+					{URL: "admin.example.com"},
+				},
+				ccv3.Warnings{"routes-warning"},
+				nil,
+			)
+			fakeNetworkingActor.NetworkPoliciesBySpaceReturns(
+				[]cfnetworkingaction.Policy{
+					{SourceName: "web", DestinationName: "worker", DestinationSpaceName: "some-space", DestinationOrgName: "some-org", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+				},
+				cfnetworkingaction.Warnings{"policies-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			plan, warnings, executeErr = actor.PlanSpace("some-space-guid", "some-space", "some-org-guid", "some-org", manifest, space, fakeNetworkingActor)
+		})
+
+		It("returns the changes that are missing from the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("diff-warning", "instances-warning", "apps-warning", "routes-warning", "policies-warning"))
+
+			rawManifest, err := manifestparser.ManifestParser{}.MarshalManifest(manifest)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(plan.SpaceGUID).To(Equal("some-space-guid"))
+			Expect(plan.OrgGUID).To(Equal("some-org-guid"))
+			Expect(plan.Manifest).To(Equal(rawManifest))
+			Expect(plan.ApplicationManifest).To(Equal(manifest))
+			Expect(plan.ManifestDiff.Diffs).To(HaveLen(1))
+			Expect(plan.ServiceInstances).To(Equal([]spacebundle.ServiceInstance{{Name: "db", Offering: "postgres", Plan: "small"}}))
+			Expect(plan.UserProvidedServices).To(Equal([]spacebundle.UserProvidedService{{Name: "logs"}}))
+			Expect(plan.Routes).To(Equal([]spacebundle.Route{space.Routes[1]}))
+			Expect(plan.RouteMappings).To(Equal([]SpaceRouteMapping{
+				{Route: space.Routes[1], AppName: "web"},
+				{Route: space.Routes[2], AppName: "web"},
+			}))
+			Expect(plan.NetworkPolicies).To(Equal([]spacebundle.NetworkPolicy{space.NetworkPolicies[1]}))
+			Expect(plan.ChangedEnvironmentVariableGroups).To(BeEmpty())
+			Expect(plan.Apps).To(Equal([]string{"worker"}))
+			Expect(plan.AppsWithoutSource).To(Equal([]string{"admin"}))
+			Expect(plan.IsEmpty()).To(BeFalse())
+
+			Expect(fakeCloudControllerClient.GetSpaceManifestDiffCallCount()).To(Equal(1))
+			spaceGUID, diffedManifest := fakeCloudControllerClient.GetSpaceManifestDiffArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(diffedManifest).To(Equal(rawManifest))
+
+			Expect(fakeCloudControllerClient.GetApplicationDropletCurrentCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("web-guid"))
+
+			Expect(fakeNetworkingActor.NetworkPoliciesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			Expect(fakeCloudControllerClient.GetEnvironmentVariableGroupCallCount()).To(Equal(0))
+		})
+
+		When("an app of the space has never been pushed", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(resources.Droplet{}, ccv3.Warnings{"droplet-warning"}, ccerror.DropletNotFoundError{})
+			})
+
+			It("pushes it too", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("droplet-warning"))
+				Expect(plan.Apps).To(Equal([]string{"web", "worker"}))
+			})
+		})
+
+		When("getting the current droplet fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(resources.Droplet{}, ccv3.Warnings{"droplet-warning"}, errors.New("droplet-error"))
+			})
+
+			It("returns the error and the warnings", func() {
+				Expect(executeErr).To(MatchError("droplet-error"))
+				Expect(warnings).To(ContainElement("droplet-warning"))
+			})
+		})
+
+		When("the bundle has environment variable groups", func() {
+			BeforeEach(func() {
+				space.EnvironmentVariableGroups = spacebundle.EnvironmentVariableGroups{
+					Running: map[string]string{"key": "value"},
+					Staging: map[string]string{"key": "value"},
+				}
+				fakeCloudControllerClient.GetEnvironmentVariableGroupStub = func(group constant.EnvironmentVariableGroupName) (resources.EnvironmentVariables, ccv3.Warnings, error) {
+					if group == constant.RunningEnvironmentVariableGroup {
+						return resources.EnvironmentVariables{"key": {Value: "value", IsSet: true}}, ccv3.Warnings{"running-warning"}, nil
+					}
+					return resources.EnvironmentVariables{"key": {Value: "other-value", IsSet: true}}, ccv3.Warnings{"staging-warning"}, nil
+				}
+			})
+
+			It("returns the groups that differ without changing them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElements("running-warning", "staging-warning"))
+				Expect(plan.ChangedEnvironmentVariableGroups).To(Equal([]constant.EnvironmentVariableGroupName{constant.StagingEnvironmentVariableGroup}))
+				Expect(fakeCloudControllerClient.UpdateEnvironmentVariableGroupCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the space already matches the bundle", func() {
+			BeforeEach(func() {
+				manifest.Applications = manifest.Applications[:1]
+				fakeCloudControllerClient.GetSpaceManifestDiffReturns(resources.ManifestDiff{}, nil, nil)
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]resources.ServiceInstance{{Name: "db"}, {Name: "existing-db"}, {Name: "logs"}},
+					ccv3.IncludedResources{},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]resources.Route{
+						{URL: "web.example.com", Destinations: []resources.RouteDestination{webDestination}},
+						{URL: "api.example.com/v1", Destinations: []resources.RouteDestination{webDestination}},
+						{URL: "admin.example.com", Destinations: []resources.RouteDestination{webDestination}},
+					},
+					nil,
+					nil,
+				)
+				fakeNetworkingActor.NetworkPoliciesBySpaceReturns(
+					[]cfnetworkingaction.Policy{
+						{SourceName: "web", DestinationName: "worker", DestinationSpaceName: "some-space", DestinationOrgName: "some-org", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+						{SourceName: "web", DestinationName: "billing", DestinationSpaceName: "other-space", DestinationOrgName: "some-org", Protocol: "tcp", StartPort: 9000, EndPort: 9010},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns an empty plan", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plan.IsEmpty()).To(BeTrue())
+			})
+		})
+
+		When("getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"routes-warning"}, errors.New("routes-error"))
+			})
+
+			It("returns the error and the warnings", func() {
+				Expect(executeErr).To(MatchError("routes-error"))
+				Expect(warnings).To(ContainElement("routes-warning"))
+				Expect(fakeNetworkingActor.NetworkPoliciesBySpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the network policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingActor.NetworkPoliciesBySpaceReturns(nil, cfnetworkingaction.Warnings{"policies-warning"}, errors.New("policies-error"))
+			})
+
+			It("returns the error and the warnings", func() {
+				Expect(executeErr).To(MatchError("policies-error"))
+				Expect(warnings).To(ContainElement("policies-warning"))
+			})
+		})
+	})
+
+	Describe("ApplySpacePlan", func() {
+		var (
+			plan       SpacePlan
+			steps      []SpaceApplyStep
+			calls      []string
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			route := spacebundle.Route{Host: "api", Domain: "example.com", Path: "/v1"}
+			plan = SpacePlan{
+				SpaceGUID: "some-space-guid",
+				SpaceName: "some-space",
+				OrgGUID:   "some-org-guid",
+				OrgName:   "some-org",
+				ServiceInstances: []spacebundle.ServiceInstance{
+					{Name: "db", Offering: "postgres", Plan: "small", Broker: "some-broker", Tags: []string{"sql"}},
+				},
+				UserProvidedServices: []spacebundle.UserProvidedService{
+					{Name: "logs", SyslogDrainURL: "syslog://logs.example.com"},
+				},
+				Routes:        []spacebundle.Route{route},
+				RouteMappings: []SpaceRouteMapping{{Route: route, AppName: "web"}},
+				NetworkPolicies: []spacebundle.NetworkPolicy{
+					{Source: "web", Destination: "billing", DestinationSpace: "other-space", Protocol: "tcp", StartPort: 9000, EndPort: 9010},
+				},
+				Manifest:     []byte("some-manifest"),
+				ManifestDiff: resources.ManifestDiff{Diffs: []resources.Diff{{Op: resources.AddOperation, Path: "/applications/0"}}},
+				Apps:         []string{"web"},
+				ApplicationManifest: manifestparser.Manifest{
+					Applications: []manifestparser.Application{{Name: "web", Path: "/bundle/web"}},
+				},
+			}
+
+			steps = nil
+			calls = nil
+			fakeCloudControllerClient.GetServicePlansReturns([]resources.ServicePlan{{GUID: "plan-guid", Name: "small"}}, nil, nil)
+			fakeCloudControllerClient.CreateServiceInstanceStub = func(instance resources.ServiceInstance) (ccv3.JobURL, ccv3.Warnings, error) {
+				calls = append(calls, "create-service-instance "+instance.Name)
+				return "some-job-url", ccv3.Warnings{"create-instance-warning-" + instance.Name}, nil
+			}
+			fakeCloudControllerClient.PollJobToEventStreamStub = func(ccv3.JobURL) chan ccv3.PollJobEvent {
+				stream := make(chan ccv3.PollJobEvent, 1)
+				stream <- ccv3.PollJobEvent{State: constant.JobComplete, Warnings: ccv3.Warnings{"poll-warning"}}
+				close(stream)
+				return stream
+			}
+			fakeCloudControllerClient.GetDomainsReturns([]resources.Domain{{GUID: "domain-guid", Name: "example.com"}}, nil, nil)
+			fakeCloudControllerClient.CreateRouteStub = func(route resources.Route) (resources.Route, ccv3.Warnings, error) {
+				calls = append(calls, "create-route")
+				return route, ccv3.Warnings{"create-route-warning"}, nil
+			}
+			fakeCloudControllerClient.UpdateSpaceApplyManifestStub = func(string, []byte) (ccv3.JobURL, ccv3.Warnings, error) {
+				calls = append(calls, "apply-manifest")
+				return "manifest-job-url", ccv3.Warnings{"manifest-warning"}, nil
+			}
+			fakeCloudControllerClient.GetRoutesReturns([]resources.Route{{GUID: "route-guid", URL: "api.example.com/v1"}}, nil, nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{{Name: "web", GUID: "web-guid"}}, nil, nil)
+			fakeCloudControllerClient.MapRouteStub = func(string, string, string) (ccv3.Warnings, error) {
+				calls = append(calls, "map-route")
+				return ccv3.Warnings{"map-warning"}, nil
+			}
+			fakeCloudControllerClient.GetOrganizationsReturns([]resources.Organization{{GUID: "some-org-guid", Name: "some-org"}}, nil, nil)
+			fakeCloudControllerClient.GetSpacesReturns([]resources.Space{{GUID: "other-space-guid", Name: "other-space"}}, ccv3.IncludedResources{}, nil, nil)
+			fakeNetworkingActor.AddNetworkPolicyStub = func(string, string, string, string, string, int, int) (cfnetworkingaction.Warnings, error) {
+				calls = append(calls, "add-network-policy")
+				return cfnetworkingaction.Warnings{"policy-warning"}, nil
+			}
+			fakePushActor.PushSpaceApplicationStub = func(string, string, manifestparser.Manifest, string) (Warnings, error) {
+				calls = append(calls, "push-app")
+				return Warnings{"push-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplySpacePlan(plan, fakeNetworkingActor, fakePushActor, func(step SpaceApplyStep) {
+				steps = append(steps, step)
+			})
+		})
+
+		It("makes the changes in order, creating the routes before applying the manifest and pushing the apps last", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(calls).To(Equal([]string{
+				"create-service-instance db",
+				"create-service-instance logs",
+				"create-route",
+				"apply-manifest",
+				"map-route",
+				"add-network-policy",
+				"push-app",
+			}))
+			Expect(steps).To(Equal([]SpaceApplyStep{
+				{Type: CreatingServiceInstanceStep, Name: "db"},
+				{Type: CreatingUserProvidedServiceStep, Name: "logs"},
+				{Type: CreatingRouteStep, Name: "api.example.com/v1"},
+				{Type: ApplyingManifestStep},
+				{Type: MappingRouteStep, Name: "api.example.com/v1", AppName: "web"},
+				{Type: AddingNetworkPolicyStep, NetworkPolicy: plan.NetworkPolicies[0]},
+				{Type: PushingAppStep, AppName: "web"},
+			}))
+			Expect(warnings).To(ConsistOf(
+				"create-instance-warning-db",
+				"poll-warning",
+				"create-instance-warning-logs",
+				"create-route-warning",
+				"manifest-warning",
+				"map-warning",
+				"policy-warning",
+				"push-warning",
+			))
+		})
+
+		It("pushes the apps from the manifest", func() {
+			Expect(fakePushActor.PushSpaceApplicationCallCount()).To(Equal(1))
+			spaceGUID, orgGUID, manifest, appName := fakePushActor.PushSpaceApplicationArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(manifest).To(Equal(plan.ApplicationManifest))
+			Expect(appName).To(Equal("web"))
+		})
+
+		When("pushing an app fails", func() {
+			BeforeEach(func() {
+				fakePushActor.PushSpaceApplicationReturns(Warnings{"push-warning"}, errors.New("push-error"))
+			})
+
+			It("returns the error and the warnings", func() {
+				Expect(executeErr).To(MatchError("push-error"))
+				Expect(warnings).To(ContainElement("push-warning"))
+			})
+		})
+
+		It("creates the service instances in the space", func() {
+			managed := fakeCloudControllerClient.CreateServiceInstanceArgsForCall(0)
+			Expect(managed.Type).To(Equal(resources.ManagedServiceInstance))
+			Expect(managed.ServicePlanGUID).To(Equal("plan-guid"))
+			Expect(managed.SpaceGUID).To(Equal("some-space-guid"))
+			Expect(managed.Tags).To(Equal(types.NewOptionalStringSlice("sql")))
+
+			userProvided := fakeCloudControllerClient.CreateServiceInstanceArgsForCall(1)
+			Expect(userProvided.Type).To(Equal(resources.UserProvidedServiceInstance))
+			Expect(userProvided.SpaceGUID).To(Equal("some-space-guid"))
+			Expect(userProvided.SyslogDrainURL).To(Equal(types.NewOptionalString("syslog://logs.example.com")))
+		})
+
+		It("creates and maps the routes", func() {
+			route := fakeCloudControllerClient.CreateRouteArgsForCall(0)
+			Expect(route).To(Equal(resources.Route{SpaceGUID: "some-space-guid", DomainGUID: "domain-guid", Host: "api", Path: "/v1"}))
+
+			routeGUID, appGUID, _ := fakeCloudControllerClient.MapRouteArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-guid"))
+			Expect(appGUID).To(Equal("web-guid"))
+		})
+
+		It("adds the network policies to the destination space", func() {
+			srcSpaceGUID, srcApp, destSpaceGUID, destApp, protocol, startPort, endPort := fakeNetworkingActor.AddNetworkPolicyArgsForCall(0)
+			Expect(srcSpaceGUID).To(Equal("some-space-guid"))
+			Expect(srcApp).To(Equal("web"))
+			Expect(destSpaceGUID).To(Equal("other-space-guid"))
+			Expect(destApp).To(Equal("billing"))
+			Expect(protocol).To(Equal("tcp"))
+			Expect(startPort).To(Equal(9000))
+			Expect(endPort).To(Equal(9010))
+		})
+
+		When("a route already exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateRouteStub = nil
+				fakeCloudControllerClient.CreateRouteReturns(resources.Route{}, ccv3.Warnings{"create-route-warning"}, ccerror.RouteNotUniqueError{})
+			})
+
+			It("applies the rest of the plan", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("create-route-warning"))
+				Expect(fakeCloudControllerClient.UpdateSpaceApplyManifestCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.MapRouteCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the manifest already mapped the route", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns([]resources.Route{
+					{GUID: "route-guid", URL: "api.example.com/v1", Destinations: []resources.RouteDestination{webDestination}},
+				}, nil, nil)
+			})
+
+			It("does not map it again", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.MapRouteCallCount()).To(Equal(0))
+				Expect(fakeNetworkingActor.AddNetworkPolicyCallCount()).To(Equal(1))
+			})
+		})
+
+		When("creating a service instance fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.PollJobToEventStreamStub = func(ccv3.JobURL) chan ccv3.PollJobEvent {
+					stream := make(chan ccv3.PollJobEvent, 1)
+					stream <- ccv3.PollJobEvent{State: constant.JobFailed, Err: errors.New("broker-error"), Warnings: ccv3.Warnings{"poll-warning"}}
+					close(stream)
+					return stream
+				}
+			})
+
+			It("stops before changing anything else", func() {
+				Expect(executeErr).To(MatchError("broker-error"))
+				Expect(warnings).To(ConsistOf("create-instance-warning-db", "poll-warning"))
+				Expect(calls).To(Equal([]string{"create-service-instance db"}))
+			})
+		})
+
+		When("applying the manifest fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-manifest-warning"}, errors.New("manifest-error"))
+			})
+
+			It("stops before mapping the routes", func() {
+				Expect(executeErr).To(MatchError("manifest-error"))
+				Expect(warnings).To(ContainElement("poll-manifest-warning"))
+				Expect(fakeCloudControllerClient.MapRouteCallCount()).To(Equal(0))
+				Expect(fakeNetworkingActor.AddNetworkPolicyCallCount()).To(Equal(0))
+				Expect(fakePushActor.PushSpaceApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the manifest has not changed", func() {
+			BeforeEach(func() {
+				plan.ManifestDiff = resources.ManifestDiff{}
+			})
+
+			It("does not apply it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.UpdateSpaceApplyManifestCallCount()).To(Equal(0))
+				Expect(steps).NotTo(ContainElement(SpaceApplyStep{Type: ApplyingManifestStep}))
+			})
+		})
+	})
+})
@@ -0,0 +1,221 @@
+package v7action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/lookuptable"
+	"gopkg.in/yaml.v2"
+)
+
+// SpaceExport is a snapshot of the contents of a space that can be used to
+// recreate it.
+type SpaceExport struct {
+	// Manifest is a multi-app manifest containing every app in the space.
+	Manifest                    []byte
+	ServiceInstances            []ExportedServiceInstance
+	Routes                      []ExportedRoute
+	RunningEnvironmentVariables EnvironmentVariableGroup
+	StagingEnvironmentVariables EnvironmentVariableGroup
+}
+
+// ExportedServiceInstance is a managed or user-provided service instance of
+// an exported space. Credentials of user-provided service instances are not
+// exported.
+type ExportedServiceInstance struct {
+	Name                string
+	Type                resources.ServiceInstanceType
+	ServiceOfferingName string
+	ServicePlanName     string
+	ServiceBrokerName   string
+	Tags                []string
+	Parameters          ServiceInstanceParameters
+	SyslogDrainURL      string
+	RouteServiceURL     string
+}
+
+// ExportedRoute is a route of an exported space along with the names of the
+// apps it is mapped to.
+type ExportedRoute struct {
+	Host       string
+	DomainName string
+	Path       string
+	Port       int
+	Protocol   string
+	AppNames   []string
+}
+
+type rawManifest struct {
+	Applications []yaml.MapSlice `yaml:"applications"`
+}
+
+// ExportSpace gathers the apps, service instances, routes and environment
+// variable groups of a space.
+func (actor Actor) ExportSpace(spaceGUID string) (SpaceExport, Warnings, error) {
+	var export SpaceExport
+
+	apps, allWarnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return SpaceExport{}, allWarnings, err
+	}
+	sort.Slice(apps, func(i, j int) bool { return apps[i].Name < apps[j].Name })
+
+	export.Manifest, err = actor.exportApplicationsManifest(apps, &allWarnings)
+	if err != nil {
+		return SpaceExport{}, allWarnings, err
+	}
+
+	export.ServiceInstances, err = actor.exportServiceInstances(spaceGUID, &allWarnings)
+	if err != nil {
+		return SpaceExport{}, allWarnings, err
+	}
+
+	export.Routes, err = actor.exportRoutes(spaceGUID, apps, &allWarnings)
+	if err != nil {
+		return SpaceExport{}, allWarnings, err
+	}
+
+	var warnings Warnings
+	export.RunningEnvironmentVariables, warnings, err = actor.GetEnvironmentVariableGroup(constant.RunningEnvironmentVariableGroup)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceExport{}, allWarnings, err
+	}
+
+	export.StagingEnvironmentVariables, warnings, err = actor.GetEnvironmentVariableGroup(constant.StagingEnvironmentVariableGroup)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceExport{}, allWarnings, err
+	}
+
+	return export, allWarnings, nil
+}
+
+// exportApplicationsManifest combines the manifests of the apps into a single
+// multi-app manifest, keeping the key order the Cloud Controller generated.
+func (actor Actor) exportApplicationsManifest(apps []resources.Application, allWarnings *Warnings) ([]byte, error) {
+	var combined rawManifest
+	for _, app := range apps {
+		manifestBytes, warnings, err := actor.CloudControllerClient.GetApplicationManifest(app.GUID)
+		*allWarnings = append(*allWarnings, warnings...)
+		if err != nil {
+			return nil, err
+		}
+
+		var appManifest rawManifest
+		err = yaml.Unmarshal(manifestBytes, &appManifest)
+		if err != nil {
+			return nil, err
+		}
+		combined.Applications = append(combined.Applications, appManifest.Applications...)
+	}
+
+	return yaml.Marshal(combined)
+}
+
+func (actor Actor) exportServiceInstances(spaceGUID string, allWarnings *Warnings) ([]ExportedServiceInstance, error) {
+	instances, included, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		ccv3.Query{Key: ccv3.FieldsServicePlan, Values: []string{"guid", "name", "relationships.service_offering"}},
+		ccv3.Query{Key: ccv3.FieldsServicePlanServiceOffering, Values: []string{"guid", "name", "relationships.service_broker"}},
+		ccv3.Query{Key: ccv3.FieldsServicePlanServiceOfferingServiceBroker, Values: []string{"guid", "name"}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	*allWarnings = append(*allWarnings, warnings...)
+	if err != nil {
+		return nil, err
+	}
+
+	planDetailsFromPlanGUIDLookup := buildPlanDetailsLookup(included)
+
+	var exported []ExportedServiceInstance
+	for _, instance := range instances {
+		if instance.SpaceGUID != "" && instance.SpaceGUID != spaceGUID {
+			continue
+		}
+
+		names := planDetailsFromPlanGUIDLookup[instance.ServicePlanGUID]
+		exportedInstance := ExportedServiceInstance{
+			Name:                instance.Name,
+			Type:                instance.Type,
+			ServiceOfferingName: names.offering,
+			ServicePlanName:     names.plan,
+			ServiceBrokerName:   names.broker,
+			Tags:                instance.Tags.Value,
+			SyslogDrainURL:      instance.SyslogDrainURL.Value,
+			RouteServiceURL:     instance.RouteServiceURL.Value,
+		}
+
+		if instance.Type == resources.ManagedServiceInstance {
+			parameters, warnings, err := actor.getServiceInstanceParameters(instance.GUID)
+			*allWarnings = append(*allWarnings, warnings...)
+			switch err.(type) {
+			case nil:
+				exportedInstance.Parameters = parameters
+			case actionerror.ServiceInstanceParamsFetchingNotSupportedError:
+			default:
+				return nil, err
+			}
+		}
+
+		exported = append(exported, exportedInstance)
+	}
+
+	return exported, nil
+}
+
+func (actor Actor) exportRoutes(spaceGUID string, apps []resources.Application, allWarnings *Warnings) ([]ExportedRoute, error) {
+	routes, warnings, err := actor.GetRoutesBySpace(spaceGUID, "")
+	*allWarnings = append(*allWarnings, warnings...)
+	if err != nil || len(routes) == 0 {
+		return nil, err
+	}
+
+	domainGUIDs := map[string]bool{}
+	var uniqueDomainGUIDs []string
+	for _, route := range routes {
+		if !domainGUIDs[route.DomainGUID] {
+			domainGUIDs[route.DomainGUID] = true
+			uniqueDomainGUIDs = append(uniqueDomainGUIDs, route.DomainGUID)
+		}
+	}
+
+	domains, ccWarnings, err := actor.CloudControllerClient.GetDomains(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: uniqueDomainGUIDs},
+	)
+	*allWarnings = append(*allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, err
+	}
+
+	domainNames := lookuptable.NameFromGUID(domains)
+	appNames := lookuptable.NameFromGUID(apps)
+
+	exported := make([]ExportedRoute, 0, len(routes))
+	for _, route := range routes {
+		exportedRoute := ExportedRoute{
+			Host:       route.Host,
+			DomainName: domainNames[route.DomainGUID],
+			Path:       route.Path,
+			Port:       route.Port,
+			Protocol:   route.Protocol,
+		}
+
+		seen := map[string]bool{}
+		for _, destination := range route.Destinations {
+			name, ok := appNames[destination.App.GUID]
+			if ok && !seen[name] {
+				seen[name] = true
+				exportedRoute.AppNames = append(exportedRoute.AppNames, name)
+			}
+		}
+
+		exported = append(exported, exportedRoute)
+	}
+
+	return exported, nil
+}
@@ -0,0 +1,222 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Export Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+	})
+
+	Describe("ExportSpace", func() {
+		var (
+			export     SpaceExport
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{
+					{GUID: "worker-guid", Name: "worker"},
+					{GUID: "web-guid", Name: "web"},
+				},
+				ccv3.Warnings{"get-apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationManifestStub = func(appGUID string) ([]byte, ccv3.Warnings, error) {
+				name := map[string]string{"web-guid": "web", "worker-guid": "worker"}[appGUID]
+				return []byte("applications:\n- name: " + name + "\n  memory: 1G\n"), ccv3.Warnings{"manifest-warning-" + name}, nil
+			}
+
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{
+					{
+						GUID:            "db-guid",
+						Name:            "db",
+						Type:            resources.ManagedServiceInstance,
+						SpaceGUID:       "space-guid",
+						ServicePlanGUID: "plan-guid",
+						Tags:            types.NewOptionalStringSlice("sql"),
+					},
+					{
+						GUID:           "logs-guid",
+						Name:           "logs",
+						Type:           resources.UserProvidedServiceInstance,
+						SpaceGUID:      "space-guid",
+						SyslogDrainURL: types.NewOptionalString("syslog://logs.example.com"),
+					},
+					{
+						GUID:      "shared-guid",
+						Name:      "shared",
+						Type:      resources.ManagedServiceInstance,
+						SpaceGUID: "other-space-guid",
+					},
+				},
+				ccv3.IncludedResources{
+					ServicePlans:     []resources.ServicePlan{{GUID: "plan-guid", Name: "small", ServiceOfferingGUID: "offering-guid"}},
+					ServiceOfferings: []resources.ServiceOffering{{GUID: "offering-guid", Name: "postgres", ServiceBrokerGUID: "broker-guid"}},
+					ServiceBrokers:   []resources.ServiceBroker{{GUID: "broker-guid", Name: "some-broker"}},
+				},
+				ccv3.Warnings{"get-instances-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstanceParametersReturns(
+				types.JSONObject{"size": "small"},
+				ccv3.Warnings{"parameters-warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetRoutesReturns(
+				[]resources.Route{
+					{
+						Host:       "web",
+						DomainGUID: "domain-guid",
+						Path:       "/api",
+						Protocol:   "http",
+						Destinations: []resources.RouteDestination{
+							{App: resources.RouteDestinationApp{GUID: "web-guid"}},
+							{App: resources.RouteDestinationApp{GUID: "web-guid"}},
+						},
+					},
+				},
+				ccv3.Warnings{"get-routes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetDomainsReturns(
+				[]resources.Domain{{GUID: "domain-guid", Name: "example.com"}},
+				ccv3.Warnings{"get-domains-warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetEnvironmentVariableGroupStub = func(group constant.EnvironmentVariableGroupName) (resources.EnvironmentVariables, ccv3.Warnings, error) {
+				return resources.EnvironmentVariables{
+					"GROUP": {Value: string(group), IsSet: true},
+				}, ccv3.Warnings{"env-group-warning-" + string(group)}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			export, warnings, executeErr = actor.ExportSpace("space-guid")
+		})
+
+		It("combines the app manifests in name order", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(export.Manifest)).To(Equal(`applications:
+- name: web
+  memory: 1G
+- name: worker
+  memory: 1G
+`))
+
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+			))
+		})
+
+		It("exports the service instances that belong to the space", func() {
+			Expect(export.ServiceInstances).To(Equal([]ExportedServiceInstance{
+				{
+					Name:                "db",
+					Type:                resources.ManagedServiceInstance,
+					ServiceOfferingName: "postgres",
+					ServicePlanName:     "small",
+					ServiceBrokerName:   "some-broker",
+					Tags:                []string{"sql"},
+					Parameters:          ServiceInstanceParameters{"size": "small"},
+				},
+				{
+					Name:           "logs",
+					Type:           resources.UserProvidedServiceInstance,
+					SyslogDrainURL: "syslog://logs.example.com",
+				},
+			}))
+
+			Expect(fakeCloudControllerClient.GetServiceInstanceParametersCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceInstanceParametersArgsForCall(0)).To(Equal("db-guid"))
+		})
+
+		It("exports the routes with their domain and app names", func() {
+			Expect(export.Routes).To(Equal([]ExportedRoute{
+				{Host: "web", DomainName: "example.com", Path: "/api", Protocol: "http", AppNames: []string{"web"}},
+			}))
+
+			Expect(fakeCloudControllerClient.GetDomainsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"domain-guid"}},
+			))
+		})
+
+		It("exports the environment variable groups and returns all warnings", func() {
+			Expect(export.RunningEnvironmentVariables).To(HaveKeyWithValue("GROUP", types.FilteredString{Value: "running", IsSet: true}))
+			Expect(export.StagingEnvironmentVariables).To(HaveKeyWithValue("GROUP", types.FilteredString{Value: "staging", IsSet: true}))
+
+			Expect(warnings).To(ConsistOf(
+				"get-apps-warning",
+				"manifest-warning-web",
+				"manifest-warning-worker",
+				"get-instances-warning",
+				"parameters-warning",
+				"get-routes-warning",
+				"get-domains-warning",
+				"env-group-warning-running",
+				"env-group-warning-staging",
+			))
+		})
+
+		When("the service broker does not support fetching parameters", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceParametersReturns(
+					nil,
+					ccv3.Warnings{"parameters-warning"},
+					ccerror.ServiceInstanceParametersFetchNotSupportedError{},
+				)
+			})
+
+			It("exports the instance without parameters", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(export.ServiceInstances[0].Name).To(Equal("db"))
+				Expect(export.ServiceInstances[0].Parameters).To(BeEmpty())
+			})
+		})
+
+		When("getting an app manifest fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationManifestStub = nil
+				fakeCloudControllerClient.GetApplicationManifestReturns(nil, ccv3.Warnings{"manifest-warning"}, errors.New("manifest-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("manifest-error"))
+				Expect(warnings).To(ConsistOf("get-apps-warning", "manifest-warning"))
+			})
+		})
+
+		When("getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"get-routes-warning"}, errors.New("routes-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("routes-error"))
+				Expect(warnings).To(ContainElement("get-routes-warning"))
+			})
+		})
+	})
+})
@@ -152,6 +152,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateApplicationSidecarStub        func(string, resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)
+	createApplicationSidecarMutex       sync.RWMutex
+	createApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 resources.Sidecar
+	}
+	createApplicationSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationTaskStub        func(string, resources.Task) (resources.Task, ccv3.Warnings, error)
 	createApplicationTaskMutex       sync.RWMutex
 	createApplicationTaskArgsForCall []struct {
@@ -690,6 +706,19 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeleteSidecarStub        func(string) (ccv3.Warnings, error)
+	deleteSidecarMutex       sync.RWMutex
+	deleteSidecarArgsForCall []struct {
+		arg1 string
+	}
+	deleteSidecarReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	deleteSidecarReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	DeleteSpaceStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
 	deleteSpaceMutex       sync.RWMutex
 	deleteSpaceArgsForCall []struct {
@@ -735,20 +764,35 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
-	DownloadDropletStub        func(string) ([]byte, ccv3.Warnings, error)
+	DownloadDropletStub        func(string, int64, io.Writer) (ccv3.Warnings, error)
 	downloadDropletMutex       sync.RWMutex
 	downloadDropletArgsForCall []struct {
 		arg1 string
+		arg2 int64
+		arg3 io.Writer
 	}
 	downloadDropletReturns struct {
-		result1 []byte
-		result2 ccv3.Warnings
-		result3 error
+		result1 ccv3.Warnings
+		result2 error
 	}
 	downloadDropletReturnsOnCall map[int]struct {
-		result1 []byte
-		result2 ccv3.Warnings
-		result3 error
+		result1 ccv3.Warnings
+		result2 error
+	}
+	DownloadPackageStub        func(string, int64, io.Writer) (ccv3.Warnings, error)
+	downloadPackageMutex       sync.RWMutex
+	downloadPackageArgsForCall []struct {
+		arg1 string
+		arg2 int64
+		arg3 io.Writer
+	}
+	downloadPackageReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	downloadPackageReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
 	}
 	EntitleIsolationSegmentToOrganizationsStub        func(string, []string) (resources.RelationshipList, ccv3.Warnings, error)
 	entitleIsolationSegmentToOrganizationsMutex       sync.RWMutex
@@ -920,6 +964,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationSidecarsStub        func(string, ...ccv3.Query) ([]resources.Sidecar, ccv3.Warnings, error)
+	getApplicationSidecarsMutex       sync.RWMutex
+	getApplicationSidecarsArgsForCall []struct {
+		arg1 string
+		arg2 []ccv3.Query
+	}
+	getApplicationSidecarsReturns struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationSidecarsReturnsOnCall map[int]struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationTasksStub        func(string, ...ccv3.Query) ([]resources.Task, ccv3.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -2632,6 +2692,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateSidecarStub        func(resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)
+	updateSidecarMutex       sync.RWMutex
+	updateSidecarArgsForCall []struct {
+		arg1 resources.Sidecar
+	}
+	updateSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateSpaceStub        func(resources.Space) (resources.Space, ccv3.Warnings, error)
 	updateSpaceMutex       sync.RWMutex
 	updateSpaceArgsForCall []struct {
@@ -3416,6 +3491,74 @@ func (fake *FakeCloudControllerClient) CreateApplicationProcessScaleReturnsOnCal
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateApplicationSidecar(arg1 string, arg2 resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error) {
+	fake.createApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.createApplicationSidecarReturnsOnCall[len(fake.createApplicationSidecarArgsForCall)]
+	fake.createApplicationSidecarArgsForCall = append(fake.createApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 resources.Sidecar
+	}{arg1, arg2})
+	stub := fake.CreateApplicationSidecarStub
+	fakeReturns := fake.createApplicationSidecarReturns
+	fake.recordInvocation("CreateApplicationSidecar", []interface{}{arg1, arg2})
+	fake.createApplicationSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarCallCount() int {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	return len(fake.createApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarCalls(stub func(string, resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarArgsForCall(i int) (string, resources.Sidecar) {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.createApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarReturns(result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	fake.createApplicationSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	if fake.createApplicationSidecarReturnsOnCall == nil {
+		fake.createApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplicationTask(arg1 string, arg2 resources.Task) (resources.Task, ccv3.Warnings, error) {
 	fake.createApplicationTaskMutex.Lock()
 	ret, specificReturn := fake.createApplicationTaskReturnsOnCall[len(fake.createApplicationTaskArgsForCall)]
@@ -5822,6 +5965,70 @@ func (fake *FakeCloudControllerClient) DeleteServicePlanVisibilityReturnsOnCall(
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteSidecar(arg1 string) (ccv3.Warnings, error) {
+	fake.deleteSidecarMutex.Lock()
+	ret, specificReturn := fake.deleteSidecarReturnsOnCall[len(fake.deleteSidecarArgsForCall)]
+	fake.deleteSidecarArgsForCall = append(fake.deleteSidecarArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteSidecarStub
+	fakeReturns := fake.deleteSidecarReturns
+	fake.recordInvocation("DeleteSidecar", []interface{}{arg1})
+	fake.deleteSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarCallCount() int {
+	fake.deleteSidecarMutex.RLock()
+	defer fake.deleteSidecarMutex.RUnlock()
+	return len(fake.deleteSidecarArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarCalls(stub func(string) (ccv3.Warnings, error)) {
+	fake.deleteSidecarMutex.Lock()
+	defer fake.deleteSidecarMutex.Unlock()
+	fake.DeleteSidecarStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarArgsForCall(i int) string {
+	fake.deleteSidecarMutex.RLock()
+	defer fake.deleteSidecarMutex.RUnlock()
+	argsForCall := fake.deleteSidecarArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarReturns(result1 ccv3.Warnings, result2 error) {
+	fake.deleteSidecarMutex.Lock()
+	defer fake.deleteSidecarMutex.Unlock()
+	fake.DeleteSidecarStub = nil
+	fake.deleteSidecarReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.deleteSidecarMutex.Lock()
+	defer fake.deleteSidecarMutex.Unlock()
+	fake.DeleteSidecarStub = nil
+	if fake.deleteSidecarReturnsOnCall == nil {
+		fake.deleteSidecarReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.deleteSidecarReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteSpace(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
 	fake.deleteSpaceMutex.Lock()
 	ret, specificReturn := fake.deleteSpaceReturnsOnCall[len(fake.deleteSpaceArgsForCall)]
@@ -6023,23 +6230,25 @@ func (fake *FakeCloudControllerClient) DeleteUserReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DownloadDroplet(arg1 string) ([]byte, ccv3.Warnings, error) {
+func (fake *FakeCloudControllerClient) DownloadDroplet(arg1 string, arg2 int64, arg3 io.Writer) (ccv3.Warnings, error) {
 	fake.downloadDropletMutex.Lock()
 	ret, specificReturn := fake.downloadDropletReturnsOnCall[len(fake.downloadDropletArgsForCall)]
 	fake.downloadDropletArgsForCall = append(fake.downloadDropletArgsForCall, struct {
 		arg1 string
-	}{arg1})
+		arg2 int64
+		arg3 io.Writer
+	}{arg1, arg2, arg3})
 	stub := fake.DownloadDropletStub
 	fakeReturns := fake.downloadDropletReturns
-	fake.recordInvocation("DownloadDroplet", []interface{}{arg1})
+	fake.recordInvocation("DownloadDroplet", []interface{}{arg1, arg2, arg3})
 	fake.downloadDropletMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	return fakeReturns.result1, fakeReturns.result2
 }
 
 func (fake *FakeCloudControllerClient) DownloadDropletCallCount() int {
@@ -6048,46 +6257,109 @@ func (fake *FakeCloudControllerClient) DownloadDropletCallCount() int {
 	return len(fake.downloadDropletArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) DownloadDropletCalls(stub func(string) ([]byte, ccv3.Warnings, error)) {
+func (fake *FakeCloudControllerClient) DownloadDropletCalls(stub func(string, int64, io.Writer) (ccv3.Warnings, error)) {
 	fake.downloadDropletMutex.Lock()
 	defer fake.downloadDropletMutex.Unlock()
 	fake.DownloadDropletStub = stub
 }
 
-func (fake *FakeCloudControllerClient) DownloadDropletArgsForCall(i int) string {
+func (fake *FakeCloudControllerClient) DownloadDropletArgsForCall(i int) (string, int64, io.Writer) {
 	fake.downloadDropletMutex.RLock()
 	defer fake.downloadDropletMutex.RUnlock()
 	argsForCall := fake.downloadDropletArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeCloudControllerClient) DownloadDropletReturns(result1 []byte, result2 ccv3.Warnings, result3 error) {
+func (fake *FakeCloudControllerClient) DownloadDropletReturns(result1 ccv3.Warnings, result2 error) {
 	fake.downloadDropletMutex.Lock()
 	defer fake.downloadDropletMutex.Unlock()
 	fake.DownloadDropletStub = nil
 	fake.downloadDropletReturns = struct {
-		result1 []byte
-		result2 ccv3.Warnings
-		result3 error
-	}{result1, result2, result3}
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) DownloadDropletReturnsOnCall(i int, result1 []byte, result2 ccv3.Warnings, result3 error) {
+func (fake *FakeCloudControllerClient) DownloadDropletReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
 	fake.downloadDropletMutex.Lock()
 	defer fake.downloadDropletMutex.Unlock()
 	fake.DownloadDropletStub = nil
 	if fake.downloadDropletReturnsOnCall == nil {
 		fake.downloadDropletReturnsOnCall = make(map[int]struct {
-			result1 []byte
-			result2 ccv3.Warnings
-			result3 error
+			result1 ccv3.Warnings
+			result2 error
 		})
 	}
 	fake.downloadDropletReturnsOnCall[i] = struct {
-		result1 []byte
-		result2 ccv3.Warnings
-		result3 error
-	}{result1, result2, result3}
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackage(arg1 string, arg2 int64, arg3 io.Writer) (ccv3.Warnings, error) {
+	fake.downloadPackageMutex.Lock()
+	ret, specificReturn := fake.downloadPackageReturnsOnCall[len(fake.downloadPackageArgsForCall)]
+	fake.downloadPackageArgsForCall = append(fake.downloadPackageArgsForCall, struct {
+		arg1 string
+		arg2 int64
+		arg3 io.Writer
+	}{arg1, arg2, arg3})
+	stub := fake.DownloadPackageStub
+	fakeReturns := fake.downloadPackageReturns
+	fake.recordInvocation("DownloadPackage", []interface{}{arg1, arg2, arg3})
+	fake.downloadPackageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackageCallCount() int {
+	fake.downloadPackageMutex.RLock()
+	defer fake.downloadPackageMutex.RUnlock()
+	return len(fake.downloadPackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackageCalls(stub func(string, int64, io.Writer) (ccv3.Warnings, error)) {
+	fake.downloadPackageMutex.Lock()
+	defer fake.downloadPackageMutex.Unlock()
+	fake.DownloadPackageStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackageArgsForCall(i int) (string, int64, io.Writer) {
+	fake.downloadPackageMutex.RLock()
+	defer fake.downloadPackageMutex.RUnlock()
+	argsForCall := fake.downloadPackageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackageReturns(result1 ccv3.Warnings, result2 error) {
+	fake.downloadPackageMutex.Lock()
+	defer fake.downloadPackageMutex.Unlock()
+	fake.DownloadPackageStub = nil
+	fake.downloadPackageReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackageReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.downloadPackageMutex.Lock()
+	defer fake.downloadPackageMutex.Unlock()
+	fake.DownloadPackageStub = nil
+	if fake.downloadPackageReturnsOnCall == nil {
+		fake.downloadPackageReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.downloadPackageReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeCloudControllerClient) EntitleIsolationSegmentToOrganizations(arg1 string, arg2 []string) (resources.RelationshipList, ccv3.Warnings, error) {
@@ -6837,6 +7109,74 @@ func (fake *FakeCloudControllerClient) GetApplicationRoutesReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationSidecars(arg1 string, arg2 ...ccv3.Query) ([]resources.Sidecar, ccv3.Warnings, error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	ret, specificReturn := fake.getApplicationSidecarsReturnsOnCall[len(fake.getApplicationSidecarsArgsForCall)]
+	fake.getApplicationSidecarsArgsForCall = append(fake.getApplicationSidecarsArgsForCall, struct {
+		arg1 string
+		arg2 []ccv3.Query
+	}{arg1, arg2})
+	stub := fake.GetApplicationSidecarsStub
+	fakeReturns := fake.getApplicationSidecarsReturns
+	fake.recordInvocation("GetApplicationSidecars", []interface{}{arg1, arg2})
+	fake.getApplicationSidecarsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsCallCount() int {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	return len(fake.getApplicationSidecarsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsCalls(stub func(string, ...ccv3.Query) ([]resources.Sidecar, ccv3.Warnings, error)) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsArgsForCall(i int) (string, []ccv3.Query) {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	argsForCall := fake.getApplicationSidecarsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsReturns(result1 []resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	fake.getApplicationSidecarsReturns = struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsReturnsOnCall(i int, result1 []resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	if fake.getApplicationSidecarsReturnsOnCall == nil {
+		fake.getApplicationSidecarsReturnsOnCall = make(map[int]struct {
+			result1 []resources.Sidecar
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSidecarsReturnsOnCall[i] = struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationTasks(arg1 string, arg2 ...ccv3.Query) ([]resources.Task, ccv3.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
@@ -14444,6 +14784,73 @@ func (fake *FakeCloudControllerClient) UpdateServicePlanVisibilityReturnsOnCall(
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateSidecar(arg1 resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error) {
+	fake.updateSidecarMutex.Lock()
+	ret, specificReturn := fake.updateSidecarReturnsOnCall[len(fake.updateSidecarArgsForCall)]
+	fake.updateSidecarArgsForCall = append(fake.updateSidecarArgsForCall, struct {
+		arg1 resources.Sidecar
+	}{arg1})
+	stub := fake.UpdateSidecarStub
+	fakeReturns := fake.updateSidecarReturns
+	fake.recordInvocation("UpdateSidecar", []interface{}{arg1})
+	fake.updateSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarCallCount() int {
+	fake.updateSidecarMutex.RLock()
+	defer fake.updateSidecarMutex.RUnlock()
+	return len(fake.updateSidecarArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarCalls(stub func(resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)) {
+	fake.updateSidecarMutex.Lock()
+	defer fake.updateSidecarMutex.Unlock()
+	fake.UpdateSidecarStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarArgsForCall(i int) resources.Sidecar {
+	fake.updateSidecarMutex.RLock()
+	defer fake.updateSidecarMutex.RUnlock()
+	argsForCall := fake.updateSidecarArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarReturns(result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.updateSidecarMutex.Lock()
+	defer fake.updateSidecarMutex.Unlock()
+	fake.UpdateSidecarStub = nil
+	fake.updateSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.updateSidecarMutex.Lock()
+	defer fake.updateSidecarMutex.Unlock()
+	fake.UpdateSidecarStub = nil
+	if fake.updateSidecarReturnsOnCall == nil {
+		fake.updateSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateSpace(arg1 resources.Space) (resources.Space, ccv3.Warnings, error) {
 	fake.updateSpaceMutex.Lock()
 	ret, specificReturn := fake.updateSpaceReturnsOnCall[len(fake.updateSpaceArgsForCall)]
@@ -15215,6 +15622,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.createApplicationDeploymentMutex.RUnlock()
 	fake.createApplicationProcessScaleMutex.RLock()
 	defer fake.createApplicationProcessScaleMutex.RUnlock()
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
 	fake.createApplicationTaskMutex.RLock()
 	defer fake.createApplicationTaskMutex.RUnlock()
 	fake.createBuildMutex.RLock()
@@ -15287,6 +15696,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteServiceInstanceMutex.RUnlock()
 	fake.deleteServicePlanVisibilityMutex.RLock()
 	defer fake.deleteServicePlanVisibilityMutex.RUnlock()
+	fake.deleteSidecarMutex.RLock()
+	defer fake.deleteSidecarMutex.RUnlock()
 	fake.deleteSpaceMutex.RLock()
 	defer fake.deleteSpaceMutex.RUnlock()
 	fake.deleteSpaceQuotaMutex.RLock()
@@ -15295,6 +15706,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteUserMutex.RUnlock()
 	fake.downloadDropletMutex.RLock()
 	defer fake.downloadDropletMutex.RUnlock()
+	fake.downloadPackageMutex.RLock()
+	defer fake.downloadPackageMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationsMutex.RLock()
 	defer fake.entitleIsolationSegmentToOrganizationsMutex.RUnlock()
 	fake.getAppFeatureMutex.RLock()
@@ -15317,6 +15730,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationRevisionsDeployedMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
 	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
@@ -15545,6 +15960,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateServiceInstanceMutex.RUnlock()
 	fake.updateServicePlanVisibilityMutex.RLock()
 	defer fake.updateServicePlanVisibilityMutex.RUnlock()
+	fake.updateSidecarMutex.RLock()
+	defer fake.updateSidecarMutex.RUnlock()
 	fake.updateSpaceMutex.RLock()
 	defer fake.updateSpaceMutex.RUnlock()
 	fake.updateSpaceApplyManifestMutex.RLock()
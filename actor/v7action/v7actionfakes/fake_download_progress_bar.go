@@ -0,0 +1,144 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7actionfakes
+
+import (
+	"io"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+)
+
+type FakeDownloadProgressBar struct {
+	CompleteStub        func()
+	completeMutex       sync.RWMutex
+	completeArgsForCall []struct {
+	}
+	NewProgressBarWriterWrapperStub        func(io.Writer, int64) io.Writer
+	newProgressBarWriterWrapperMutex       sync.RWMutex
+	newProgressBarWriterWrapperArgsForCall []struct {
+		arg1 io.Writer
+		arg2 int64
+	}
+	newProgressBarWriterWrapperReturns struct {
+		result1 io.Writer
+	}
+	newProgressBarWriterWrapperReturnsOnCall map[int]struct {
+		result1 io.Writer
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDownloadProgressBar) Complete() {
+	fake.completeMutex.Lock()
+	fake.completeArgsForCall = append(fake.completeArgsForCall, struct {
+	}{})
+	stub := fake.CompleteStub
+	fake.recordInvocation("Complete", []interface{}{})
+	fake.completeMutex.Unlock()
+	if stub != nil {
+		fake.CompleteStub()
+	}
+}
+
+func (fake *FakeDownloadProgressBar) CompleteCallCount() int {
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	return len(fake.completeArgsForCall)
+}
+
+func (fake *FakeDownloadProgressBar) CompleteCalls(stub func()) {
+	fake.completeMutex.Lock()
+	defer fake.completeMutex.Unlock()
+	fake.CompleteStub = stub
+}
+
+func (fake *FakeDownloadProgressBar) NewProgressBarWriterWrapper(arg1 io.Writer, arg2 int64) io.Writer {
+	fake.newProgressBarWriterWrapperMutex.Lock()
+	ret, specificReturn := fake.newProgressBarWriterWrapperReturnsOnCall[len(fake.newProgressBarWriterWrapperArgsForCall)]
+	fake.newProgressBarWriterWrapperArgsForCall = append(fake.newProgressBarWriterWrapperArgsForCall, struct {
+		arg1 io.Writer
+		arg2 int64
+	}{arg1, arg2})
+	stub := fake.NewProgressBarWriterWrapperStub
+	fakeReturns := fake.newProgressBarWriterWrapperReturns
+	fake.recordInvocation("NewProgressBarWriterWrapper", []interface{}{arg1, arg2})
+	fake.newProgressBarWriterWrapperMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDownloadProgressBar) NewProgressBarWriterWrapperCallCount() int {
+	fake.newProgressBarWriterWrapperMutex.RLock()
+	defer fake.newProgressBarWriterWrapperMutex.RUnlock()
+	return len(fake.newProgressBarWriterWrapperArgsForCall)
+}
+
+func (fake *FakeDownloadProgressBar) NewProgressBarWriterWrapperCalls(stub func(io.Writer, int64) io.Writer) {
+	fake.newProgressBarWriterWrapperMutex.Lock()
+	defer fake.newProgressBarWriterWrapperMutex.Unlock()
+	fake.NewProgressBarWriterWrapperStub = stub
+}
+
+func (fake *FakeDownloadProgressBar) NewProgressBarWriterWrapperArgsForCall(i int) (io.Writer, int64) {
+	fake.newProgressBarWriterWrapperMutex.RLock()
+	defer fake.newProgressBarWriterWrapperMutex.RUnlock()
+	argsForCall := fake.newProgressBarWriterWrapperArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDownloadProgressBar) NewProgressBarWriterWrapperReturns(result1 io.Writer) {
+	fake.newProgressBarWriterWrapperMutex.Lock()
+	defer fake.newProgressBarWriterWrapperMutex.Unlock()
+	fake.NewProgressBarWriterWrapperStub = nil
+	fake.newProgressBarWriterWrapperReturns = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakeDownloadProgressBar) NewProgressBarWriterWrapperReturnsOnCall(i int, result1 io.Writer) {
+	fake.newProgressBarWriterWrapperMutex.Lock()
+	defer fake.newProgressBarWriterWrapperMutex.Unlock()
+	fake.NewProgressBarWriterWrapperStub = nil
+	if fake.newProgressBarWriterWrapperReturnsOnCall == nil {
+		fake.newProgressBarWriterWrapperReturnsOnCall = make(map[int]struct {
+			result1 io.Writer
+		})
+	}
+	fake.newProgressBarWriterWrapperReturnsOnCall[i] = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakeDownloadProgressBar) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	fake.newProgressBarWriterWrapperMutex.RLock()
+	defer fake.newProgressBarWriterWrapperMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDownloadProgressBar) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7action.DownloadProgressBar = new(FakeDownloadProgressBar)
@@ -0,0 +1,123 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7actionfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+type FakeSpacePushActor struct {
+	PushSpaceApplicationStub        func(string, string, manifestparser.Manifest, string) (v7action.Warnings, error)
+	pushSpaceApplicationMutex       sync.RWMutex
+	pushSpaceApplicationArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 manifestparser.Manifest
+		arg4 string
+	}
+	pushSpaceApplicationReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	pushSpaceApplicationReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSpacePushActor) PushSpaceApplication(arg1 string, arg2 string, arg3 manifestparser.Manifest, arg4 string) (v7action.Warnings, error) {
+	fake.pushSpaceApplicationMutex.Lock()
+	ret, specificReturn := fake.pushSpaceApplicationReturnsOnCall[len(fake.pushSpaceApplicationArgsForCall)]
+	fake.pushSpaceApplicationArgsForCall = append(fake.pushSpaceApplicationArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 manifestparser.Manifest
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PushSpaceApplicationStub
+	fakeReturns := fake.pushSpaceApplicationReturns
+	fake.recordInvocation("PushSpaceApplication", []interface{}{arg1, arg2, arg3, arg4})
+	fake.pushSpaceApplicationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSpacePushActor) PushSpaceApplicationCallCount() int {
+	fake.pushSpaceApplicationMutex.RLock()
+	defer fake.pushSpaceApplicationMutex.RUnlock()
+	return len(fake.pushSpaceApplicationArgsForCall)
+}
+
+func (fake *FakeSpacePushActor) PushSpaceApplicationCalls(stub func(string, string, manifestparser.Manifest, string) (v7action.Warnings, error)) {
+	fake.pushSpaceApplicationMutex.Lock()
+	defer fake.pushSpaceApplicationMutex.Unlock()
+	fake.PushSpaceApplicationStub = stub
+}
+
+func (fake *FakeSpacePushActor) PushSpaceApplicationArgsForCall(i int) (string, string, manifestparser.Manifest, string) {
+	fake.pushSpaceApplicationMutex.RLock()
+	defer fake.pushSpaceApplicationMutex.RUnlock()
+	argsForCall := fake.pushSpaceApplicationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSpacePushActor) PushSpaceApplicationReturns(result1 v7action.Warnings, result2 error) {
+	fake.pushSpaceApplicationMutex.Lock()
+	defer fake.pushSpaceApplicationMutex.Unlock()
+	fake.PushSpaceApplicationStub = nil
+	fake.pushSpaceApplicationReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSpacePushActor) PushSpaceApplicationReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.pushSpaceApplicationMutex.Lock()
+	defer fake.pushSpaceApplicationMutex.Unlock()
+	fake.PushSpaceApplicationStub = nil
+	if fake.pushSpaceApplicationReturnsOnCall == nil {
+		fake.pushSpaceApplicationReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.pushSpaceApplicationReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSpacePushActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pushSpaceApplicationMutex.RLock()
+	defer fake.pushSpaceApplicationMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSpacePushActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7action.SpacePushActor = new(FakeSpacePushActor)
@@ -27,6 +27,20 @@ type FakeUAAClient struct {
 		result2 string
 		result3 error
 	}
+	AuthorizationCodeURLStub        func(string, string, string, string) string
+	authorizationCodeURLMutex       sync.RWMutex
+	authorizationCodeURLArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	authorizationCodeURLReturns struct {
+		result1 string
+	}
+	authorizationCodeURLReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CreateUserStub        func(string, string, string) (uaa.User, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -131,6 +145,18 @@ type FakeUAAClient struct {
 	revokeReturnsOnCall map[int]struct {
 		result1 error
 	}
+	StartDeviceAuthorizationStub        func() (uaa.DeviceAuthorization, error)
+	startDeviceAuthorizationMutex       sync.RWMutex
+	startDeviceAuthorizationArgsForCall []struct {
+	}
+	startDeviceAuthorizationReturns struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
+	startDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
 	UpdatePasswordStub        func(string, string, string) error
 	updatePasswordMutex       sync.RWMutex
 	updatePasswordArgsForCall []struct {
@@ -167,15 +193,16 @@ func (fake *FakeUAAClient) Authenticate(arg1 map[string]string, arg2 string, arg
 		arg2 string
 		arg3 constant.GrantType
 	}{arg1, arg2, arg3})
+	stub := fake.AuthenticateStub
+	fakeReturns := fake.authenticateReturns
 	fake.recordInvocation("Authenticate", []interface{}{arg1, arg2, arg3})
 	fake.authenticateMutex.Unlock()
-	if fake.AuthenticateStub != nil {
-		return fake.AuthenticateStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.authenticateReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -227,6 +254,70 @@ func (fake *FakeUAAClient) AuthenticateReturnsOnCall(i int, result1 string, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeUAAClient) AuthorizationCodeURL(arg1 string, arg2 string, arg3 string, arg4 string) string {
+	fake.authorizationCodeURLMutex.Lock()
+	ret, specificReturn := fake.authorizationCodeURLReturnsOnCall[len(fake.authorizationCodeURLArgsForCall)]
+	fake.authorizationCodeURLArgsForCall = append(fake.authorizationCodeURLArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AuthorizationCodeURLStub
+	fakeReturns := fake.authorizationCodeURLReturns
+	fake.recordInvocation("AuthorizationCodeURL", []interface{}{arg1, arg2, arg3, arg4})
+	fake.authorizationCodeURLMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLCallCount() int {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	return len(fake.authorizationCodeURLArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLCalls(stub func(string, string, string, string) string) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = stub
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLArgsForCall(i int) (string, string, string, string) {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	argsForCall := fake.authorizationCodeURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLReturns(result1 string) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = nil
+	fake.authorizationCodeURLReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLReturnsOnCall(i int, result1 string) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = nil
+	if fake.authorizationCodeURLReturnsOnCall == nil {
+		fake.authorizationCodeURLReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.authorizationCodeURLReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeUAAClient) CreateUser(arg1 string, arg2 string, arg3 string) (uaa.User, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -235,15 +326,16 @@ func (fake *FakeUAAClient) CreateUser(arg1 string, arg2 string, arg3 string) (ua
 		arg2 string
 		arg3 string
 	}{arg1, arg2, arg3})
+	stub := fake.CreateUserStub
+	fakeReturns := fake.createUserReturns
 	fake.recordInvocation("CreateUser", []interface{}{arg1, arg2, arg3})
 	fake.createUserMutex.Unlock()
-	if fake.CreateUserStub != nil {
-		return fake.CreateUserStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createUserReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -298,15 +390,16 @@ func (fake *FakeUAAClient) DeleteUser(arg1 string) (uaa.User, error) {
 	fake.deleteUserArgsForCall = append(fake.deleteUserArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.DeleteUserStub
+	fakeReturns := fake.deleteUserReturns
 	fake.recordInvocation("DeleteUser", []interface{}{arg1})
 	fake.deleteUserMutex.Unlock()
-	if fake.DeleteUserStub != nil {
-		return fake.DeleteUserStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteUserReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -360,15 +453,16 @@ func (fake *FakeUAAClient) GetAPIVersion() (string, error) {
 	ret, specificReturn := fake.getAPIVersionReturnsOnCall[len(fake.getAPIVersionArgsForCall)]
 	fake.getAPIVersionArgsForCall = append(fake.getAPIVersionArgsForCall, struct {
 	}{})
+	stub := fake.GetAPIVersionStub
+	fakeReturns := fake.getAPIVersionReturns
 	fake.recordInvocation("GetAPIVersion", []interface{}{})
 	fake.getAPIVersionMutex.Unlock()
-	if fake.GetAPIVersionStub != nil {
-		return fake.GetAPIVersionStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getAPIVersionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -415,15 +509,16 @@ func (fake *FakeUAAClient) GetLoginPrompts() (map[string][]string, error) {
 	ret, specificReturn := fake.getLoginPromptsReturnsOnCall[len(fake.getLoginPromptsArgsForCall)]
 	fake.getLoginPromptsArgsForCall = append(fake.getLoginPromptsArgsForCall, struct {
 	}{})
+	stub := fake.GetLoginPromptsStub
+	fakeReturns := fake.getLoginPromptsReturns
 	fake.recordInvocation("GetLoginPrompts", []interface{}{})
 	fake.getLoginPromptsMutex.Unlock()
-	if fake.GetLoginPromptsStub != nil {
-		return fake.GetLoginPromptsStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getLoginPromptsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -472,15 +567,16 @@ func (fake *FakeUAAClient) GetSSHPasscode(arg1 string, arg2 string) (string, err
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.GetSSHPasscodeStub
+	fakeReturns := fake.getSSHPasscodeReturns
 	fake.recordInvocation("GetSSHPasscode", []interface{}{arg1, arg2})
 	fake.getSSHPasscodeMutex.Unlock()
-	if fake.GetSSHPasscodeStub != nil {
-		return fake.GetSSHPasscodeStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getSSHPasscodeReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -536,15 +632,16 @@ func (fake *FakeUAAClient) ListUsers(arg1 string, arg2 string) ([]uaa.User, erro
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.ListUsersStub
+	fakeReturns := fake.listUsersReturns
 	fake.recordInvocation("ListUsers", []interface{}{arg1, arg2})
 	fake.listUsersMutex.Unlock()
-	if fake.ListUsersStub != nil {
-		return fake.ListUsersStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listUsersReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -599,15 +696,16 @@ func (fake *FakeUAAClient) RefreshAccessToken(arg1 string) (uaa.RefreshedTokens,
 	fake.refreshAccessTokenArgsForCall = append(fake.refreshAccessTokenArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.RefreshAccessTokenStub
+	fakeReturns := fake.refreshAccessTokenReturns
 	fake.recordInvocation("RefreshAccessToken", []interface{}{arg1})
 	fake.refreshAccessTokenMutex.Unlock()
-	if fake.RefreshAccessTokenStub != nil {
-		return fake.RefreshAccessTokenStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.refreshAccessTokenReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -662,15 +760,16 @@ func (fake *FakeUAAClient) Revoke(arg1 string) error {
 	fake.revokeArgsForCall = append(fake.revokeArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.RevokeStub
+	fakeReturns := fake.revokeReturns
 	fake.recordInvocation("Revoke", []interface{}{arg1})
 	fake.revokeMutex.Unlock()
-	if fake.RevokeStub != nil {
-		return fake.RevokeStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.revokeReturns
 	return fakeReturns.result1
 }
 
@@ -716,6 +815,62 @@ func (fake *FakeUAAClient) RevokeReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeUAAClient) StartDeviceAuthorization() (uaa.DeviceAuthorization, error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.startDeviceAuthorizationReturnsOnCall[len(fake.startDeviceAuthorizationArgsForCall)]
+	fake.startDeviceAuthorizationArgsForCall = append(fake.startDeviceAuthorizationArgsForCall, struct {
+	}{})
+	stub := fake.StartDeviceAuthorizationStub
+	fakeReturns := fake.startDeviceAuthorizationReturns
+	fake.recordInvocation("StartDeviceAuthorization", []interface{}{})
+	fake.startDeviceAuthorizationMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationCallCount() int {
+	fake.startDeviceAuthorizationMutex.RLock()
+	defer fake.startDeviceAuthorizationMutex.RUnlock()
+	return len(fake.startDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationCalls(stub func() (uaa.DeviceAuthorization, error)) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationReturns(result1 uaa.DeviceAuthorization, result2 error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = nil
+	fake.startDeviceAuthorizationReturns = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationReturnsOnCall(i int, result1 uaa.DeviceAuthorization, result2 error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = nil
+	if fake.startDeviceAuthorizationReturnsOnCall == nil {
+		fake.startDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 uaa.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.startDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) UpdatePassword(arg1 string, arg2 string, arg3 string) error {
 	fake.updatePasswordMutex.Lock()
 	ret, specificReturn := fake.updatePasswordReturnsOnCall[len(fake.updatePasswordArgsForCall)]
@@ -724,15 +879,16 @@ func (fake *FakeUAAClient) UpdatePassword(arg1 string, arg2 string, arg3 string)
 		arg2 string
 		arg3 string
 	}{arg1, arg2, arg3})
+	stub := fake.UpdatePasswordStub
+	fakeReturns := fake.updatePasswordReturns
 	fake.recordInvocation("UpdatePassword", []interface{}{arg1, arg2, arg3})
 	fake.updatePasswordMutex.Unlock()
-	if fake.UpdatePasswordStub != nil {
-		return fake.UpdatePasswordStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.updatePasswordReturns
 	return fakeReturns.result1
 }
 
@@ -784,15 +940,16 @@ func (fake *FakeUAAClient) ValidateClientUser(arg1 string) error {
 	fake.validateClientUserArgsForCall = append(fake.validateClientUserArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.ValidateClientUserStub
+	fakeReturns := fake.validateClientUserReturns
 	fake.recordInvocation("ValidateClientUser", []interface{}{arg1})
 	fake.validateClientUserMutex.Unlock()
-	if fake.ValidateClientUserStub != nil {
-		return fake.ValidateClientUserStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.validateClientUserReturns
 	return fakeReturns.result1
 }
 
@@ -843,6 +1000,8 @@ func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
 	fake.deleteUserMutex.RLock()
@@ -859,6 +1018,8 @@ func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	defer fake.refreshAccessTokenMutex.RUnlock()
 	fake.revokeMutex.RLock()
 	defer fake.revokeMutex.RUnlock()
+	fake.startDeviceAuthorizationMutex.RLock()
+	defer fake.startDeviceAuthorizationMutex.RUnlock()
 	fake.updatePasswordMutex.RLock()
 	defer fake.updatePasswordMutex.RUnlock()
 	fake.validateClientUserMutex.RLock()
@@ -15,15 +15,36 @@ func (actor Actor) CreatePushPlans(
 	manifest manifestparser.Manifest,
 	overrides FlagOverrides,
 ) ([]PushPlan, v7action.Warnings, error) {
-	var pushPlans []PushPlan
-
 	apps, warnings, err := actor.V7Actor.GetApplicationsByNamesAndSpace(manifest.AppNames(), spaceGUID)
 	if err != nil {
 		return nil, warnings, err
 	}
 	nameToApp := actor.generateAppNameToApplicationMapping(apps)
 
+	pushPlans, err := actor.createPushPlansForApps(spaceGUID, orgGUID, manifest, overrides, nameToApp)
+	return pushPlans, warnings, err
+}
+
+func (actor Actor) createPushPlansForApps(
+	spaceGUID string,
+	orgGUID string,
+	manifest manifestparser.Manifest,
+	overrides FlagOverrides,
+	nameToApp map[string]resources.Application,
+) ([]PushPlan, error) {
+	var pushPlans []PushPlan
+
+	orderedApplications, err := manifest.ApplicationsInDependencyOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	inManifest := map[string]bool{}
 	for _, manifestApplication := range manifest.Applications {
+		inManifest[manifestApplication.Name] = true
+	}
+
+	for _, manifestApplication := range orderedApplications {
 		plan := PushPlan{
 			OrgGUID:     orgGUID,
 			SpaceGUID:   spaceGUID,
@@ -31,6 +52,12 @@ func (actor Actor) CreatePushPlans(
 			BitsPath:    manifestApplication.Path,
 		}
 
+		for _, dependency := range manifestApplication.DependsOn {
+			if inManifest[dependency] {
+				plan.DependsOn = append(plan.DependsOn, dependency)
+			}
+		}
+
 		if manifestApplication.Lifecycle != "" {
 			plan.Application.LifecycleType = manifestApplication.Lifecycle
 		}
@@ -56,14 +83,14 @@ func (actor Actor) CreatePushPlans(
 			var err error
 			plan, err = updatePlan(plan, overrides)
 			if err != nil {
-				return nil, warnings, err
+				return nil, err
 			}
 		}
 
 		pushPlans = append(pushPlans, plan)
 	}
 
-	return pushPlans, warnings, nil
+	return pushPlans, nil
 }
 
 func (actor Actor) generateAppNameToApplicationMapping(applications []resources.Application) map[string]resources.Application {
@@ -139,6 +139,39 @@ var _ = Describe("CreatePushPlans", func() {
 		})
 	})
 
+	When("apps in the manifest depend on each other", func() {
+		BeforeEach(func() {
+			fakeV7Actor.GetApplicationsByNamesAndSpaceReturns(
+				[]resources.Application{
+					{Name: "name-1", GUID: "app-guid-1"},
+					{Name: "name-2", GUID: "app-guid-2"},
+				},
+				nil,
+				nil,
+			)
+			manifest.Applications[0].DependsOn = []string{"name-2", "not-being-pushed"}
+		})
+
+		It("orders the plans after their dependencies", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(pushPlans).To(HaveLen(2))
+			Expect(pushPlans[0].Application.Name).To(Equal("name-2"))
+			Expect(pushPlans[0].DependsOn).To(BeEmpty())
+			Expect(pushPlans[1].Application.Name).To(Equal("name-1"))
+			Expect(pushPlans[1].DependsOn).To(Equal([]string{"name-2"}))
+		})
+
+		When("the dependencies form a cycle", func() {
+			BeforeEach(func() {
+				manifest.Applications[1].DependsOn = []string{"name-1"}
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(manifestparser.DependencyCycleError{Cycle: []string{"name-1", "name-2", "name-1"}}))
+			})
+		})
+	})
+
 	When("lifecycle is overwritten", func() {
 		BeforeEach(func() {
 			flagOverrides = FlagOverrides{
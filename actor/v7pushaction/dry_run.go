@@ -0,0 +1,53 @@
+package v7pushaction
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+// CreateDryRunPushPlans returns the same push plans as CreatePushPlans,
+// without requiring the apps to exist. Since a dry run does not apply the
+// manifest to the space, apps that the push would create are planned with
+// only their name and lifecycle set.
+func (actor Actor) CreateDryRunPushPlans(
+	spaceGUID string,
+	orgGUID string,
+	manifest manifestparser.Manifest,
+	overrides FlagOverrides,
+) ([]PushPlan, v7action.Warnings, error) {
+	var allWarnings v7action.Warnings
+	nameToApp := map[string]resources.Application{}
+
+	for _, manifestApplication := range manifest.Applications {
+		app, warnings, err := actor.V7Actor.GetApplicationByNameAndSpace(manifestApplication.Name, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if _, ok := err.(actionerror.ApplicationNotFoundError); ok {
+			app = resources.Application{Name: manifestApplication.Name}
+			if manifestApplication.Docker != nil {
+				app.LifecycleType = constant.AppLifecycleTypeDocker
+			}
+		} else if err != nil {
+			return nil, allWarnings, err
+		}
+		nameToApp[manifestApplication.Name] = app
+	}
+
+	pushPlans, err := actor.createPushPlansForApps(spaceGUID, orgGUID, manifest, overrides, nameToApp)
+	return pushPlans, allWarnings, err
+}
+
+// MatchPushPlanResources returns which of the plan's resources are already in
+// the resource cache and which would be uploaded by the push.
+func (actor Actor) MatchPushPlanResources(pushPlan PushPlan) ([]sharedaction.V3Resource, []sharedaction.V3Resource, Warnings, error) {
+	for _, resource := range pushPlan.AllResources {
+		if resource.SizeInBytes != 0 {
+			return actor.MatchResources(pushPlan.AllResources)
+		}
+	}
+
+	return nil, pushPlan.AllResources, nil, nil
+}
@@ -0,0 +1,134 @@
+package v7pushaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("dry run", func() {
+	var (
+		pushActor   *Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+	)
+
+	BeforeEach(func() {
+		pushActor, fakeV7Actor, _ = getTestPushActor()
+		pushActor.PreparePushPlanSequence = nil
+	})
+
+	Describe("CreateDryRunPushPlans", func() {
+		var (
+			manifest   manifestparser.Manifest
+			pushPlans  []PushPlan
+			warnings   v7action.Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			manifest = manifestparser.Manifest{
+				Applications: []manifestparser.Application{
+					{Name: "existing-app", Path: "some-path"},
+					{Name: "new-app", Docker: &manifestparser.Docker{Image: "some-image"}},
+				},
+			}
+
+			fakeV7Actor.GetApplicationByNameAndSpaceStub = func(appName string, _ string) (resources.Application, v7action.Warnings, error) {
+				if appName == "existing-app" {
+					return resources.Application{Name: appName, GUID: "existing-app-guid"}, v7action.Warnings{"get-existing-warning"}, nil
+				}
+				return resources.Application{}, v7action.Warnings{"get-new-warning"}, actionerror.ApplicationNotFoundError{Name: appName}
+			}
+		})
+
+		JustBeforeEach(func() {
+			pushPlans, warnings, executeErr = pushActor.CreateDryRunPushPlans("some-space-guid", "some-org-guid", manifest, FlagOverrides{})
+		})
+
+		It("plans existing apps and apps that would be created", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-existing-warning", "get-new-warning"))
+
+			Expect(pushPlans).To(HaveLen(2))
+			Expect(pushPlans[0].Application.GUID).To(Equal("existing-app-guid"))
+			Expect(pushPlans[0].BitsPath).To(Equal("some-path"))
+			Expect(pushPlans[1].Application).To(Equal(resources.Application{
+				Name:          "new-app",
+				LifecycleType: constant.AppLifecycleTypeDocker,
+			}))
+			Expect(pushPlans[1].DockerImageCredentials.Path).To(Equal("some-image"))
+
+			Expect(fakeV7Actor.SetSpaceManifestCallCount()).To(Equal(0))
+			Expect(fakeV7Actor.CreateApplicationInSpaceCallCount()).To(Equal(0))
+		})
+
+		When("getting an app fails", func() {
+			BeforeEach(func() {
+				fakeV7Actor.GetApplicationByNameAndSpaceReturns(resources.Application{}, v7action.Warnings{"get-warning"}, errors.New("get-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-error"))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+
+	Describe("MatchPushPlanResources", func() {
+		var (
+			pushPlan   PushPlan
+			matched    []sharedaction.V3Resource
+			unmatched  []sharedaction.V3Resource
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			matched, unmatched, warnings, executeErr = pushActor.MatchPushPlanResources(pushPlan)
+		})
+
+		When("every resource is empty", func() {
+			BeforeEach(func() {
+				pushPlan = PushPlan{AllResources: []sharedaction.V3Resource{{FilePath: "empty-file"}}}
+			})
+
+			It("does not ask the API and uploads everything", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(0))
+				Expect(matched).To(BeEmpty())
+				Expect(unmatched).To(Equal(pushPlan.AllResources))
+			})
+		})
+
+		When("there are resources with content", func() {
+			BeforeEach(func() {
+				pushPlan = PushPlan{AllResources: []sharedaction.V3Resource{
+					{FilePath: "cached-file", SizeInBytes: 10, Checksum: ccv3.Checksum{Value: "cached-sha"}},
+					{FilePath: "new-file", SizeInBytes: 20, Checksum: ccv3.Checksum{Value: "new-sha"}},
+				}}
+				fakeV7Actor.ResourceMatchReturns(
+					[]sharedaction.V3Resource{pushPlan.AllResources[0]},
+					v7action.Warnings{"match-warning"},
+					nil,
+				)
+			})
+
+			It("splits the resources into matched and unmatched", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("match-warning"))
+				Expect(matched).To(Equal([]sharedaction.V3Resource{pushPlan.AllResources[0]}))
+				Expect(unmatched).To(Equal([]sharedaction.V3Resource{pushPlan.AllResources[1]}))
+			})
+		})
+	})
+})
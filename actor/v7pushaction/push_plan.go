@@ -16,6 +16,9 @@ type PushPlan struct {
 	OrgGUID   string
 
 	Application resources.Application
+	// DependsOn names the apps in the same push that must be running before
+	// this one is pushed.
+	DependsOn []string
 
 	NoStart             bool
 	NoWait              bool
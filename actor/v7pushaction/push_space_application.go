@@ -0,0 +1,45 @@
+package v7pushaction
+
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+// PushSpaceApplication pushes one app of a space manifest with the same push
+// plans as push, without any flag overrides, and waits for it to start. It
+// implements v7action.SpacePushActor for apply-space, which pushes the apps
+// of a space bundle one at a time.
+func (actor Actor) PushSpaceApplication(spaceGUID string, orgGUID string, manifest manifestparser.Manifest, appName string) (v7action.Warnings, error) {
+	overrides := FlagOverrides{AppName: appName}
+
+	manifest, err := actor.HandleFlagOverrides(manifest, overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	pushPlans, allWarnings, err := actor.CreatePushPlans(spaceGUID, orgGUID, manifest, overrides)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	for _, plan := range pushPlans {
+		for event := range actor.Actualize(plan, silentProgressBar{}) {
+			allWarnings = append(allWarnings, event.Warnings...)
+			if event.Err != nil {
+				return allWarnings, event.Err
+			}
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// silentProgressBar does not display upload progress, since apply-space
+// displays a line per app instead.
+type silentProgressBar struct{}
+
+func (silentProgressBar) NewProgressBarWrapper(reader io.Reader, _ int64) io.Reader {
+	return reader
+}
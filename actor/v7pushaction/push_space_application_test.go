@@ -0,0 +1,99 @@
+package v7pushaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PushSpaceApplication", func() {
+	var (
+		pushActor   *Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+
+		manifest     manifestparser.Manifest
+		appName      string
+		actualized   []PushPlan
+		changeAppErr error
+
+		warnings   v7action.Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		pushActor, fakeV7Actor, _ = getTestPushActor()
+
+		manifest = manifestparser.Manifest{
+			Applications: []manifestparser.Application{
+				{Name: "web", Docker: &manifestparser.Docker{Image: "web-image"}},
+				{Name: "worker", Docker: &manifestparser.Docker{Image: "worker-image"}},
+			},
+		}
+		fakeV7Actor.GetApplicationsByNamesAndSpaceReturns(
+			[]resources.Application{{Name: "web", GUID: "web-guid", LifecycleType: constant.AppLifecycleTypeDocker}},
+			v7action.Warnings{"get-apps-warning"},
+			nil,
+		)
+
+		appName = "web"
+		actualized = nil
+		changeAppErr = nil
+		pushActor.ChangeApplicationSequence = func(PushPlan) []ChangeApplicationFunc {
+			return []ChangeApplicationFunc{
+				func(plan PushPlan, _ chan<- *PushEvent, _ ProgressBar) (PushPlan, Warnings, error) {
+					actualized = append(actualized, plan)
+					return plan, Warnings{"change-app-warning"}, changeAppErr
+				},
+			}
+		}
+	})
+
+	JustBeforeEach(func() {
+		warnings, executeErr = pushActor.PushSpaceApplication("some-space-guid", "some-org-guid", manifest, appName)
+	})
+
+	It("pushes only the named app with a push plan", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(warnings).To(ConsistOf("get-apps-warning", "change-app-warning"))
+
+		appNames, spaceGUID := fakeV7Actor.GetApplicationsByNamesAndSpaceArgsForCall(0)
+		Expect(appNames).To(Equal([]string{"web"}))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(actualized).To(HaveLen(1))
+		Expect(actualized[0].Application.GUID).To(Equal("web-guid"))
+		Expect(actualized[0].SpaceGUID).To(Equal("some-space-guid"))
+		Expect(actualized[0].OrgGUID).To(Equal("some-org-guid"))
+		Expect(actualized[0].DockerImageCredentials.Path).To(Equal("web-image"))
+	})
+
+	When("the app is not in the manifest", func() {
+		BeforeEach(func() {
+			appName = "missing"
+		})
+
+		It("returns an error without pushing", func() {
+			Expect(executeErr).To(MatchError(manifestparser.AppNotInManifestError{Name: "missing"}))
+			Expect(fakeV7Actor.GetApplicationsByNamesAndSpaceCallCount()).To(Equal(0))
+			Expect(actualized).To(BeEmpty())
+		})
+	})
+
+	When("pushing the app fails", func() {
+		BeforeEach(func() {
+			changeAppErr = errors.New("push-error")
+		})
+
+		It("returns the error and the warnings", func() {
+			Expect(executeErr).To(MatchError("push-error"))
+			Expect(warnings).To(ConsistOf("get-apps-warning", "change-app-warning"))
+		})
+	})
+})
@@ -68,6 +68,23 @@ type FakeRequester struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	MakeRequestReceiveStreamStub        func(string, internal.Params, string, int64, io.Writer) (ccv3.Warnings, error)
+	makeRequestReceiveStreamMutex       sync.RWMutex
+	makeRequestReceiveStreamArgsForCall []struct {
+		arg1 string
+		arg2 internal.Params
+		arg3 string
+		arg4 int64
+		arg5 io.Writer
+	}
+	makeRequestReceiveStreamReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	makeRequestReceiveStreamReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	MakeRequestSendRawStub        func(string, internal.Params, []byte, string, interface{}) (string, ccv3.Warnings, error)
 	makeRequestSendRawMutex       sync.RWMutex
 	makeRequestSendRawArgsForCall []struct {
@@ -140,9 +157,10 @@ func (fake *FakeRequester) InitializeConnection(arg1 ccv3.TargetSettings) {
 	fake.initializeConnectionArgsForCall = append(fake.initializeConnectionArgsForCall, struct {
 		arg1 ccv3.TargetSettings
 	}{arg1})
+	stub := fake.InitializeConnectionStub
 	fake.recordInvocation("InitializeConnection", []interface{}{arg1})
 	fake.initializeConnectionMutex.Unlock()
-	if fake.InitializeConnectionStub != nil {
+	if stub != nil {
 		fake.InitializeConnectionStub(arg1)
 	}
 }
@@ -171,9 +189,10 @@ func (fake *FakeRequester) InitializeRouter(arg1 string) {
 	fake.initializeRouterArgsForCall = append(fake.initializeRouterArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.InitializeRouterStub
 	fake.recordInvocation("InitializeRouter", []interface{}{arg1})
 	fake.initializeRouterMutex.Unlock()
-	if fake.InitializeRouterStub != nil {
+	if stub != nil {
 		fake.InitializeRouterStub(arg1)
 	}
 }
@@ -203,15 +222,16 @@ func (fake *FakeRequester) MakeListRequest(arg1 ccv3.RequestParams) (ccv3.Includ
 	fake.makeListRequestArgsForCall = append(fake.makeListRequestArgsForCall, struct {
 		arg1 ccv3.RequestParams
 	}{arg1})
+	stub := fake.MakeListRequestStub
+	fakeReturns := fake.makeListRequestReturns
 	fake.recordInvocation("MakeListRequest", []interface{}{arg1})
 	fake.makeListRequestMutex.Unlock()
-	if fake.MakeListRequestStub != nil {
-		return fake.MakeListRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.makeListRequestReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -269,15 +289,16 @@ func (fake *FakeRequester) MakeRequest(arg1 ccv3.RequestParams) (ccv3.JobURL, cc
 	fake.makeRequestArgsForCall = append(fake.makeRequestArgsForCall, struct {
 		arg1 ccv3.RequestParams
 	}{arg1})
+	stub := fake.MakeRequestStub
+	fakeReturns := fake.makeRequestReturns
 	fake.recordInvocation("MakeRequest", []interface{}{arg1})
 	fake.makeRequestMutex.Unlock()
-	if fake.MakeRequestStub != nil {
-		return fake.MakeRequestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.makeRequestReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -337,15 +358,16 @@ func (fake *FakeRequester) MakeRequestReceiveRaw(arg1 string, arg2 internal.Para
 		arg2 internal.Params
 		arg3 string
 	}{arg1, arg2, arg3})
+	stub := fake.MakeRequestReceiveRawStub
+	fakeReturns := fake.makeRequestReceiveRawReturns
 	fake.recordInvocation("MakeRequestReceiveRaw", []interface{}{arg1, arg2, arg3})
 	fake.makeRequestReceiveRawMutex.Unlock()
-	if fake.MakeRequestReceiveRawStub != nil {
-		return fake.MakeRequestReceiveRawStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.makeRequestReceiveRawReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -397,6 +419,74 @@ func (fake *FakeRequester) MakeRequestReceiveRawReturnsOnCall(i int, result1 []b
 	}{result1, result2, result3}
 }
 
+func (fake *FakeRequester) MakeRequestReceiveStream(arg1 string, arg2 internal.Params, arg3 string, arg4 int64, arg5 io.Writer) (ccv3.Warnings, error) {
+	fake.makeRequestReceiveStreamMutex.Lock()
+	ret, specificReturn := fake.makeRequestReceiveStreamReturnsOnCall[len(fake.makeRequestReceiveStreamArgsForCall)]
+	fake.makeRequestReceiveStreamArgsForCall = append(fake.makeRequestReceiveStreamArgsForCall, struct {
+		arg1 string
+		arg2 internal.Params
+		arg3 string
+		arg4 int64
+		arg5 io.Writer
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.MakeRequestReceiveStreamStub
+	fakeReturns := fake.makeRequestReceiveStreamReturns
+	fake.recordInvocation("MakeRequestReceiveStream", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.makeRequestReceiveStreamMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRequester) MakeRequestReceiveStreamCallCount() int {
+	fake.makeRequestReceiveStreamMutex.RLock()
+	defer fake.makeRequestReceiveStreamMutex.RUnlock()
+	return len(fake.makeRequestReceiveStreamArgsForCall)
+}
+
+func (fake *FakeRequester) MakeRequestReceiveStreamCalls(stub func(string, internal.Params, string, int64, io.Writer) (ccv3.Warnings, error)) {
+	fake.makeRequestReceiveStreamMutex.Lock()
+	defer fake.makeRequestReceiveStreamMutex.Unlock()
+	fake.MakeRequestReceiveStreamStub = stub
+}
+
+func (fake *FakeRequester) MakeRequestReceiveStreamArgsForCall(i int) (string, internal.Params, string, int64, io.Writer) {
+	fake.makeRequestReceiveStreamMutex.RLock()
+	defer fake.makeRequestReceiveStreamMutex.RUnlock()
+	argsForCall := fake.makeRequestReceiveStreamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeRequester) MakeRequestReceiveStreamReturns(result1 ccv3.Warnings, result2 error) {
+	fake.makeRequestReceiveStreamMutex.Lock()
+	defer fake.makeRequestReceiveStreamMutex.Unlock()
+	fake.MakeRequestReceiveStreamStub = nil
+	fake.makeRequestReceiveStreamReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRequester) MakeRequestReceiveStreamReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.makeRequestReceiveStreamMutex.Lock()
+	defer fake.makeRequestReceiveStreamMutex.Unlock()
+	fake.MakeRequestReceiveStreamStub = nil
+	if fake.makeRequestReceiveStreamReturnsOnCall == nil {
+		fake.makeRequestReceiveStreamReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.makeRequestReceiveStreamReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRequester) MakeRequestSendRaw(arg1 string, arg2 internal.Params, arg3 []byte, arg4 string, arg5 interface{}) (string, ccv3.Warnings, error) {
 	var arg3Copy []byte
 	if arg3 != nil {
@@ -412,15 +502,16 @@ func (fake *FakeRequester) MakeRequestSendRaw(arg1 string, arg2 internal.Params,
 		arg4 string
 		arg5 interface{}
 	}{arg1, arg2, arg3Copy, arg4, arg5})
+	stub := fake.MakeRequestSendRawStub
+	fakeReturns := fake.makeRequestSendRawReturns
 	fake.recordInvocation("MakeRequestSendRaw", []interface{}{arg1, arg2, arg3Copy, arg4, arg5})
 	fake.makeRequestSendRawMutex.Unlock()
-	if fake.MakeRequestSendRawStub != nil {
-		return fake.MakeRequestSendRawStub(arg1, arg2, arg3, arg4, arg5)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.makeRequestSendRawReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -486,15 +577,16 @@ func (fake *FakeRequester) MakeRequestSendReceiveRaw(arg1 string, arg2 string, a
 		arg3 http.Header
 		arg4 []byte
 	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.MakeRequestSendReceiveRawStub
+	fakeReturns := fake.makeRequestSendReceiveRawReturns
 	fake.recordInvocation("MakeRequestSendReceiveRaw", []interface{}{arg1, arg2, arg3, arg4Copy})
 	fake.makeRequestSendReceiveRawMutex.Unlock()
-	if fake.MakeRequestSendReceiveRawStub != nil {
-		return fake.MakeRequestSendReceiveRawStub(arg1, arg2, arg3, arg4)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.makeRequestSendReceiveRawReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -558,15 +650,16 @@ func (fake *FakeRequester) MakeRequestUploadAsync(arg1 string, arg2 internal.Par
 		arg6 interface{}
 		arg7 <-chan error
 	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	stub := fake.MakeRequestUploadAsyncStub
+	fakeReturns := fake.makeRequestUploadAsyncReturns
 	fake.recordInvocation("MakeRequestUploadAsync", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
 	fake.makeRequestUploadAsyncMutex.Unlock()
-	if fake.MakeRequestUploadAsyncStub != nil {
-		return fake.MakeRequestUploadAsyncStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.makeRequestUploadAsyncReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -623,9 +716,10 @@ func (fake *FakeRequester) WrapConnection(arg1 ccv3.ConnectionWrapper) {
 	fake.wrapConnectionArgsForCall = append(fake.wrapConnectionArgsForCall, struct {
 		arg1 ccv3.ConnectionWrapper
 	}{arg1})
+	stub := fake.WrapConnectionStub
 	fake.recordInvocation("WrapConnection", []interface{}{arg1})
 	fake.wrapConnectionMutex.Unlock()
-	if fake.WrapConnectionStub != nil {
+	if stub != nil {
 		fake.WrapConnectionStub(arg1)
 	}
 }
@@ -662,6 +756,8 @@ func (fake *FakeRequester) Invocations() map[string][][]interface{} {
 	defer fake.makeRequestMutex.RUnlock()
 	fake.makeRequestReceiveRawMutex.RLock()
 	defer fake.makeRequestReceiveRawMutex.RUnlock()
+	fake.makeRequestReceiveStreamMutex.RLock()
+	defer fake.makeRequestReceiveStreamMutex.RUnlock()
 	fake.makeRequestSendRawMutex.RLock()
 	defer fake.makeRequestSendRawMutex.RUnlock()
 	fake.makeRequestSendReceiveRawMutex.RLock()
@@ -118,11 +118,14 @@ func (client *Client) UploadDropletBits(dropletGUID string, dropletPath string,
 	return JobURL(responseLocation), warnings, err
 }
 
-func (client *Client) DownloadDroplet(dropletGUID string) ([]byte, Warnings, error) {
-	bytes, warnings, err := client.MakeRequestReceiveRaw(
+// DownloadDroplet streams the bits of the droplet into writer, starting at
+// offset bytes into the droplet.
+func (client *Client) DownloadDroplet(dropletGUID string, offset int64, writer io.Writer) (Warnings, error) {
+	return client.MakeRequestReceiveStream(
 		internal.GetDropletBitsRequest,
 		internal.Params{"droplet_guid": dropletGUID},
 		"application/json",
+		offset,
+		writer,
 	)
-	return bytes, warnings, err
 }
@@ -1,6 +1,7 @@
 package ccv3_test
 
 import (
+	"bytes"
 	"errors"
 	"io"
 	"mime/multipart"
@@ -423,31 +424,35 @@ var _ = Describe("Droplet", func() {
 
 	Describe("DownloadDroplet", func() {
 		var (
-			dropletBytes []byte
-			warnings     Warnings
-			executeErr   error
+			writer     *bytes.Buffer
+			warnings   Warnings
+			executeErr error
 		)
 
-		JustBeforeEach(func() {
-			dropletBytes, warnings, executeErr = client.DownloadDroplet("some-droplet-guid")
-		})
-
 		BeforeEach(func() {
-			requester.MakeRequestReceiveRawCalls(func(string, internal.Params, string) ([]byte, ccv3.Warnings, error) {
-				return []byte{'d', 'r', 'o', 'p'}, Warnings{"some-warning"}, errors.New("some-error")
+			writer = new(bytes.Buffer)
+			requester.MakeRequestReceiveStreamCalls(func(_ string, _ internal.Params, _ string, _ int64, w io.Writer) (ccv3.Warnings, error) {
+				_, err := w.Write([]byte("drop"))
+				Expect(err).ToNot(HaveOccurred())
+				return Warnings{"some-warning"}, errors.New("some-error")
 			})
 		})
 
+		JustBeforeEach(func() {
+			warnings, executeErr = client.DownloadDroplet("some-droplet-guid", 42, writer)
+		})
+
 		It("makes the correct request", func() {
-			Expect(requester.MakeRequestReceiveRawCallCount()).To(Equal(1))
-			requestType, requestParams, responseType := requester.MakeRequestReceiveRawArgsForCall(0)
+			Expect(requester.MakeRequestReceiveStreamCallCount()).To(Equal(1))
+			requestType, requestParams, responseType, offset, _ := requester.MakeRequestReceiveStreamArgsForCall(0)
 			Expect(requestType).To(Equal(internal.GetDropletBitsRequest))
 			Expect(requestParams).To(Equal(internal.Params{"droplet_guid": "some-droplet-guid"}))
 			Expect(responseType).To(Equal("application/json"))
+			Expect(offset).To(Equal(int64(42)))
 		})
 
-		It("returns the given droplet and all warnings", func() {
-			Expect(dropletBytes).To(Equal([]byte{'d', 'r', 'o', 'p'}))
+		It("streams the droplet into the writer and returns all warnings", func() {
+			Expect(writer.String()).To(Equal("drop"))
 			Expect(warnings).To(ConsistOf("some-warning"))
 			Expect(executeErr).To(MatchError("some-error"))
 		})
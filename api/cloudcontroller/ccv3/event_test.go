@@ -100,10 +100,17 @@ var _ = Describe("Event", func() {
 				Expect(warnings).To(ConsistOf("warning"))
 				Expect(events).To(ConsistOf(
 					Event{
-						GUID:      "some-event-guid",
-						CreatedAt: timestamp,
-						Type:      "audit.app.update",
-						ActorName: "admin",
+						GUID:             "some-event-guid",
+						CreatedAt:        timestamp,
+						Type:             "audit.app.update",
+						ActorGUID:        "d144abe3-3d7b-40d4-b63f-2584798d3ee5",
+						ActorType:        "user",
+						ActorName:        "admin",
+						TargetGUID:       "2e3151ba-9a63-4345-9c5b-6d8c238f4e55",
+						TargetType:       "app",
+						TargetName:       "my-app",
+						SpaceGUID:        "cb97dd25-d4f7-4185-9e6f-ad6e585c207c",
+						OrganizationGUID: "d9be96f5-ea8f-4549-923f-bec882e32e3c",
 						Data: map[string]interface{}{
 							"request": map[string]interface{}{
 								"recursive": true,
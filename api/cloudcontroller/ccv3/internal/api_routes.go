@@ -30,6 +30,7 @@ const (
 	DeleteServiceInstanceRequest                                = "DeleteServiceInstance"
 	DeleteServiceOfferingRequest                                = "DeleteServiceOffering"
 	DeleteServicePlanVisibilityRequest                          = "DeleteServicePlanVisibility"
+	DeleteSidecarRequest                                        = "DeleteSidecar"
 	DeleteSharedOrgFromDomainRequest                            = "DeleteSharedOrgFromDomain"
 	DeleteSpaceQuotaRequest                                     = "DeleteSpaceQuota"
 	DeleteSpaceRequest                                          = "DeleteSpace"
@@ -44,6 +45,7 @@ const (
 	GetApplicationRevisionsRequest                              = "GetApplicationRevisions"
 	GetApplicationRevisionsDeployedRequest                      = "GetApplicationRevisionsDeployed"
 	GetApplicationRoutesRequest                                 = "GetApplicationRoutes"
+	GetApplicationSidecarsRequest                               = "GetApplicationSidecars"
 	GetApplicationTasksRequest                                  = "GetApplicationTasks"
 	GetApplicationsRequest                                      = "GetApplications"
 	GetBuildRequest                                             = "GetBuild"
@@ -71,6 +73,7 @@ const (
 	GetOrganizationRequest                                      = "GetOrganization"
 	GetOrganizationsRequest                                     = "GetOrganizations"
 	GetPackageRequest                                           = "GetPackage"
+	GetPackageBitsRequest                                       = "GetPackageBits"
 	GetPackagesRequest                                          = "GetPackages"
 	GetPackageDropletsRequest                                   = "GetPackageDroplets"
 	GetProcessRequest                                           = "GetProcess"
@@ -127,6 +130,7 @@ const (
 	PatchServiceInstanceRequest                                 = "PatchServiceInstance"
 	PatchServiceOfferingRequest                                 = "PatchServiceOfferingRequest"
 	PatchServicePlanRequest                                     = "PatchServicePlanRequest"
+	PatchSidecarRequest                                         = "PatchSidecar"
 	PatchSpaceRelationshipIsolationSegmentRequest               = "PatchSpaceRelationshipIsolationSegment"
 	PatchSpaceRequest                                           = "PatchSpace"
 	PatchSpaceFeaturesRequest                                   = "PatchSpaceFeatures"
@@ -142,6 +146,7 @@ const (
 	PostApplicationDeploymentRequest                            = "PostApplicationDeployment"
 	PostApplicationProcessActionScaleRequest                    = "PostApplicationProcessActionScale"
 	PostApplicationRequest                                      = "PostApplication"
+	PostApplicationSidecarRequest                               = "PostApplicationSidecar"
 	PostApplicationTasksRequest                                 = "PostApplicationTasks"
 	PostBuildRequest                                            = "PostBuild"
 	PostBuildpackBitsRequest                                    = "PostBuildpackBits"
@@ -207,6 +212,8 @@ var APIRoutes = map[string]Route{
 	GetApplicationRevisionsRequest:                              {Path: "/v3/apps/:app_guid/revisions", Method: http.MethodGet},
 	GetApplicationRevisionsDeployedRequest:                      {Path: "/v3/apps/:app_guid/revisions/deployed", Method: http.MethodGet},
 	GetApplicationRoutesRequest:                                 {Path: "/v3/apps/:app_guid/routes", Method: http.MethodGet},
+	GetApplicationSidecarsRequest:                               {Path: "/v3/apps/:app_guid/sidecars", Method: http.MethodGet},
+	PostApplicationSidecarRequest:                               {Path: "/v3/apps/:app_guid/sidecars", Method: http.MethodPost},
 	GetSSHEnabled:                                               {Path: "/v3/apps/:app_guid/ssh_enabled", Method: http.MethodGet},
 	GetApplicationTasksRequest:                                  {Path: "/v3/apps/:app_guid/tasks", Method: http.MethodGet},
 	PostApplicationTasksRequest:                                 {Path: "/v3/apps/:app_guid/tasks", Method: http.MethodPost},
@@ -266,6 +273,7 @@ var APIRoutes = map[string]Route{
 	GetPackagesRequest:                                          {Path: "/v3/packages", Method: http.MethodGet},
 	PostPackageRequest:                                          {Path: "/v3/packages", Method: http.MethodPost},
 	GetPackageRequest:                                           {Path: "/v3/packages/:package_guid", Method: http.MethodGet},
+	GetPackageBitsRequest:                                       {Path: "/v3/packages/:package_guid/download", Method: http.MethodGet},
 	PostPackageBitsRequest:                                      {Path: "/v3/packages/:package_guid/upload", Method: http.MethodPost},
 	GetPackageDropletsRequest:                                   {Path: "/v3/packages/:package_guid/droplets", Method: http.MethodGet},
 	GetProcessRequest:                                           {Path: "/v3/processes/:process_guid", Method: http.MethodGet},
@@ -327,6 +335,8 @@ var APIRoutes = map[string]Route{
 	PostRouteBindingRequest:                                     {Path: "/v3/service_route_bindings", Method: http.MethodPost},
 	GetRouteBindingsRequest:                                     {Path: "/v3/service_route_bindings", Method: http.MethodGet},
 	DeleteRouteBindingRequest:                                   {Path: "/v3/service_route_bindings/:route_binding_guid", Method: http.MethodDelete},
+	PatchSidecarRequest:                                         {Path: "/v3/sidecars/:sidecar_guid", Method: http.MethodPatch},
+	DeleteSidecarRequest:                                        {Path: "/v3/sidecars/:sidecar_guid", Method: http.MethodDelete},
 	GetSpacesRequest:                                            {Path: "/v3/spaces", Method: http.MethodGet},
 	PostSpaceRequest:                                            {Path: "/v3/spaces", Method: http.MethodPost},
 	DeleteSpaceRequest:                                          {Path: "/v3/spaces/:space_guid", Method: http.MethodDelete},
@@ -121,6 +121,18 @@ func (client *Client) CopyPackage(sourcePkgGUID string, targetAppGUID string) (r
 	return targetPackage, warnings, err
 }
 
+// DownloadPackage streams the bits of the package into writer, starting at
+// offset bytes into the package.
+func (client *Client) DownloadPackage(packageGUID string, offset int64, writer io.Writer) (Warnings, error) {
+	return client.MakeRequestReceiveStream(
+		internal.GetPackageBitsRequest,
+		internal.Params{"package_guid": packageGUID},
+		"application/json",
+		offset,
+		writer,
+	)
+}
+
 func (client *Client) calculateAppBitsRequestSize(matchedResources []Resource, newResourcesLength int64) (int64, error) {
 	body := &bytes.Buffer{}
 	form := multipart.NewWriter(body)
@@ -235,6 +235,12 @@ var _ = Describe("Package", func() {
 				response := `{
   "guid": "some-pkg-guid",
   "state": "PROCESSING_UPLOAD",
+  "data": {
+    "checksum": {
+      "type": "sha256",
+      "value": "some-checksum"
+    }
+  },
 	"links": {
     "upload": {
       "href": "some-package-upload-url",
@@ -254,8 +260,9 @@ var _ = Describe("Package", func() {
 				Expect(executeErr).NotTo(HaveOccurred())
 
 				expectedPackage := resources.Package{
-					GUID:  "some-pkg-guid",
-					State: constant.PackageProcessingUpload,
+					GUID:     "some-pkg-guid",
+					State:    constant.PackageProcessingUpload,
+					Checksum: resources.BitsChecksum{Type: "sha256", Value: "some-checksum"},
 					Links: map[string]resources.APILink{
 						"upload": resources.APILink{HREF: "some-package-upload-url", Method: http.MethodPost},
 					},
@@ -939,4 +946,63 @@ var _ = Describe("Package", func() {
 			})
 		})
 	})
+
+	Describe("DownloadPackage", func() {
+		var (
+			writer     *bytes.Buffer
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			writer = new(bytes.Buffer)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = client.DownloadPackage("some-pkg-guid", 0, writer)
+		})
+
+		When("the package exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/packages/some-pkg-guid/download"),
+						RespondWith(http.StatusOK, "some-package-bits", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("streams the package bits into the writer and returns all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(writer.String()).To(Equal("some-package-bits"))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		When("the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "Package not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/packages/some-pkg-guid/download"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Package not found"}))
+				Expect(writer.String()).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
@@ -37,6 +37,12 @@ const (
 	StatusValueFilter QueryKey = "status_values"
 	// DomainGUIDFilter is a query param for listing events by target_guid
 	TargetGUIDFilter QueryKey = "target_guids"
+	// EventTypesFilter is a query param for listing events by type
+	EventTypesFilter QueryKey = "types"
+	// CreatedAtsGreaterThanFilter is a query param for listing objects created after a timestamp
+	CreatedAtsGreaterThanFilter QueryKey = "created_ats[gt]"
+	// CreatedAtsLessThanFilter is a query param for listing objects created before a timestamp
+	CreatedAtsLessThanFilter QueryKey = "created_ats[lt]"
 	// DomainGUIDFilter is a query param for listing objects by domain_guid
 	DomainGUIDFilter QueryKey = "domain_guids"
 	// HostsFilter is a query param for listing objects by hostname
@@ -39,6 +39,14 @@ type Requester interface {
 		responseBodyMimeType string,
 	) ([]byte, Warnings, error)
 
+	MakeRequestReceiveStream(
+		requestName string,
+		uriParams internal.Params,
+		responseBodyMimeType string,
+		offset int64,
+		responseBody io.Writer,
+	) (Warnings, error)
+
 	MakeRequestSendRaw(
 		requestName string,
 		uriParams internal.Params,
@@ -144,6 +152,42 @@ func (requester *RealRequester) MakeRequestReceiveRaw(
 	return response.RawResponse, response.Warnings, err
 }
 
+// MakeRequestReceiveStream copies the response body into responseBody as it
+// is read. A positive offset requests the body starting at that byte, so that
+// an interrupted download can be resumed; if the server ignores the range the
+// bytes before offset are discarded.
+func (requester *RealRequester) MakeRequestReceiveStream(
+	requestName string,
+	uriParams internal.Params,
+	responseBodyMimeType string,
+	offset int64,
+	responseBody io.Writer,
+) (Warnings, error) {
+	request, err := requester.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Accept", responseBodyMimeType)
+	if offset > 0 {
+		request.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	response := cloudcontroller.Response{}
+	response.ResponseBodyWriter = &rangeWriter{
+		response: &response,
+		offset:   offset,
+		writer:   responseBody,
+	}
+
+	err = requester.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
+
 func (requester *RealRequester) MakeRequestSendReceiveRaw(
 	Method string,
 	URL string,
@@ -310,3 +354,36 @@ func (requester *RealRequester) uploadAsynchronously(request *cloudcontroller.Re
 
 	return response.ResourceLocationURL, response.Warnings, firstError
 }
+
+// rangeWriter discards the leading bytes of a response that was requested
+// with a Range header but answered in full.
+type rangeWriter struct {
+	response *cloudcontroller.Response
+	offset   int64
+	writer   io.Writer
+	started  bool
+	skip     int64
+}
+
+func (w *rangeWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+		if w.response.HTTPResponse != nil && w.response.HTTPResponse.StatusCode != http.StatusPartialContent {
+			w.skip = w.offset
+		}
+	}
+
+	if w.skip > 0 {
+		if int64(len(p)) <= w.skip {
+			w.skip -= int64(len(p))
+			return len(p), nil
+		}
+
+		n, err := w.writer.Write(p[w.skip:])
+		n += int(w.skip)
+		w.skip = 0
+		return n, err
+	}
+
+	return w.writer.Write(p)
+}
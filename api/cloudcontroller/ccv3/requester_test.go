@@ -1166,6 +1166,118 @@ var _ = Describe("shared request helpers", func() {
 		})
 	})
 
+	Describe("MakeRequestReceiveStream", func() {
+		var (
+			offset       int64
+			responseBody *strings.Builder
+
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			offset = 0
+			responseBody = new(strings.Builder)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = client.MakeRequestReceiveStream(
+				internal.GetDropletBitsRequest,
+				internal.Params{"droplet_guid": "some-droplet-guid"},
+				"application/json",
+				offset,
+				responseBody,
+			)
+		})
+
+		When("the download is successful", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+						func(w http.ResponseWriter, req *http.Request) {
+							Expect(req.Header).ToNot(HaveKey("Range"))
+						},
+						RespondWith(http.StatusOK, "some-droplet-bits", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("writes the response body to the writer and returns all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(responseBody.String()).To(Equal("some-droplet-bits"))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		When("an offset is provided", func() {
+			BeforeEach(func() {
+				offset = 5
+			})
+
+			When("the server honours the range", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+							VerifyHeaderKV("Range", "bytes=5-"),
+							RespondWith(http.StatusPartialContent, "droplet-bits"),
+						),
+					)
+				})
+
+				It("writes the partial body to the writer", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(responseBody.String()).To(Equal("droplet-bits"))
+				})
+			})
+
+			When("the server ignores the range", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+							VerifyHeaderKV("Range", "bytes=5-"),
+							RespondWith(http.StatusOK, "some-droplet-bits"),
+						),
+					)
+				})
+
+				It("discards the bytes before the offset", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(responseBody.String()).To(Equal("droplet-bits"))
+				})
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings without writing the body", func() {
+				Expect(executeErr).To(MatchError(ccerror.DropletNotFoundError{}))
+				Expect(responseBody.String()).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("MakeRequestSendRaw", func() {
 		var (
 			requestName         string
@@ -63,6 +63,11 @@ func (connection *CloudControllerConnection) Make(request *Request, passedRespon
 func (*CloudControllerConnection) handleStatusCodes(response *http.Response, passedResponse *Response) error {
 	if response.StatusCode == http.StatusNoContent {
 		passedResponse.RawResponse = []byte("{}")
+	} else if response.StatusCode < 400 && passedResponse.ResponseBodyWriter != nil {
+		_, err := io.Copy(passedResponse.ResponseBodyWriter, response.Body)
+		if err != nil {
+			return err
+		}
 	} else {
 		rawBytes, err := io.ReadAll(response.Body)
 		if err != nil {
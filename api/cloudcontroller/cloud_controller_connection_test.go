@@ -1,6 +1,7 @@
 package cloudcontroller_test
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"runtime"
@@ -111,6 +112,62 @@ var _ = Describe("Cloud Controller Connection", func() {
 			})
 		})
 
+		Describe("Streaming the response body", func() {
+			var request *Request
+
+			BeforeEach(func() {
+				req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+				Expect(err).ToNot(HaveOccurred())
+				request = &Request{Request: req}
+			})
+
+			When("the request succeeds", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/foo"),
+							RespondWith(http.StatusOK, "some-large-body"),
+						),
+					)
+				})
+
+				It("writes the body to the ResponseBodyWriter instead of RawResponse", func() {
+					writer := new(bytes.Buffer)
+					response := Response{ResponseBodyWriter: writer}
+
+					err := connection.Make(request, &response)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(writer.String()).To(Equal("some-large-body"))
+					Expect(response.RawResponse).To(BeEmpty())
+				})
+			})
+
+			When("the request fails", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/foo"),
+							RespondWith(http.StatusNotFound, `{"errors":[]}`),
+						),
+					)
+				})
+
+				It("keeps the error body out of the ResponseBodyWriter", func() {
+					writer := new(bytes.Buffer)
+					response := Response{ResponseBodyWriter: writer}
+
+					err := connection.Make(request, &response)
+					Expect(err).To(MatchError(ccerror.RawHTTPStatusError{
+						StatusCode:  http.StatusNotFound,
+						RawResponse: []byte(`{"errors":[]}`),
+					}))
+
+					Expect(writer.Len()).To(BeZero())
+				})
+			})
+		})
+
 		Describe("Response Headers", func() {
 			Describe("Location", func() {
 				BeforeEach(func() {
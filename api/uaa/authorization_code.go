@@ -0,0 +1,29 @@
+package uaa
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// AuthorizationCodeURL returns the URL of the authorization endpoint a user
+// opens in a browser to log in. After login, the browser is redirected to
+// redirectURI with the state and an authorization code that Authenticate
+// exchanges for tokens together with the PKCE code verifier of
+// codeChallenge.
+func (client *Client) AuthorizationCodeURL(redirectURI string, state string, codeChallenge string, origin string) string {
+	queryValues := url.Values{}
+	queryValues.Add("response_type", "code")
+	queryValues.Add("client_id", client.config.UAAOAuthClient())
+	queryValues.Add("redirect_uri", redirectURI)
+	queryValues.Add("state", state)
+	queryValues.Add("code_challenge", codeChallenge)
+	queryValues.Add("code_challenge_method", "S256")
+
+	if origin != "" {
+		loginHint, _ := json.Marshal(map[string]string{"origin": origin})
+		queryValues.Add("login_hint", string(loginHint))
+	}
+
+	return fmt.Sprintf("%s/oauth/authorize?%s", client.LoginLink(), queryValues.Encode())
+}
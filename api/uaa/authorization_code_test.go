@@ -0,0 +1,55 @@
+package uaa_test
+
+import (
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuthorizationCodeURL", func() {
+	var (
+		client *Client
+		origin string
+
+		authorizationURL string
+	)
+
+	BeforeEach(func() {
+		client = NewTestUAAClientAndStore(NewTestConfig())
+		origin = ""
+	})
+
+	JustBeforeEach(func() {
+		authorizationURL = client.AuthorizationCodeURL("http://127.0.0.1:8080/callback", "some-state", "some-challenge", origin)
+	})
+
+	It("returns the authorize URL of the login server", func() {
+		parsedURL, err := url.Parse(authorizationURL)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(parsedURL.Scheme + "://" + parsedURL.Host).To(Equal(client.LoginLink()))
+		Expect(parsedURL.Path).To(Equal("/oauth/authorize"))
+		Expect(parsedURL.Query()).To(Equal(url.Values{
+			"response_type":         {"code"},
+			"client_id":             {"client-id"},
+			"redirect_uri":          {"http://127.0.0.1:8080/callback"},
+			"state":                 {"some-state"},
+			"code_challenge":        {"some-challenge"},
+			"code_challenge_method": {"S256"},
+		}))
+	})
+
+	When("an origin is given", func() {
+		BeforeEach(func() {
+			origin = "ldap"
+		})
+
+		It("adds it as a login hint", func() {
+			parsedURL, err := url.Parse(authorizationURL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsedURL.Query().Get("login_hint")).To(Equal(`{"origin":"ldap"}`))
+		})
+	})
+})
@@ -35,6 +35,10 @@ type Config interface {
 	// will reuse the TCP connection.
 	UAADisableKeepAlives() bool
 
+	// UAAAssertionFile returns the file to read the assertion from when
+	// refreshing the token of a JWT bearer login.
+	UAAAssertionFile() string
+
 	// UAAGrantType returns the grant type of the supplied UAA credentials.
 	UAAGrantType() string
 
@@ -10,6 +10,12 @@ const (
 	// GrantTypePassword is used for user's username/password authentication.
 	GrantTypePassword     GrantType = "password"
 	GrantTypeRefreshToken GrantType = "refresh_token"
+	// GrantTypeAuthorizationCode is used for user authentication in a
+	// browser, with the code the authorization endpoint redirected to.
+	GrantTypeAuthorizationCode GrantType = "authorization_code"
+	// GrantTypeDeviceCode is used for user authentication on another device,
+	// with the device code of a device authorization.
+	GrantTypeDeviceCode GrantType = "urn:ietf:params:oauth:grant-type:device_code"
 	// GrantTypeJwtBearer is used for token based user authentication
 	GrantTypeJwtBearer GrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
 )
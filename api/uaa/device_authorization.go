@@ -0,0 +1,51 @@
+package uaa
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// DeviceAuthorization is the response of a device authorization request. The
+// user approves it by entering UserCode at VerificationURI on another device
+// while the CLI polls for tokens with DeviceCode.
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	// ExpiresIn is the lifetime of the device code in seconds.
+	ExpiresIn int `json:"expires_in"`
+	// Interval is the number of seconds to wait between token requests.
+	Interval int `json:"interval"`
+}
+
+// StartDeviceAuthorization requests a device code and a user code for the
+// configured OAuth client.
+func (client *Client) StartDeviceAuthorization() (DeviceAuthorization, error) {
+	requestBody := url.Values{
+		"client_id": {client.config.UAAOAuthClient()},
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostDeviceAuthorizationRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(requestBody.Encode()),
+	})
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+	request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
+
+	var authorization DeviceAuthorization
+	response := Response{
+		Result: &authorization,
+	}
+
+	err = client.connection.Make(request, &response)
+	return authorization, err
+}
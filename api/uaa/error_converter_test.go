@@ -1,6 +1,7 @@
 package uaa_test
 
 import (
+	"fmt"
 	"net/http"
 
 	. "code.cloudfoundry.org/cli/api/uaa"
@@ -83,6 +84,20 @@ var _ = Describe("Error Wrapper", func() {
 						Expect(makeErr).To(MatchError(InvalidSCIMResourceError{Message: "A username must be provided"}))
 					})
 				})
+
+				DescribeTable("device authorization errors",
+					func(errorType string, expectedErr error) {
+						fakeConnectionErr.RawResponse = []byte(fmt.Sprintf(`{"error":"%s","error_description":"some-description"}`, errorType))
+						fakeConnection.MakeReturns(fakeConnectionErr)
+
+						makeErr = wrapper.Make(request, response)
+						Expect(makeErr).To(MatchError(expectedErr))
+					},
+					Entry("authorization pending", "authorization_pending", AuthorizationPendingError{Message: "some-description"}),
+					Entry("slow down", "slow_down", SlowDownError{Message: "some-description"}),
+					Entry("expired token", "expired_token", ExpiredDeviceCodeError{Message: "some-description"}),
+					Entry("access denied", "access_denied", AccessDeniedError{Message: "some-description"}),
+				)
 			})
 
 			Context("(401) Unauthorized", func() {
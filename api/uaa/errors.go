@@ -104,3 +104,43 @@ type InvalidPasswordError struct {
 func (e InvalidPasswordError) Error() string {
 	return e.Message
 }
+
+// AuthorizationPendingError is returned while the user has not yet approved
+// a device authorization.
+type AuthorizationPendingError struct {
+	Message string
+}
+
+func (e AuthorizationPendingError) Error() string {
+	return e.Message
+}
+
+// SlowDownError is returned when a device authorization is polled more often
+// than the interval allows.
+type SlowDownError struct {
+	Message string
+}
+
+func (e SlowDownError) Error() string {
+	return e.Message
+}
+
+// ExpiredDeviceCodeError is returned when a device authorization expired
+// before the user approved it.
+type ExpiredDeviceCodeError struct {
+	Message string
+}
+
+func (e ExpiredDeviceCodeError) Error() string {
+	return e.Message
+}
+
+// AccessDeniedError is returned when the user denied an authorization
+// request.
+type AccessDeniedError struct {
+	Message string
+}
+
+func (e AccessDeniedError) Error() string {
+	return e.Message
+}
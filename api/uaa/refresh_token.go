@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
 	"strings"
 
 	"code.cloudfoundry.org/cli/api/uaa/constant"
@@ -22,14 +23,27 @@ func (refreshTokenResponse RefreshedTokens) AuthorizationToken() string {
 	return fmt.Sprintf("%s %s", refreshTokenResponse.Type, refreshTokenResponse.AccessToken)
 }
 
-// RefreshAccessToken refreshes the current access token.
+// RefreshAccessToken refreshes the current access token. When the token was
+// obtained with a JWT bearer assertion read from a file, a new token is
+// requested with the current content of the file instead.
 func (client *Client) RefreshAccessToken(refreshToken string) (RefreshedTokens, error) {
 	var values url.Values
 
 	switch client.config.UAAGrantType() {
 	case string(constant.GrantTypeClientCredentials):
 		values = client.clientCredentialRefreshBody()
-	case "", string(constant.GrantTypePassword), string(constant.GrantTypeJwtBearer): // CLI used to write empty string for grant type in the case of password; preserve compatibility with old config.json files
+	case string(constant.GrantTypeJwtBearer):
+		if client.config.UAAAssertionFile() == "" {
+			values = client.refreshTokenBody(refreshToken)
+			break
+		}
+
+		var err error
+		values, err = client.assertionRefreshBody()
+		if err != nil {
+			return RefreshedTokens{}, err
+		}
+	case "", string(constant.GrantTypePassword): // CLI used to write empty string for grant type in the case of password; preserve compatibility with old config.json files
 		values = client.refreshTokenBody(refreshToken)
 	}
 
@@ -75,3 +89,15 @@ func (client *Client) refreshTokenBody(refreshToken string) url.Values {
 		"grant_type":    {string(constant.GrantTypeRefreshToken)},
 	}
 }
+
+func (client *Client) assertionRefreshBody() (url.Values, error) {
+	assertion, err := os.ReadFile(client.config.UAAAssertionFile())
+	if err != nil {
+		return nil, err
+	}
+
+	return url.Values{
+		"assertion":  {strings.TrimSpace(string(assertion))},
+		"grant_type": {string(constant.GrantTypeJwtBearer)},
+	}, nil
+}
@@ -105,10 +105,16 @@ func (t *UAAAuthentication) Wrap(innerconnection uaa.Connection) uaa.Connection
 func skipAuthenticationHeader(request *http.Request, body []byte) bool {
 	stringBody := string(body)
 
+	if strings.Contains(request.URL.String(), "/oauth/device_authorize") && request.Method == http.MethodPost {
+		return true
+	}
+
 	return strings.Contains(request.URL.String(), "/oauth/token") &&
 		request.Method == http.MethodPost &&
 		(strings.Contains(stringBody, "grant_type=refresh_token") ||
 			strings.Contains(stringBody, "grant_type=password") ||
+			strings.Contains(stringBody, "grant_type=authorization_code") ||
+			strings.Contains(stringBody, "grant_type=urn%3Aietf%3Aparams%3Aoauth%3Agrant-type%3Adevice_code") ||
 			strings.Contains(stringBody, "grant_type=urn%3Aietf%3Aparams%3Aoauth%3Agrant-type%3Ajwt-bearer") ||
 			strings.Contains(stringBody, "grant_type=client_credentials"))
 }
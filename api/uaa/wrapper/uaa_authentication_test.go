@@ -214,6 +214,81 @@ var _ = Describe("UAA Authentication", func() {
 					Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
 				})
 			})
+			Context("with authorization_code grant_type", func() {
+				var originalAuthHeader string
+				BeforeEach(func() {
+					body := strings.NewReader(url.Values{
+						"grant_type": {"authorization_code"},
+					}.Encode())
+
+					request, err := http.NewRequest("POST", fmt.Sprintf("%s/oauth/token", server.URL()), body)
+					Expect(err).NotTo(HaveOccurred())
+					request.SetBasicAuth("some-user", "some-password")
+					originalAuthHeader = request.Header.Get("Authorization")
+
+					inMemoryCache.SetAccessToken("some-access-token")
+
+					err = wrapper.Make(request, nil)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not change the 'Authorization' header", func() {
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+					request, _ := fakeConnection.MakeArgsForCall(0)
+					Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
+				})
+			})
+			Context("with device_code grant_type", func() {
+				var originalAuthHeader string
+				BeforeEach(func() {
+					body := strings.NewReader(url.Values{
+						"grant_type": {"urn:ietf:params:oauth:grant-type:device_code"},
+					}.Encode())
+
+					request, err := http.NewRequest("POST", fmt.Sprintf("%s/oauth/token", server.URL()), body)
+					Expect(err).NotTo(HaveOccurred())
+					request.SetBasicAuth("some-user", "some-password")
+					originalAuthHeader = request.Header.Get("Authorization")
+
+					inMemoryCache.SetAccessToken("some-access-token")
+
+					err = wrapper.Make(request, nil)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not change the 'Authorization' header", func() {
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+					request, _ := fakeConnection.MakeArgsForCall(0)
+					Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
+				})
+			})
+			Context("requesting a device authorization", func() {
+				var originalAuthHeader string
+				BeforeEach(func() {
+					body := strings.NewReader(url.Values{
+						"client_id": {"cf"},
+					}.Encode())
+
+					request, err := http.NewRequest("POST", fmt.Sprintf("%s/oauth/device_authorize", server.URL()), body)
+					Expect(err).NotTo(HaveOccurred())
+					request.SetBasicAuth("some-user", "some-password")
+					originalAuthHeader = request.Header.Get("Authorization")
+
+					inMemoryCache.SetAccessToken("some-access-token")
+
+					err = wrapper.Make(request, nil)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not change the 'Authorization' header", func() {
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+					request, _ := fakeConnection.MakeArgsForCall(0)
+					Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
+				})
+			})
 			Context("with client_credentials grant_type", func() {
 				var originalAuthHeader string
 				BeforeEach(func() {
@@ -2,6 +2,7 @@ package coreconfig
 
 import (
 	"encoding/json"
+	"os"
 
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -23,11 +24,13 @@ type AuthPrompt struct {
 
 type Data struct {
 	AccessToken              string
+	ActiveProfile            string `json:",omitempty"`
 	APIVersion               string
 	AsyncTimeout             uint
 	AuthorizationEndpoint    string
 	ColorEnabled             string
 	ConfigVersion            int
+	CredentialStore          string `json:",omitempty"`
 	DopplerEndPoint          string
 	Locale                   string
 	LogCacheEndPoint         string
@@ -36,6 +39,7 @@ type Data struct {
 	NetworkPolicyV1Endpoint  string
 	OrganizationFields       models.OrganizationFields
 	PluginRepos              []models.PluginRepo
+	Profiles                 map[string]json.RawMessage `json:",omitempty"`
 	RefreshToken             string
 	RoutingAPIEndpoint       string
 	SpaceFields              models.SpaceFields
@@ -43,10 +47,15 @@ type Data struct {
 	SSLDisabled              bool
 	Target                   string
 	Trace                    string
+	UAAAssertionFile         string `json:",omitempty"`
 	UaaEndpoint              string
 	UAAGrantType             string
 	UAAOAuthClient           string
 	UAAOAuthClientSecret     string
+
+	credentialCache configv3.CredentialCache
+	store           configv3.CredentialStore
+	storeName       string
 }
 
 func NewData() *Data {
@@ -60,7 +69,19 @@ func NewData() *Data {
 
 func (d *Data) JSONMarshalV3() ([]byte, error) {
 	d.ConfigVersion = configv3.CurrentConfigVersion
-	return json.MarshalIndent(d, "", "  ")
+	if d.CredentialStore == "" {
+		return json.MarshalIndent(d, "", "  ")
+	}
+
+	if d.credentialCache.Secrets == nil {
+		d.credentialCache = configv3.NewCredentialCache()
+	}
+	file := *d
+	err := file.credentialFields().Save(d.credentialStore(), file.profileName(), d.credentialCache)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(file, "", "  ")
 }
 
 func (d *Data) JSONUnmarshalV3(input []byte) error {
@@ -74,5 +95,39 @@ func (d *Data) JSONUnmarshalV3(input []byte) error {
 		return nil
 	}
 
+	if d.CredentialStore == "" {
+		return nil
+	}
+
+	// Credentials that cannot be read are left empty, so that the command runs
+	// as if the user was logged out. The error has already been displayed as
+	// a warning when the command was parsed.
+	d.credentialCache = configv3.NewCredentialCache()
+	_ = d.credentialFields().Resolve(d.credentialStore(), d.credentialCache)
 	return nil
 }
+
+func (d *Data) credentialFields() configv3.CredentialFields {
+	return configv3.CredentialFields{
+		AccessToken:          &d.AccessToken,
+		RefreshToken:         &d.RefreshToken,
+		UAAOAuthClientSecret: &d.UAAOAuthClientSecret,
+	}
+}
+
+// credentialStore returns the configured credential store, reusing it for as
+// long as the configured store does not change.
+func (d *Data) credentialStore() configv3.CredentialStore {
+	if d.store == nil || d.storeName != d.CredentialStore {
+		d.store = configv3.NewCredentialStore(d.CredentialStore, os.Getenv("CF_CREDENTIALS_PASSPHRASE"))
+		d.storeName = d.CredentialStore
+	}
+	return d.store
+}
+
+func (d *Data) profileName() string {
+	if d.ActiveProfile == "" {
+		return configv3.DefaultProfileName
+	}
+	return d.ActiveProfile
+}
@@ -1,6 +1,9 @@
 package coreconfig_test
 
 import (
+	"encoding/json"
+	"os"
+
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
 
@@ -217,6 +220,32 @@ var _ = Describe("V3 Config files", func() {
 			Expect(actualData).To(Equal(expectedData))
 		})
 
+		It("keeps the active profile and the other profiles", func() {
+			profilesJSON := `{
+				"ConfigVersion": 4,
+				"Target": "api.prod.example.com",
+				"ActiveProfile": "prod",
+				"Profiles": {
+					"default": {"Target": "api.dev.example.com", "AccessToken": "dev-access-token"}
+				}
+			}`
+
+			actualData := coreconfig.NewData()
+			err := actualData.JSONUnmarshalV3([]byte(profilesJSON))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualData.ActiveProfile).To(Equal("prod"))
+
+			jsonData, err := actualData.JSONMarshalV3()
+			Expect(err).NotTo(HaveOccurred())
+
+			var written map[string]interface{}
+			Expect(json.Unmarshal(jsonData, &written)).To(Succeed())
+			Expect(written).To(HaveKeyWithValue("ActiveProfile", "prod"))
+			Expect(written).To(HaveKeyWithValue("Profiles", map[string]interface{}{
+				"default": map[string]interface{}{"Target": "api.dev.example.com", "AccessToken": "dev-access-token"},
+			}))
+		})
+
 		It("returns an empty Data object for non-V3 JSON", func() {
 			actualData := coreconfig.NewData()
 			err := actualData.JSONUnmarshalV3([]byte(exampleV2JSON))
@@ -225,4 +254,62 @@ var _ = Describe("V3 Config files", func() {
 			Expect(*actualData).To(Equal(coreconfig.Data{}))
 		})
 	})
+
+	Describe("with a credential store", func() {
+		var homeDir string
+
+		BeforeEach(func() {
+			var err error
+			homeDir, err = os.MkdirTemp("", "cli-coreconfig-credentials")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.Setenv("CF_HOME", homeDir)).To(Succeed())
+			Expect(os.Setenv("CF_CREDENTIALS_PASSPHRASE", "some-passphrase")).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_CREDENTIALS_PASSPHRASE")).To(Succeed())
+			Expect(os.Unsetenv("CF_HOME")).To(Succeed())
+			Expect(os.RemoveAll(homeDir)).To(Succeed())
+		})
+
+		It("keeps only the keys of the credentials in the JSON", func() {
+			data := coreconfig.NewData()
+			data.CredentialStore = "file"
+			data.ActiveProfile = "prod"
+			data.AccessToken = "bearer the-access-token"
+			data.RefreshToken = "the-refresh-token"
+
+			jsonData, err := data.JSONMarshalV3()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(jsonData)).NotTo(ContainSubstring("the-access-token"))
+			Expect(string(jsonData)).To(ContainSubstring(`"AccessToken": "cf-cli://prod/AccessToken"`))
+			Expect(data.AccessToken).To(Equal("bearer the-access-token"))
+
+			actualData := coreconfig.NewData()
+			Expect(actualData.JSONUnmarshalV3(jsonData)).To(Succeed())
+			Expect(actualData.AccessToken).To(Equal("bearer the-access-token"))
+			Expect(actualData.RefreshToken).To(Equal("the-refresh-token"))
+		})
+
+		When("the credentials cannot be read", func() {
+			It("leaves them empty and keeps their keys in the JSON", func() {
+				data := coreconfig.NewData()
+				data.CredentialStore = "file"
+				data.AccessToken = "bearer the-access-token"
+
+				jsonData, err := data.JSONMarshalV3()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(os.Unsetenv("CF_CREDENTIALS_PASSPHRASE")).To(Succeed())
+				actualData := coreconfig.NewData()
+				Expect(actualData.JSONUnmarshalV3(jsonData)).To(Succeed())
+				Expect(actualData.AccessToken).To(BeEmpty())
+				Expect(actualData.Target).To(Equal(data.Target))
+
+				jsonData, err = actualData.JSONMarshalV3()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(jsonData)).To(ContainSubstring(`"AccessToken": "cf-cli://default/AccessToken"`))
+			})
+		})
+	})
 })
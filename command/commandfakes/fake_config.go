@@ -51,16 +51,6 @@ type FakeConfig struct {
 	authorizationEndpointReturnsOnCall map[int]struct {
 		result1 string
 	}
-	B3SpanIDStub        func() string
-	b3SpanIDMutex       sync.RWMutex
-	b3SpanIDArgsForCall []struct {
-	}
-	b3SpanIDReturns struct {
-		result1 string
-	}
-	b3SpanIDReturnsOnCall map[int]struct {
-		result1 string
-	}
 	B3TraceIDStub        func() string
 	b3TraceIDMutex       sync.RWMutex
 	b3TraceIDArgsForCall []struct {
@@ -91,6 +81,16 @@ type FakeConfig struct {
 	binaryVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CFAssertionFileStub        func() string
+	cFAssertionFileMutex       sync.RWMutex
+	cFAssertionFileArgsForCall []struct {
+	}
+	cFAssertionFileReturns struct {
+		result1 string
+	}
+	cFAssertionFileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CFPasswordStub        func() string
 	cFPasswordMutex       sync.RWMutex
 	cFPasswordArgsForCall []struct {
@@ -123,6 +123,17 @@ type FakeConfig struct {
 		result1 map[string]interface{}
 		result2 error
 	}
+	CheckCredentialStoreStub        func(string) error
+	checkCredentialStoreMutex       sync.RWMutex
+	checkCredentialStoreArgsForCall []struct {
+		arg1 string
+	}
+	checkCredentialStoreReturns struct {
+		result1 error
+	}
+	checkCredentialStoreReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ColorEnabledStub        func() configv3.ColorSetting
 	colorEnabledMutex       sync.RWMutex
 	colorEnabledArgsForCall []struct {
@@ -133,6 +144,17 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	CreateProfileStub        func(string) error
+	createProfileMutex       sync.RWMutex
+	createProfileArgsForCall []struct {
+		arg1 string
+	}
+	createProfileReturns struct {
+		result1 error
+	}
+	createProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct {
@@ -303,6 +325,16 @@ type FakeConfig struct {
 	networkPolicyV1EndpointReturnsOnCall map[int]struct {
 		result1 string
 	}
+	OutputFormatStub        func() configv3.OutputFormat
+	outputFormatMutex       sync.RWMutex
+	outputFormatArgsForCall []struct {
+	}
+	outputFormatReturns struct {
+		result1 configv3.OutputFormat
+	}
+	outputFormatReturnsOnCall map[int]struct {
+		result1 configv3.OutputFormat
+	}
 	OverallPollingTimeoutStub        func() time.Duration
 	overallPollingTimeoutMutex       sync.RWMutex
 	overallPollingTimeoutArgsForCall []struct {
@@ -353,6 +385,26 @@ type FakeConfig struct {
 	pollingIntervalReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	ProfileNameStub        func() string
+	profileNameMutex       sync.RWMutex
+	profileNameArgsForCall []struct {
+	}
+	profileNameReturns struct {
+		result1 string
+	}
+	profileNameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ProfilesStub        func() []configv3.Profile
+	profilesMutex       sync.RWMutex
+	profilesArgsForCall []struct {
+	}
+	profilesReturns struct {
+		result1 []configv3.Profile
+	}
+	profilesReturnsOnCall map[int]struct {
+		result1 []configv3.Profile
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct {
@@ -413,6 +465,17 @@ type FakeConfig struct {
 	setColorEnabledArgsForCall []struct {
 		arg1 string
 	}
+	SetCredentialStoreStub        func(string) error
+	setCredentialStoreMutex       sync.RWMutex
+	setCredentialStoreArgsForCall []struct {
+		arg1 string
+	}
+	setCredentialStoreReturns struct {
+		result1 error
+	}
+	setCredentialStoreReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetKubernetesAuthInfoStub        func(string)
 	setKubernetesAuthInfoMutex       sync.RWMutex
 	setKubernetesAuthInfoArgsForCall []struct {
@@ -463,6 +526,11 @@ type FakeConfig struct {
 	setTraceArgsForCall []struct {
 		arg1 string
 	}
+	SetUAAAssertionFileStub        func(string)
+	setUAAAssertionFileMutex       sync.RWMutex
+	setUAAAssertionFileArgsForCall []struct {
+		arg1 string
+	}
 	SetUAAClientCredentialsStub        func(string, string)
 	setUAAClientCredentialsMutex       sync.RWMutex
 	setUAAClientCredentialsArgsForCall []struct {
@@ -559,6 +627,16 @@ type FakeConfig struct {
 	terminalWidthReturnsOnCall map[int]struct {
 		result1 int
 	}
+	UAAAssertionFileStub        func() string
+	uAAAssertionFileMutex       sync.RWMutex
+	uAAAssertionFileArgsForCall []struct {
+	}
+	uAAAssertionFileReturns struct {
+		result1 string
+	}
+	uAAAssertionFileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	UAADisableKeepAlivesStub        func() bool
 	uAADisableKeepAlivesMutex       sync.RWMutex
 	uAADisableKeepAlivesArgsForCall []struct {
@@ -621,6 +699,17 @@ type FakeConfig struct {
 	unsetUserInformationMutex       sync.RWMutex
 	unsetUserInformationArgsForCall []struct {
 	}
+	UseProfileStub        func(string) error
+	useProfileMutex       sync.RWMutex
+	useProfileArgsForCall []struct {
+		arg1 string
+	}
+	useProfileReturns struct {
+		result1 error
+	}
+	useProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
 	V7SetSpaceInformationStub        func(string, string)
 	v7SetSpaceInformationMutex       sync.RWMutex
 	v7SetSpaceInformationArgsForCall []struct {
@@ -887,59 +976,6 @@ func (fake *FakeConfig) AuthorizationEndpointReturnsOnCall(i int, result1 string
 	}{result1}
 }
 
-func (fake *FakeConfig) B3SpanID() string {
-	fake.b3SpanIDMutex.Lock()
-	ret, specificReturn := fake.b3SpanIDReturnsOnCall[len(fake.b3SpanIDArgsForCall)]
-	fake.b3SpanIDArgsForCall = append(fake.b3SpanIDArgsForCall, struct {
-	}{})
-	stub := fake.B3SpanIDStub
-	fakeReturns := fake.b3SpanIDReturns
-	fake.recordInvocation("B3SpanID", []interface{}{})
-	fake.b3SpanIDMutex.Unlock()
-	if stub != nil {
-		return stub()
-	}
-	if specificReturn {
-		return ret.result1
-	}
-	return fakeReturns.result1
-}
-
-func (fake *FakeConfig) B3SpanIDCallCount() int {
-	fake.b3SpanIDMutex.RLock()
-	defer fake.b3SpanIDMutex.RUnlock()
-	return len(fake.b3SpanIDArgsForCall)
-}
-
-func (fake *FakeConfig) B3SpanIDCalls(stub func() string) {
-	fake.b3SpanIDMutex.Lock()
-	defer fake.b3SpanIDMutex.Unlock()
-	fake.B3SpanIDStub = stub
-}
-
-func (fake *FakeConfig) B3SpanIDReturns(result1 string) {
-	fake.b3SpanIDMutex.Lock()
-	defer fake.b3SpanIDMutex.Unlock()
-	fake.B3SpanIDStub = nil
-	fake.b3SpanIDReturns = struct {
-		result1 string
-	}{result1}
-}
-
-func (fake *FakeConfig) B3SpanIDReturnsOnCall(i int, result1 string) {
-	fake.b3SpanIDMutex.Lock()
-	defer fake.b3SpanIDMutex.Unlock()
-	fake.B3SpanIDStub = nil
-	if fake.b3SpanIDReturnsOnCall == nil {
-		fake.b3SpanIDReturnsOnCall = make(map[int]struct {
-			result1 string
-		})
-	}
-	fake.b3SpanIDReturnsOnCall[i] = struct {
-		result1 string
-	}{result1}
-}
-
 func (fake *FakeConfig) B3TraceID() string {
 	fake.b3TraceIDMutex.Lock()
 	ret, specificReturn := fake.b3TraceIDReturnsOnCall[len(fake.b3TraceIDArgsForCall)]
@@ -1099,6 +1135,59 @@ func (fake *FakeConfig) BinaryVersionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) CFAssertionFile() string {
+	fake.cFAssertionFileMutex.Lock()
+	ret, specificReturn := fake.cFAssertionFileReturnsOnCall[len(fake.cFAssertionFileArgsForCall)]
+	fake.cFAssertionFileArgsForCall = append(fake.cFAssertionFileArgsForCall, struct {
+	}{})
+	stub := fake.CFAssertionFileStub
+	fakeReturns := fake.cFAssertionFileReturns
+	fake.recordInvocation("CFAssertionFile", []interface{}{})
+	fake.cFAssertionFileMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CFAssertionFileCallCount() int {
+	fake.cFAssertionFileMutex.RLock()
+	defer fake.cFAssertionFileMutex.RUnlock()
+	return len(fake.cFAssertionFileArgsForCall)
+}
+
+func (fake *FakeConfig) CFAssertionFileCalls(stub func() string) {
+	fake.cFAssertionFileMutex.Lock()
+	defer fake.cFAssertionFileMutex.Unlock()
+	fake.CFAssertionFileStub = stub
+}
+
+func (fake *FakeConfig) CFAssertionFileReturns(result1 string) {
+	fake.cFAssertionFileMutex.Lock()
+	defer fake.cFAssertionFileMutex.Unlock()
+	fake.CFAssertionFileStub = nil
+	fake.cFAssertionFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CFAssertionFileReturnsOnCall(i int, result1 string) {
+	fake.cFAssertionFileMutex.Lock()
+	defer fake.cFAssertionFileMutex.Unlock()
+	fake.CFAssertionFileStub = nil
+	if fake.cFAssertionFileReturnsOnCall == nil {
+		fake.cFAssertionFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cFAssertionFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CFPassword() string {
 	fake.cFPasswordMutex.Lock()
 	ret, specificReturn := fake.cFPasswordReturnsOnCall[len(fake.cFPasswordArgsForCall)]
@@ -1261,6 +1350,67 @@ func (fake *FakeConfig) CNBCredentialsReturnsOnCall(i int, result1 map[string]in
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) CheckCredentialStore(arg1 string) error {
+	fake.checkCredentialStoreMutex.Lock()
+	ret, specificReturn := fake.checkCredentialStoreReturnsOnCall[len(fake.checkCredentialStoreArgsForCall)]
+	fake.checkCredentialStoreArgsForCall = append(fake.checkCredentialStoreArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.CheckCredentialStoreStub
+	fakeReturns := fake.checkCredentialStoreReturns
+	fake.recordInvocation("CheckCredentialStore", []interface{}{arg1})
+	fake.checkCredentialStoreMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CheckCredentialStoreCallCount() int {
+	fake.checkCredentialStoreMutex.RLock()
+	defer fake.checkCredentialStoreMutex.RUnlock()
+	return len(fake.checkCredentialStoreArgsForCall)
+}
+
+func (fake *FakeConfig) CheckCredentialStoreCalls(stub func(string) error) {
+	fake.checkCredentialStoreMutex.Lock()
+	defer fake.checkCredentialStoreMutex.Unlock()
+	fake.CheckCredentialStoreStub = stub
+}
+
+func (fake *FakeConfig) CheckCredentialStoreArgsForCall(i int) string {
+	fake.checkCredentialStoreMutex.RLock()
+	defer fake.checkCredentialStoreMutex.RUnlock()
+	argsForCall := fake.checkCredentialStoreArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) CheckCredentialStoreReturns(result1 error) {
+	fake.checkCredentialStoreMutex.Lock()
+	defer fake.checkCredentialStoreMutex.Unlock()
+	fake.CheckCredentialStoreStub = nil
+	fake.checkCredentialStoreReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) CheckCredentialStoreReturnsOnCall(i int, result1 error) {
+	fake.checkCredentialStoreMutex.Lock()
+	defer fake.checkCredentialStoreMutex.Unlock()
+	fake.CheckCredentialStoreStub = nil
+	if fake.checkCredentialStoreReturnsOnCall == nil {
+		fake.checkCredentialStoreReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.checkCredentialStoreReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) ColorEnabled() configv3.ColorSetting {
 	fake.colorEnabledMutex.Lock()
 	ret, specificReturn := fake.colorEnabledReturnsOnCall[len(fake.colorEnabledArgsForCall)]
@@ -1314,6 +1464,67 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) CreateProfile(arg1 string) error {
+	fake.createProfileMutex.Lock()
+	ret, specificReturn := fake.createProfileReturnsOnCall[len(fake.createProfileArgsForCall)]
+	fake.createProfileArgsForCall = append(fake.createProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.CreateProfileStub
+	fakeReturns := fake.createProfileReturns
+	fake.recordInvocation("CreateProfile", []interface{}{arg1})
+	fake.createProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CreateProfileCallCount() int {
+	fake.createProfileMutex.RLock()
+	defer fake.createProfileMutex.RUnlock()
+	return len(fake.createProfileArgsForCall)
+}
+
+func (fake *FakeConfig) CreateProfileCalls(stub func(string) error) {
+	fake.createProfileMutex.Lock()
+	defer fake.createProfileMutex.Unlock()
+	fake.CreateProfileStub = stub
+}
+
+func (fake *FakeConfig) CreateProfileArgsForCall(i int) string {
+	fake.createProfileMutex.RLock()
+	defer fake.createProfileMutex.RUnlock()
+	argsForCall := fake.createProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) CreateProfileReturns(result1 error) {
+	fake.createProfileMutex.Lock()
+	defer fake.createProfileMutex.Unlock()
+	fake.CreateProfileStub = nil
+	fake.createProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) CreateProfileReturnsOnCall(i int, result1 error) {
+	fake.createProfileMutex.Lock()
+	defer fake.createProfileMutex.Unlock()
+	fake.CreateProfileStub = nil
+	if fake.createProfileReturnsOnCall == nil {
+		fake.createProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -2190,6 +2401,59 @@ func (fake *FakeConfig) NetworkPolicyV1EndpointReturnsOnCall(i int, result1 stri
 	}{result1}
 }
 
+func (fake *FakeConfig) OutputFormat() configv3.OutputFormat {
+	fake.outputFormatMutex.Lock()
+	ret, specificReturn := fake.outputFormatReturnsOnCall[len(fake.outputFormatArgsForCall)]
+	fake.outputFormatArgsForCall = append(fake.outputFormatArgsForCall, struct {
+	}{})
+	stub := fake.OutputFormatStub
+	fakeReturns := fake.outputFormatReturns
+	fake.recordInvocation("OutputFormat", []interface{}{})
+	fake.outputFormatMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) OutputFormatCallCount() int {
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
+	return len(fake.outputFormatArgsForCall)
+}
+
+func (fake *FakeConfig) OutputFormatCalls(stub func() configv3.OutputFormat) {
+	fake.outputFormatMutex.Lock()
+	defer fake.outputFormatMutex.Unlock()
+	fake.OutputFormatStub = stub
+}
+
+func (fake *FakeConfig) OutputFormatReturns(result1 configv3.OutputFormat) {
+	fake.outputFormatMutex.Lock()
+	defer fake.outputFormatMutex.Unlock()
+	fake.OutputFormatStub = nil
+	fake.outputFormatReturns = struct {
+		result1 configv3.OutputFormat
+	}{result1}
+}
+
+func (fake *FakeConfig) OutputFormatReturnsOnCall(i int, result1 configv3.OutputFormat) {
+	fake.outputFormatMutex.Lock()
+	defer fake.outputFormatMutex.Unlock()
+	fake.OutputFormatStub = nil
+	if fake.outputFormatReturnsOnCall == nil {
+		fake.outputFormatReturnsOnCall = make(map[int]struct {
+			result1 configv3.OutputFormat
+		})
+	}
+	fake.outputFormatReturnsOnCall[i] = struct {
+		result1 configv3.OutputFormat
+	}{result1}
+}
+
 func (fake *FakeConfig) OverallPollingTimeout() time.Duration {
 	fake.overallPollingTimeoutMutex.Lock()
 	ret, specificReturn := fake.overallPollingTimeoutReturnsOnCall[len(fake.overallPollingTimeoutArgsForCall)]
@@ -2455,6 +2719,112 @@ func (fake *FakeConfig) PollingIntervalReturnsOnCall(i int, result1 time.Duratio
 	}{result1}
 }
 
+func (fake *FakeConfig) ProfileName() string {
+	fake.profileNameMutex.Lock()
+	ret, specificReturn := fake.profileNameReturnsOnCall[len(fake.profileNameArgsForCall)]
+	fake.profileNameArgsForCall = append(fake.profileNameArgsForCall, struct {
+	}{})
+	stub := fake.ProfileNameStub
+	fakeReturns := fake.profileNameReturns
+	fake.recordInvocation("ProfileName", []interface{}{})
+	fake.profileNameMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ProfileNameCallCount() int {
+	fake.profileNameMutex.RLock()
+	defer fake.profileNameMutex.RUnlock()
+	return len(fake.profileNameArgsForCall)
+}
+
+func (fake *FakeConfig) ProfileNameCalls(stub func() string) {
+	fake.profileNameMutex.Lock()
+	defer fake.profileNameMutex.Unlock()
+	fake.ProfileNameStub = stub
+}
+
+func (fake *FakeConfig) ProfileNameReturns(result1 string) {
+	fake.profileNameMutex.Lock()
+	defer fake.profileNameMutex.Unlock()
+	fake.ProfileNameStub = nil
+	fake.profileNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ProfileNameReturnsOnCall(i int, result1 string) {
+	fake.profileNameMutex.Lock()
+	defer fake.profileNameMutex.Unlock()
+	fake.ProfileNameStub = nil
+	if fake.profileNameReturnsOnCall == nil {
+		fake.profileNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.profileNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) Profiles() []configv3.Profile {
+	fake.profilesMutex.Lock()
+	ret, specificReturn := fake.profilesReturnsOnCall[len(fake.profilesArgsForCall)]
+	fake.profilesArgsForCall = append(fake.profilesArgsForCall, struct {
+	}{})
+	stub := fake.ProfilesStub
+	fakeReturns := fake.profilesReturns
+	fake.recordInvocation("Profiles", []interface{}{})
+	fake.profilesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ProfilesCallCount() int {
+	fake.profilesMutex.RLock()
+	defer fake.profilesMutex.RUnlock()
+	return len(fake.profilesArgsForCall)
+}
+
+func (fake *FakeConfig) ProfilesCalls(stub func() []configv3.Profile) {
+	fake.profilesMutex.Lock()
+	defer fake.profilesMutex.Unlock()
+	fake.ProfilesStub = stub
+}
+
+func (fake *FakeConfig) ProfilesReturns(result1 []configv3.Profile) {
+	fake.profilesMutex.Lock()
+	defer fake.profilesMutex.Unlock()
+	fake.ProfilesStub = nil
+	fake.profilesReturns = struct {
+		result1 []configv3.Profile
+	}{result1}
+}
+
+func (fake *FakeConfig) ProfilesReturnsOnCall(i int, result1 []configv3.Profile) {
+	fake.profilesMutex.Lock()
+	defer fake.profilesMutex.Unlock()
+	fake.ProfilesStub = nil
+	if fake.profilesReturnsOnCall == nil {
+		fake.profilesReturnsOnCall = make(map[int]struct {
+			result1 []configv3.Profile
+		})
+	}
+	fake.profilesReturnsOnCall[i] = struct {
+		result1 []configv3.Profile
+	}{result1}
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -2795,6 +3165,67 @@ func (fake *FakeConfig) SetColorEnabledArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) SetCredentialStore(arg1 string) error {
+	fake.setCredentialStoreMutex.Lock()
+	ret, specificReturn := fake.setCredentialStoreReturnsOnCall[len(fake.setCredentialStoreArgsForCall)]
+	fake.setCredentialStoreArgsForCall = append(fake.setCredentialStoreArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SetCredentialStoreStub
+	fakeReturns := fake.setCredentialStoreReturns
+	fake.recordInvocation("SetCredentialStore", []interface{}{arg1})
+	fake.setCredentialStoreMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) SetCredentialStoreCallCount() int {
+	fake.setCredentialStoreMutex.RLock()
+	defer fake.setCredentialStoreMutex.RUnlock()
+	return len(fake.setCredentialStoreArgsForCall)
+}
+
+func (fake *FakeConfig) SetCredentialStoreCalls(stub func(string) error) {
+	fake.setCredentialStoreMutex.Lock()
+	defer fake.setCredentialStoreMutex.Unlock()
+	fake.SetCredentialStoreStub = stub
+}
+
+func (fake *FakeConfig) SetCredentialStoreArgsForCall(i int) string {
+	fake.setCredentialStoreMutex.RLock()
+	defer fake.setCredentialStoreMutex.RUnlock()
+	argsForCall := fake.setCredentialStoreArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetCredentialStoreReturns(result1 error) {
+	fake.setCredentialStoreMutex.Lock()
+	defer fake.setCredentialStoreMutex.Unlock()
+	fake.SetCredentialStoreStub = nil
+	fake.setCredentialStoreReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SetCredentialStoreReturnsOnCall(i int, result1 error) {
+	fake.setCredentialStoreMutex.Lock()
+	defer fake.setCredentialStoreMutex.Unlock()
+	fake.SetCredentialStoreStub = nil
+	if fake.setCredentialStoreReturnsOnCall == nil {
+		fake.setCredentialStoreReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setCredentialStoreReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) SetKubernetesAuthInfo(arg1 string) {
 	fake.setKubernetesAuthInfoMutex.Lock()
 	fake.setKubernetesAuthInfoArgsForCall = append(fake.setKubernetesAuthInfoArgsForCall, struct {
@@ -3088,6 +3519,38 @@ func (fake *FakeConfig) SetTraceArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) SetUAAAssertionFile(arg1 string) {
+	fake.setUAAAssertionFileMutex.Lock()
+	fake.setUAAAssertionFileArgsForCall = append(fake.setUAAAssertionFileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SetUAAAssertionFileStub
+	fake.recordInvocation("SetUAAAssertionFile", []interface{}{arg1})
+	fake.setUAAAssertionFileMutex.Unlock()
+	if stub != nil {
+		fake.SetUAAAssertionFileStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetUAAAssertionFileCallCount() int {
+	fake.setUAAAssertionFileMutex.RLock()
+	defer fake.setUAAAssertionFileMutex.RUnlock()
+	return len(fake.setUAAAssertionFileArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAAssertionFileCalls(stub func(string)) {
+	fake.setUAAAssertionFileMutex.Lock()
+	defer fake.setUAAAssertionFileMutex.Unlock()
+	fake.SetUAAAssertionFileStub = stub
+}
+
+func (fake *FakeConfig) SetUAAAssertionFileArgsForCall(i int) string {
+	fake.setUAAAssertionFileMutex.RLock()
+	defer fake.setUAAAssertionFileMutex.RUnlock()
+	argsForCall := fake.setUAAAssertionFileArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetUAAClientCredentials(arg1 string, arg2 string) {
 	fake.setUAAClientCredentialsMutex.Lock()
 	fake.setUAAClientCredentialsArgsForCall = append(fake.setUAAClientCredentialsArgsForCall, struct {
@@ -3609,6 +4072,59 @@ func (fake *FakeConfig) TerminalWidthReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) UAAAssertionFile() string {
+	fake.uAAAssertionFileMutex.Lock()
+	ret, specificReturn := fake.uAAAssertionFileReturnsOnCall[len(fake.uAAAssertionFileArgsForCall)]
+	fake.uAAAssertionFileArgsForCall = append(fake.uAAAssertionFileArgsForCall, struct {
+	}{})
+	stub := fake.UAAAssertionFileStub
+	fakeReturns := fake.uAAAssertionFileReturns
+	fake.recordInvocation("UAAAssertionFile", []interface{}{})
+	fake.uAAAssertionFileMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) UAAAssertionFileCallCount() int {
+	fake.uAAAssertionFileMutex.RLock()
+	defer fake.uAAAssertionFileMutex.RUnlock()
+	return len(fake.uAAAssertionFileArgsForCall)
+}
+
+func (fake *FakeConfig) UAAAssertionFileCalls(stub func() string) {
+	fake.uAAAssertionFileMutex.Lock()
+	defer fake.uAAAssertionFileMutex.Unlock()
+	fake.UAAAssertionFileStub = stub
+}
+
+func (fake *FakeConfig) UAAAssertionFileReturns(result1 string) {
+	fake.uAAAssertionFileMutex.Lock()
+	defer fake.uAAAssertionFileMutex.Unlock()
+	fake.UAAAssertionFileStub = nil
+	fake.uAAAssertionFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAAssertionFileReturnsOnCall(i int, result1 string) {
+	fake.uAAAssertionFileMutex.Lock()
+	defer fake.uAAAssertionFileMutex.Unlock()
+	fake.UAAAssertionFileStub = nil
+	if fake.uAAAssertionFileReturnsOnCall == nil {
+		fake.uAAAssertionFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uAAAssertionFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) UAADisableKeepAlives() bool {
 	fake.uAADisableKeepAlivesMutex.Lock()
 	ret, specificReturn := fake.uAADisableKeepAlivesReturnsOnCall[len(fake.uAADisableKeepAlivesArgsForCall)]
@@ -3946,6 +4462,67 @@ func (fake *FakeConfig) UnsetUserInformationCalls(stub func()) {
 	fake.UnsetUserInformationStub = stub
 }
 
+func (fake *FakeConfig) UseProfile(arg1 string) error {
+	fake.useProfileMutex.Lock()
+	ret, specificReturn := fake.useProfileReturnsOnCall[len(fake.useProfileArgsForCall)]
+	fake.useProfileArgsForCall = append(fake.useProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.UseProfileStub
+	fakeReturns := fake.useProfileReturns
+	fake.recordInvocation("UseProfile", []interface{}{arg1})
+	fake.useProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) UseProfileCallCount() int {
+	fake.useProfileMutex.RLock()
+	defer fake.useProfileMutex.RUnlock()
+	return len(fake.useProfileArgsForCall)
+}
+
+func (fake *FakeConfig) UseProfileCalls(stub func(string) error) {
+	fake.useProfileMutex.Lock()
+	defer fake.useProfileMutex.Unlock()
+	fake.UseProfileStub = stub
+}
+
+func (fake *FakeConfig) UseProfileArgsForCall(i int) string {
+	fake.useProfileMutex.RLock()
+	defer fake.useProfileMutex.RUnlock()
+	argsForCall := fake.useProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) UseProfileReturns(result1 error) {
+	fake.useProfileMutex.Lock()
+	defer fake.useProfileMutex.Unlock()
+	fake.UseProfileStub = nil
+	fake.useProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) UseProfileReturnsOnCall(i int, result1 error) {
+	fake.useProfileMutex.Lock()
+	defer fake.useProfileMutex.Unlock()
+	fake.UseProfileStub = nil
+	if fake.useProfileReturnsOnCall == nil {
+		fake.useProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.useProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) V7SetSpaceInformation(arg1 string, arg2 string) {
 	fake.v7SetSpaceInformationMutex.Lock()
 	fake.v7SetSpaceInformationArgsForCall = append(fake.v7SetSpaceInformationArgsForCall, struct {
@@ -4154,22 +4731,26 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.addPluginRepositoryMutex.RUnlock()
 	fake.authorizationEndpointMutex.RLock()
 	defer fake.authorizationEndpointMutex.RUnlock()
-	fake.b3SpanIDMutex.RLock()
-	defer fake.b3SpanIDMutex.RUnlock()
 	fake.b3TraceIDMutex.RLock()
 	defer fake.b3TraceIDMutex.RUnlock()
 	fake.binaryNameMutex.RLock()
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
 	defer fake.binaryVersionMutex.RUnlock()
+	fake.cFAssertionFileMutex.RLock()
+	defer fake.cFAssertionFileMutex.RUnlock()
 	fake.cFPasswordMutex.RLock()
 	defer fake.cFPasswordMutex.RUnlock()
 	fake.cFUsernameMutex.RLock()
 	defer fake.cFUsernameMutex.RUnlock()
 	fake.cNBCredentialsMutex.RLock()
 	defer fake.cNBCredentialsMutex.RUnlock()
+	fake.checkCredentialStoreMutex.RLock()
+	defer fake.checkCredentialStoreMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.createProfileMutex.RLock()
+	defer fake.createProfileMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
 	fake.currentUserNameMutex.RLock()
@@ -4202,6 +4783,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.nOAARequestRetryCountMutex.RUnlock()
 	fake.networkPolicyV1EndpointMutex.RLock()
 	defer fake.networkPolicyV1EndpointMutex.RUnlock()
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
@@ -4212,6 +4795,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.pluginsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.profileNameMutex.RLock()
+	defer fake.profileNameMutex.RUnlock()
+	fake.profilesMutex.RLock()
+	defer fake.profilesMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
@@ -4228,6 +4815,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setAsyncTimeoutMutex.RUnlock()
 	fake.setColorEnabledMutex.RLock()
 	defer fake.setColorEnabledMutex.RUnlock()
+	fake.setCredentialStoreMutex.RLock()
+	defer fake.setCredentialStoreMutex.RUnlock()
 	fake.setKubernetesAuthInfoMutex.RLock()
 	defer fake.setKubernetesAuthInfoMutex.RUnlock()
 	fake.setLocaleMutex.RLock()
@@ -4246,6 +4835,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setTokenInformationMutex.RUnlock()
 	fake.setTraceMutex.RLock()
 	defer fake.setTraceMutex.RUnlock()
+	fake.setUAAAssertionFileMutex.RLock()
+	defer fake.setUAAAssertionFileMutex.RUnlock()
 	fake.setUAAClientCredentialsMutex.RLock()
 	defer fake.setUAAClientCredentialsMutex.RUnlock()
 	fake.setUAAEndpointMutex.RLock()
@@ -4268,6 +4859,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.targetedSpaceMutex.RUnlock()
 	fake.terminalWidthMutex.RLock()
 	defer fake.terminalWidthMutex.RUnlock()
+	fake.uAAAssertionFileMutex.RLock()
+	defer fake.uAAAssertionFileMutex.RUnlock()
 	fake.uAADisableKeepAlivesMutex.RLock()
 	defer fake.uAADisableKeepAlivesMutex.RUnlock()
 	fake.uAAEndpointMutex.RLock()
@@ -4284,6 +4877,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.unsetSpaceInformationMutex.RUnlock()
 	fake.unsetUserInformationMutex.RLock()
 	defer fake.unsetUserInformationMutex.RUnlock()
+	fake.useProfileMutex.RLock()
+	defer fake.useProfileMutex.RUnlock()
 	fake.v7SetSpaceInformationMutex.RLock()
 	defer fake.v7SetSpaceInformationMutex.RUnlock()
 	fake.verboseMutex.RLock()
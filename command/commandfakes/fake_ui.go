@@ -117,6 +117,13 @@ type FakeUI struct {
 		arg1 ui.LogMessage
 		arg2 bool
 	}
+	DisplayLogMessageWithPrefixStub        func(string, ui.LogMessage, bool)
+	displayLogMessageWithPrefixMutex       sync.RWMutex
+	displayLogMessageWithPrefixArgsForCall []struct {
+		arg1 string
+		arg2 ui.LogMessage
+		arg3 bool
+	}
 	DisplayNewlineStub        func()
 	displayNewlineMutex       sync.RWMutex
 	displayNewlineArgsForCall []struct {
@@ -220,6 +227,13 @@ type FakeUI struct {
 		arg1 string
 		arg2 []map[string]interface{}
 	}
+	DisplayTextWithPrefixStub        func(string, string, ...map[string]interface{})
+	displayTextWithPrefixMutex       sync.RWMutex
+	displayTextWithPrefixArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []map[string]interface{}
+	}
 	DisplayWarningStub        func(string, ...map[string]interface{})
 	displayWarningMutex       sync.RWMutex
 	displayWarningArgsForCall []struct {
@@ -231,6 +245,24 @@ type FakeUI struct {
 	displayWarningsArgsForCall []struct {
 		arg1 []string
 	}
+	DisplayWarningsWithPrefixStub        func(string, []string)
+	displayWarningsWithPrefixMutex       sync.RWMutex
+	displayWarningsWithPrefixArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	DisplayYAMLStub        func(string, interface{}) error
+	displayYAMLMutex       sync.RWMutex
+	displayYAMLArgsForCall []struct {
+		arg1 string
+		arg2 interface{}
+	}
+	displayYAMLReturns struct {
+		result1 error
+	}
+	displayYAMLReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetErrStub        func() io.Writer
 	getErrMutex       sync.RWMutex
 	getErrArgsForCall []struct {
@@ -742,15 +774,16 @@ func (fake *FakeUI) DisplayJSON(arg1 string, arg2 interface{}) error {
 		arg1 string
 		arg2 interface{}
 	}{arg1, arg2})
+	stub := fake.DisplayJSONStub
+	fakeReturns := fake.displayJSONReturns
 	fake.recordInvocation("DisplayJSON", []interface{}{arg1, arg2})
 	fake.displayJSONMutex.Unlock()
-	if fake.DisplayJSONStub != nil {
-		return fake.DisplayJSONStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.displayJSONReturns
 	return fakeReturns.result1
 }
 
@@ -905,6 +938,40 @@ func (fake *FakeUI) DisplayLogMessageArgsForCall(i int) (ui.LogMessage, bool) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeUI) DisplayLogMessageWithPrefix(arg1 string, arg2 ui.LogMessage, arg3 bool) {
+	fake.displayLogMessageWithPrefixMutex.Lock()
+	fake.displayLogMessageWithPrefixArgsForCall = append(fake.displayLogMessageWithPrefixArgsForCall, struct {
+		arg1 string
+		arg2 ui.LogMessage
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.DisplayLogMessageWithPrefixStub
+	fake.recordInvocation("DisplayLogMessageWithPrefix", []interface{}{arg1, arg2, arg3})
+	fake.displayLogMessageWithPrefixMutex.Unlock()
+	if stub != nil {
+		fake.DisplayLogMessageWithPrefixStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeUI) DisplayLogMessageWithPrefixCallCount() int {
+	fake.displayLogMessageWithPrefixMutex.RLock()
+	defer fake.displayLogMessageWithPrefixMutex.RUnlock()
+	return len(fake.displayLogMessageWithPrefixArgsForCall)
+}
+
+func (fake *FakeUI) DisplayLogMessageWithPrefixCalls(stub func(string, ui.LogMessage, bool)) {
+	fake.displayLogMessageWithPrefixMutex.Lock()
+	defer fake.displayLogMessageWithPrefixMutex.Unlock()
+	fake.DisplayLogMessageWithPrefixStub = stub
+}
+
+func (fake *FakeUI) DisplayLogMessageWithPrefixArgsForCall(i int) (string, ui.LogMessage, bool) {
+	fake.displayLogMessageWithPrefixMutex.RLock()
+	defer fake.displayLogMessageWithPrefixMutex.RUnlock()
+	argsForCall := fake.displayLogMessageWithPrefixArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakeUI) DisplayNewline() {
 	fake.displayNewlineMutex.Lock()
 	fake.displayNewlineArgsForCall = append(fake.displayNewlineArgsForCall, struct {
@@ -1429,6 +1496,40 @@ func (fake *FakeUI) DisplayTextWithFlavorArgsForCall(i int) (string, []map[strin
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeUI) DisplayTextWithPrefix(arg1 string, arg2 string, arg3 ...map[string]interface{}) {
+	fake.displayTextWithPrefixMutex.Lock()
+	fake.displayTextWithPrefixArgsForCall = append(fake.displayTextWithPrefixArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []map[string]interface{}
+	}{arg1, arg2, arg3})
+	stub := fake.DisplayTextWithPrefixStub
+	fake.recordInvocation("DisplayTextWithPrefix", []interface{}{arg1, arg2, arg3})
+	fake.displayTextWithPrefixMutex.Unlock()
+	if stub != nil {
+		fake.DisplayTextWithPrefixStub(arg1, arg2, arg3...)
+	}
+}
+
+func (fake *FakeUI) DisplayTextWithPrefixCallCount() int {
+	fake.displayTextWithPrefixMutex.RLock()
+	defer fake.displayTextWithPrefixMutex.RUnlock()
+	return len(fake.displayTextWithPrefixArgsForCall)
+}
+
+func (fake *FakeUI) DisplayTextWithPrefixCalls(stub func(string, string, ...map[string]interface{})) {
+	fake.displayTextWithPrefixMutex.Lock()
+	defer fake.displayTextWithPrefixMutex.Unlock()
+	fake.DisplayTextWithPrefixStub = stub
+}
+
+func (fake *FakeUI) DisplayTextWithPrefixArgsForCall(i int) (string, string, []map[string]interface{}) {
+	fake.displayTextWithPrefixMutex.RLock()
+	defer fake.displayTextWithPrefixMutex.RUnlock()
+	argsForCall := fake.displayTextWithPrefixArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakeUI) DisplayWarning(arg1 string, arg2 ...map[string]interface{}) {
 	fake.displayWarningMutex.Lock()
 	fake.displayWarningArgsForCall = append(fake.displayWarningArgsForCall, struct {
@@ -1499,6 +1600,106 @@ func (fake *FakeUI) DisplayWarningsArgsForCall(i int) []string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeUI) DisplayWarningsWithPrefix(arg1 string, arg2 []string) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.displayWarningsWithPrefixMutex.Lock()
+	fake.displayWarningsWithPrefixArgsForCall = append(fake.displayWarningsWithPrefixArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.DisplayWarningsWithPrefixStub
+	fake.recordInvocation("DisplayWarningsWithPrefix", []interface{}{arg1, arg2Copy})
+	fake.displayWarningsWithPrefixMutex.Unlock()
+	if stub != nil {
+		fake.DisplayWarningsWithPrefixStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeUI) DisplayWarningsWithPrefixCallCount() int {
+	fake.displayWarningsWithPrefixMutex.RLock()
+	defer fake.displayWarningsWithPrefixMutex.RUnlock()
+	return len(fake.displayWarningsWithPrefixArgsForCall)
+}
+
+func (fake *FakeUI) DisplayWarningsWithPrefixCalls(stub func(string, []string)) {
+	fake.displayWarningsWithPrefixMutex.Lock()
+	defer fake.displayWarningsWithPrefixMutex.Unlock()
+	fake.DisplayWarningsWithPrefixStub = stub
+}
+
+func (fake *FakeUI) DisplayWarningsWithPrefixArgsForCall(i int) (string, []string) {
+	fake.displayWarningsWithPrefixMutex.RLock()
+	defer fake.displayWarningsWithPrefixMutex.RUnlock()
+	argsForCall := fake.displayWarningsWithPrefixArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUI) DisplayYAML(arg1 string, arg2 interface{}) error {
+	fake.displayYAMLMutex.Lock()
+	ret, specificReturn := fake.displayYAMLReturnsOnCall[len(fake.displayYAMLArgsForCall)]
+	fake.displayYAMLArgsForCall = append(fake.displayYAMLArgsForCall, struct {
+		arg1 string
+		arg2 interface{}
+	}{arg1, arg2})
+	stub := fake.DisplayYAMLStub
+	fakeReturns := fake.displayYAMLReturns
+	fake.recordInvocation("DisplayYAML", []interface{}{arg1, arg2})
+	fake.displayYAMLMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) DisplayYAMLCallCount() int {
+	fake.displayYAMLMutex.RLock()
+	defer fake.displayYAMLMutex.RUnlock()
+	return len(fake.displayYAMLArgsForCall)
+}
+
+func (fake *FakeUI) DisplayYAMLCalls(stub func(string, interface{}) error) {
+	fake.displayYAMLMutex.Lock()
+	defer fake.displayYAMLMutex.Unlock()
+	fake.DisplayYAMLStub = stub
+}
+
+func (fake *FakeUI) DisplayYAMLArgsForCall(i int) (string, interface{}) {
+	fake.displayYAMLMutex.RLock()
+	defer fake.displayYAMLMutex.RUnlock()
+	argsForCall := fake.displayYAMLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUI) DisplayYAMLReturns(result1 error) {
+	fake.displayYAMLMutex.Lock()
+	defer fake.displayYAMLMutex.Unlock()
+	fake.DisplayYAMLStub = nil
+	fake.displayYAMLReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUI) DisplayYAMLReturnsOnCall(i int, result1 error) {
+	fake.displayYAMLMutex.Lock()
+	defer fake.displayYAMLMutex.Unlock()
+	fake.DisplayYAMLStub = nil
+	if fake.displayYAMLReturnsOnCall == nil {
+		fake.displayYAMLReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayYAMLReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUI) GetErr() io.Writer {
 	fake.getErrMutex.Lock()
 	ret, specificReturn := fake.getErrReturnsOnCall[len(fake.getErrArgsForCall)]
@@ -1986,6 +2187,8 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.displayKeyValueTableForAppMutex.RUnlock()
 	fake.displayLogMessageMutex.RLock()
 	defer fake.displayLogMessageMutex.RUnlock()
+	fake.displayLogMessageWithPrefixMutex.RLock()
+	defer fake.displayLogMessageWithPrefixMutex.RUnlock()
 	fake.displayNewlineMutex.RLock()
 	defer fake.displayNewlineMutex.RUnlock()
 	fake.displayNonWrappingTableMutex.RLock()
@@ -2010,10 +2213,16 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.displayTextWithBoldMutex.RUnlock()
 	fake.displayTextWithFlavorMutex.RLock()
 	defer fake.displayTextWithFlavorMutex.RUnlock()
+	fake.displayTextWithPrefixMutex.RLock()
+	defer fake.displayTextWithPrefixMutex.RUnlock()
 	fake.displayWarningMutex.RLock()
 	defer fake.displayWarningMutex.RUnlock()
 	fake.displayWarningsMutex.RLock()
 	defer fake.displayWarningsMutex.RUnlock()
+	fake.displayWarningsWithPrefixMutex.RLock()
+	defer fake.displayWarningsWithPrefixMutex.RUnlock()
+	fake.displayYAMLMutex.RLock()
+	defer fake.displayYAMLMutex.RUnlock()
 	fake.getErrMutex.RLock()
 	defer fake.getErrMutex.RUnlock()
 	fake.getInMutex.RLock()
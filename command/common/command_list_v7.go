@@ -3,6 +3,7 @@ package common
 import (
 	"reflect"
 
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/plugin"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 )
@@ -11,7 +12,9 @@ var Commands commandList
 var ShouldFallbackToLegacy = false
 
 type commandList struct {
-	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	VerboseOrVersion bool              `short:"v" long:"version" description:"verbose and version flag"`
+	Output           flag.OutputFormat `long:"output" description:"Display results as a machine-readable json or yaml document. Supported by app, apps, events, marketplace, org-users, orgs, routes, services, sidecars and spaces"`
+	ProfileName      string            `long:"profile" description:"Run the command against this profile instead of the active one. Not supported by plugin commands"`
 
 	V3Push v7.PushCommand `command:"v3-push" description:"Push a new app or sync changes to an existing app" hidden:"true"`
 
@@ -20,7 +23,11 @@ type commandList struct {
 	AddPluginRepo                      plugin.AddPluginRepoCommand                  `command:"add-plugin-repo" description:"Add a new plugin repository"`
 	AllowSpaceSSH                      v7.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
 	App                                v7.AppCommand                                `command:"app" description:"Display health and status for an app"`
+	AppCrashes                         v7.AppCrashesCommand                         `command:"app-crashes" description:"Display the recent crashes of an app with the output logged before each crash"`
+	AppMetrics                         v7.AppMetricsCommand                         `command:"app-metrics" description:"Display cpu, memory, disk and log rate metrics for the instances of an app"`
+	AppRequests                        v7.AppRequestsCommand                        `command:"app-requests" description:"Summarise the HTTP requests routed to an app from its router access logs"`
 	ApplyManifest                      v7.ApplyManifestCommand                      `command:"apply-manifest" description:"Apply manifest properties to a space"`
+	ApplySpace                         v7.ApplySpaceCommand                         `command:"apply-space" description:"Converge the targeted space with a bundle written by export-space"`
 	Apps                               v7.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
 	Auth                               v7.AuthCommand                               `command:"auth" description:"Authenticate non-interactively"`
 	BindRouteService                   v7.BindRouteServiceCommand                   `command:"bind-route-service" alias:"brs" description:"Bind a service instance to an HTTP route"`
@@ -49,6 +56,7 @@ type commandList struct {
 	CreateServiceBroker                v7.CreateServiceBrokerCommand                `command:"create-service-broker" alias:"csb" description:"Create a service broker"`
 	CreateServiceKey                   v7.CreateServiceKeyCommand                   `command:"create-service-key" alias:"csk" description:"Create key for a service instance"`
 	CreateSharedDomain                 v7.CreateSharedDomainCommand                 `command:"create-shared-domain" description:"Create a domain that can be used by all orgs (admin-only)"`
+	CreateSidecar                      v7.CreateSidecarCommand                      `command:"create-sidecar" description:"Create a sidecar process for an app"`
 	CreateSpace                        v7.CreateSpaceCommand                        `command:"create-space" alias:"csp" description:"Create a space"`
 	CreateSpaceQuota                   v7.CreateSpaceQuotaCommand                   `command:"create-space-quota" description:"Define a new quota for a space"`
 	CreateUser                         v7.CreateUserCommand                         `command:"create-user" description:"Create a new user"`
@@ -67,9 +75,11 @@ type commandList struct {
 	DeleteServiceBroker                v7.DeleteServiceBrokerCommand                `command:"delete-service-broker" description:"Delete a service broker"`
 	DeleteServiceKey                   v7.DeleteServiceKeyCommand                   `command:"delete-service-key" alias:"dsk" description:"Delete a service key"`
 	DeleteSharedDomain                 v7.DeleteSharedDomainCommand                 `command:"delete-shared-domain" description:"Delete a shared domain"`
+	DeleteSidecar                      v7.DeleteSidecarCommand                      `command:"delete-sidecar" description:"Delete a sidecar process of an app"`
 	DeleteSpace                        v7.DeleteSpaceCommand                        `command:"delete-space" description:"Delete a space"`
 	DeleteSpaceQuota                   v7.DeleteSpaceQuotaCommand                   `command:"delete-space-quota" description:"Delete a space quota"`
 	DeleteUser                         v7.DeleteUserCommand                         `command:"delete-user" description:"Delete a user"`
+	Deployment                         v7.DeploymentCommand                         `command:"deployment" description:"Display the status of the active deployment of an app"`
 	DisableFeatureFlag                 v7.DisableFeatureFlagCommand                 `command:"disable-feature-flag" description:"Prevent use of a feature"`
 	DisableOrgIsolation                v7.DisableOrgIsolationCommand                `command:"disable-org-isolation" description:"Revoke an organization's entitlement to an isolation segment"`
 	DisableSSH                         v7.DisableSSHCommand                         `command:"disable-ssh" description:"Disable ssh for the application"`
@@ -77,6 +87,7 @@ type commandList struct {
 	DisallowSpaceSSH                   v7.DisallowSpaceSSHCommand                   `command:"disallow-space-ssh" description:"Disallow SSH access for the space"`
 	Domains                            v7.DomainsCommand                            `command:"domains" description:"List domains in the target org"`
 	DownloadDroplet                    v7.DownloadDropletCommand                    `command:"download-droplet" description:"Download an application droplet"`
+	DownloadPackage                    v7.DownloadPackageCommand                    `command:"download-package" description:"Download the source package of an application"`
 	Droplets                           v7.DropletsCommand                           `command:"droplets" description:"List droplets of an app"`
 	EnableFeatureFlag                  v7.EnableFeatureFlagCommand                  `command:"enable-feature-flag" description:"Allow use of a feature"`
 	EnableOrgIsolation                 v7.EnableOrgIsolationCommand                 `command:"enable-org-isolation" description:"Entitle an organization to an isolation segment"`
@@ -84,6 +95,7 @@ type commandList struct {
 	EnableServiceAccess                v7.EnableServiceAccessCommand                `command:"enable-service-access" description:"Enable access to a service offering or service plan for one or all orgs"`
 	Env                                v7.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
 	Events                             v7.EventsCommand                             `command:"events" description:"Show recent app events"`
+	ExportSpace                        v7.ExportSpaceCommand                        `command:"export-space" description:"Export every app, service instance and route of a space as a manifest bundle"`
 	FeatureFlag                        v7.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
 	FeatureFlags                       v7.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status"`
 	GetHealthCheck                     v7.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
@@ -108,6 +120,8 @@ type commandList struct {
 	Packages                           v7.PackagesCommand                           `command:"packages" description:"List packages of an app"`
 	Passwd                             v7.PasswdCommand                             `command:"passwd" alias:"pw" description:"Change user password"`
 	Plugins                            plugin.PluginsCommand                        `command:"plugins" description:"List commands of installed plugins"`
+	Profile                            v7.ProfileCommand                            `command:"profile" description:"Create a profile or switch to it"`
+	Profiles                           v7.ProfilesCommand                           `command:"profiles" description:"List profiles and their targets"`
 	PurgeServiceInstance               v7.PurgeServiceInstanceCommand               `command:"purge-service-instance" description:"Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"`
 	PurgeServiceOffering               v7.PurgeServiceOfferingCommand               `command:"purge-service-offering" description:"Recursively remove a service offering and child objects from Cloud Foundry database without making requests to a service broker"`
 	Push                               v7.PushCommand                               `command:"push" alias:"p" description:"Push a new app or sync changes to an existing app"`
@@ -161,6 +175,7 @@ type commandList struct {
 	SharePrivateDomain                 v7.SharePrivateDomainCommand                 `command:"share-private-domain" description:"Share a private domain with a specific org"`
 	ShareService                       v7.ShareServiceCommand                       `command:"share-service" description:"Share a service instance with another space"`
 	ShareRoute                         v7.ShareRouteCommand                         `command:"share-route" description:"Share a route in between spaces"`
+	Sidecars                           v7.SidecarsCommand                           `command:"sidecars" description:"List sidecar processes of an app"`
 	Space                              v7.SpaceCommand                              `command:"space" description:"Show space info"`
 	SpaceQuota                         v7.SpaceQuotaCommand                         `command:"space-quota" description:"Show space quota info"`
 	SpaceQuotas                        v7.SpaceQuotasCommand                        `command:"space-quotas" description:"List available space quotas"`
@@ -177,6 +192,7 @@ type commandList struct {
 	Task                               v7.TaskCommand                               `command:"task" description:"Display a task of an app"`
 	Tasks                              v7.TasksCommand                              `command:"tasks" description:"List tasks of an app"`
 	TerminateTask                      v7.TerminateTaskCommand                      `command:"terminate-task" description:"Terminate a running task of an app"`
+	Top                                v7.TopCommand                                `command:"top" description:"Show a live view of the resource usage of every app instance in the target space"`
 	MoveRoute                          v7.MoveRouteCommand                          `command:"move-route" description:"Assign a route to a different space"`
 	UnbindRouteService                 v7.UnbindRouteServiceCommand                 `command:"unbind-route-service" alias:"urs" description:"Unbind a service instance from an HTTP route"`
 	UnbindRunningSecurityGroup         v7.UnbindRunningSecurityGroupCommand         `command:"unbind-running-security-group" description:"Unbind a security group from the set of security groups for running applications globally"`
@@ -200,9 +216,12 @@ type commandList struct {
 	UpdateService                      v7.UpdateServiceCommand                      `command:"update-service" description:"Update a service instance"`
 	UpgradeService                     v7.UpgradeServiceCommand                     `command:"upgrade-service" description:"Upgrade a service instance to the latest available version of its current service plan"`
 	UpdateServiceBroker                v7.UpdateServiceBrokerCommand                `command:"update-service-broker" description:"Update a service broker"`
+	UpdateSidecar                      v7.UpdateSidecarCommand                      `command:"update-sidecar" description:"Update a sidecar process of an app"`
 	UpdateSpaceQuota                   v7.UpdateSpaceQuotaCommand                   `command:"update-space-quota" description:"Update an existing space quota"`
 	UpdateUserProvidedService          v7.UpdateUserProvidedServiceCommand          `command:"update-user-provided-service" alias:"uups" description:"Update user-provided service instance"`
+	ValidateManifest                   v7.ValidateManifestCommand                   `command:"validate-manifest" description:"Check a manifest for problems without contacting Cloud Foundry"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
+	WaitApp                            v7.WaitAppCommand                            `command:"wait-app" description:"Wait for app instances or a deployment to reach a state"`
 }
 
 // HasCommand returns true if the command name is in the command list.
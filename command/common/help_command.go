@@ -330,6 +330,8 @@ func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
+		{"--output FORMAT", cmd.UI.TranslateText("Display results as a machine-readable json or yaml document. Supported by app, apps, events, marketplace, org-users, orgs, routes, services, sidecars and spaces")},
+		{"--profile NAME", cmd.UI.TranslateText("Run the command against this profile instead of the active one. Not supported by plugin commands")},
 	}
 }
 
@@ -6,6 +6,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
 			{"api", "auth"},
+			{"profiles", "profile"},
 		},
 	},
 	{
@@ -13,16 +14,17 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"apps", "app", "create-app"},
 			{"push", "scale", "delete", "rename"},
-			{"cancel-deployment", "continue-deployment"},
-			{"start", "stop", "restart", "stage-package", "restage", "restart-app-instance"},
+			{"deployment", "cancel-deployment", "continue-deployment"},
+			{"start", "stop", "restart", "stage-package", "restage", "restart-app-instance", "wait-app"},
 			{"run-task", "task", "tasks", "terminate-task"},
-			{"packages", "create-package"},
+			{"packages", "create-package", "download-package"},
+			{"sidecars", "create-sidecar", "update-sidecar", "delete-sidecar"},
 			{"revision", "revisions", "rollback"},
 			{"droplets", "set-droplet", "download-droplet"},
-			{"events", "logs"},
+			{"events", "logs", "app-crashes", "app-metrics", "app-requests", "top"},
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
-			{"copy-source", "create-app-manifest"},
+			{"copy-source", "create-app-manifest", "validate-manifest"},
 			{"get-health-check", "set-health-check", "get-readiness-health-check"},
 			{"enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
 		},
@@ -50,7 +52,7 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "SPACES:",
 		CommandList: [][]string{
 			{"spaces", "space"},
-			{"create-space", "delete-space", "rename-space", "apply-manifest"},
+			{"create-space", "delete-space", "rename-space", "apply-manifest", "export-space", "apply-space"},
 			{"allow-space-ssh", "disallow-space-ssh", "space-ssh-allowed"},
 		},
 	},
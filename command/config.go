@@ -18,14 +18,17 @@ type Config interface {
 	B3TraceID() string
 	BinaryName() string
 	BinaryVersion() string
+	CFAssertionFile() string
 	CFPassword() string
 	CFUsername() string
+	CheckCredentialStore(name string) error
 	ColorEnabled() configv3.ColorSetting
 	CurrentUser() (configv3.User, error)
 	CurrentUserName() (string, error)
 	DialTimeout() time.Duration
 	DockerPassword() string
 	CNBCredentials() (map[string]interface{}, error)
+	CreateProfile(name string) error
 	Experimental() bool
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
@@ -37,11 +40,14 @@ type Config interface {
 	MinCLIVersion() string
 	NOAARequestRetryCount() int
 	NetworkPolicyV1Endpoint() string
+	OutputFormat() configv3.OutputFormat
 	OverallPollingTimeout() time.Duration
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
+	ProfileName() string
+	Profiles() []configv3.Profile
 	RefreshToken() string
 	RemovePlugin(string)
 	RequestRetryCount() int
@@ -49,6 +55,7 @@ type Config interface {
 	SetAsyncTimeout(timeout int)
 	SetAccessToken(token string)
 	SetColorEnabled(enabled string)
+	SetCredentialStore(name string) error
 	SetLocale(locale string)
 	SetMinCLIVersion(version string)
 	SetOrganizationInformation(guid string, name string)
@@ -58,6 +65,7 @@ type Config interface {
 	SetTargetInformation(args configv3.TargetInformationArgs)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
 	SetTrace(trace string)
+	SetUAAAssertionFile(path string)
 	SetUAAClientCredentials(client string, clientSecret string)
 	SetUAAEndpoint(uaaEndpoint string)
 	SetUAAGrantType(uaaGrantType string)
@@ -71,6 +79,7 @@ type Config interface {
 	TargetedOrganizationName() string
 	TargetedSpace() configv3.Space
 	TerminalWidth() int
+	UAAAssertionFile() string
 	UAADisableKeepAlives() bool
 	UAAEndpoint() string
 	UAAGrantType() string
@@ -79,6 +88,7 @@ type Config interface {
 	UnsetOrganizationAndSpaceInformation()
 	UnsetSpaceInformation()
 	UnsetUserInformation()
+	UseProfile(name string) error
 	Verbose() (bool, []string)
 	WritePluginConfig() error
 	WriteConfig() error
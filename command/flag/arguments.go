@@ -8,6 +8,10 @@ type OptionalAppName struct {
 	AppName string `positional-arg-name:"APP_NAME" description:"The application name"`
 }
 
+type OptionalAppNames struct {
+	AppNames []string `positional-arg-name:"APP_NAME" description:"The application names"`
+}
+
 type AppDroplet struct {
 	AppName     string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	DropletGUID string `positional-arg-name:"DROPLET_GUID" required:"true" description:"The droplet guid"`
@@ -187,6 +191,11 @@ type UnsetEnvironmentArgs struct {
 	EnvironmentVariableName string `positional-arg-name:"ENV_VAR_NAME" required:"true" description:"The environment variable name"`
 }
 
+type AppSidecarArgs struct {
+	AppName     string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	SidecarName string `positional-arg-name:"SIDECAR_NAME" required:"true" description:"The sidecar name"`
+}
+
 type CopySourceArgs struct {
 	SourceAppName string `positional-arg-name:"SOURCE-APP" required:"true" description:"The old application name"`
 	TargetAppName string `positional-arg-name:"TARGET-NAME" required:"true" description:"The new application name"`
@@ -288,6 +297,11 @@ type UnsetLabelArgs struct {
 	ResourceName string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
 	LabelKeys    []string `positional-arg-name:"KEY" required:"true" description:"A label to unset on the resource"`
 }
+type ProfileArgs struct {
+	Action ProfileAction `positional-arg-name:"ACTION" required:"true" description:"The action to perform: create or use"`
+	Name   string        `positional-arg-name:"NAME" required:"true" description:"The profile name"`
+}
+
 type OrgRoleArgs struct {
 	Username     string  `positional-arg-name:"USERNAME" required:"true" description:"The user"`
 	Organization string  `positional-arg-name:"ORG" required:"true" description:"The organization"`
@@ -413,3 +427,7 @@ type TaskArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	TaskID  int    `positional-arg-name:"TASK_ID" required:"true" description:"The Task ID for the application"`
 }
+
+type OptionalManifestPath struct {
+	PathToManifest string `positional-arg-name:"PATH" description:"Path to the manifest file, or to a directory containing manifest.yml"`
+}
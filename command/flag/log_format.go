@@ -0,0 +1,31 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LogFormat struct {
+	Format string
+}
+
+func (LogFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"json", "logfmt", "ndjson"}, prefix, false)
+}
+
+func (l *LogFormat) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+
+	switch valLower {
+	case "json", "logfmt", "ndjson":
+		l.Format = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrInvalidChoice,
+			Message: `FORMAT must be "json", "ndjson" or "logfmt"`,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogFormat", func() {
+	var logFormat LogFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := logFormat.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+
+			Entry("completes to 'json' when passed 'j'", "j",
+				[]flags.Completion{{Item: "json"}}),
+			Entry("completes to 'ndjson' when passed 'N'", "N",
+				[]flags.Completion{{Item: "ndjson"}}),
+			Entry("returns every format when passed nothing", "",
+				[]flags.Completion{{Item: "json"}, {Item: "logfmt"}, {Item: "ndjson"}}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			logFormat = LogFormat{}
+		})
+
+		DescribeTable("downcases and sets the format",
+			func(input string, expected string) {
+				err := logFormat.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(logFormat.Format).To(Equal(expected))
+			},
+			Entry("sets 'json' when passed 'JSON'", "JSON", "json"),
+			Entry("sets 'ndjson' when passed 'ndjson'", "ndjson", "ndjson"),
+			Entry("sets 'logfmt' when passed 'LogFmt'", "LogFmt", "logfmt"),
+		)
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := logFormat.UnmarshalFlag("xml")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrInvalidChoice,
+					Message: `FORMAT must be "json", "ndjson" or "logfmt"`,
+				}))
+				Expect(logFormat.Format).To(BeEmpty())
+			})
+		})
+	})
+})
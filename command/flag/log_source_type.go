@@ -0,0 +1,30 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+var logSourceTypes = []string{"API", "APP", "APP/PROC", "CELL", "RTR", "STG"}
+
+type LogSourceType string
+
+func (LogSourceType) Complete(prefix string) []flags.Completion {
+	return completions(logSourceTypes, prefix, false)
+}
+
+func (l *LogSourceType) UnmarshalFlag(val string) error {
+	valUpper := strings.ToUpper(val)
+	for _, sourceType := range logSourceTypes {
+		if valUpper == sourceType {
+			*l = LogSourceType(valUpper)
+			return nil
+		}
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: `SOURCE_TYPE must be "API", "APP", "APP/PROC", "CELL", "RTR" or "STG"`,
+	}
+}
@@ -0,0 +1,55 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogSourceType", func() {
+	var sourceType LogSourceType
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := sourceType.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'APP' and 'APP/PROC' when passed 'a'", "a",
+				[]flags.Completion{{Item: "API"}, {Item: "APP"}, {Item: "APP/PROC"}}),
+			Entry("returns 'APP/PROC' when passed 'APP/'", "APP/",
+				[]flags.Completion{{Item: "APP/PROC"}}),
+			Entry("returns 'RTR' when passed 'r'", "r",
+				[]flags.Completion{{Item: "RTR"}}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			sourceType = ""
+		})
+
+		DescribeTable("upcases and sets the source type",
+			func(input string, expected LogSourceType) {
+				err := sourceType.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sourceType).To(Equal(expected))
+			},
+			Entry("sets 'APP/PROC' when passed 'app/proc'", "app/proc", LogSourceType("APP/PROC")),
+			Entry("sets 'STG' when passed 'STG'", "STG", LogSourceType("STG")),
+			Entry("sets 'CELL' when passed 'Cell'", "Cell", LogSourceType("CELL")),
+		)
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := sourceType.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `SOURCE_TYPE must be "API", "APP", "APP/PROC", "CELL", "RTR" or "STG"`,
+				}))
+				Expect(sourceType).To(BeEmpty())
+			})
+		})
+	})
+})
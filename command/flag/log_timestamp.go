@@ -0,0 +1,35 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// LogTimestamp is either an RFC3339 timestamp or a duration, which is taken
+// to mean that long before now.
+type LogTimestamp struct {
+	Time time.Time
+}
+
+func (t *LogTimestamp) UnmarshalFlag(val string) error {
+	if timestamp, err := time.Parse(time.RFC3339, val); err == nil {
+		t.Time = timestamp
+		return nil
+	}
+
+	duration, err := time.ParseDuration(val)
+	if err != nil || duration < 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `Time must be an RFC3339 timestamp such as "2006-01-02T15:04:05Z" or a duration such as "10m"`,
+		}
+	}
+
+	t.Time = time.Now().Add(-duration)
+	return nil
+}
+
+func (t LogTimestamp) IsSet() bool {
+	return !t.Time.IsZero()
+}
@@ -0,0 +1,48 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogTimestamp", func() {
+	var timestamp LogTimestamp
+
+	BeforeEach(func() {
+		timestamp = LogTimestamp{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		When("passed an RFC3339 timestamp", func() {
+			It("sets the time", func() {
+				err := timestamp.UnmarshalFlag("2021-03-04T05:06:07Z")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timestamp.Time).To(Equal(time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)))
+				Expect(timestamp.IsSet()).To(BeTrue())
+			})
+		})
+
+		When("passed a duration", func() {
+			It("sets the time that long before now", func() {
+				err := timestamp.UnmarshalFlag("10m")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timestamp.Time).To(BeTemporally("~", time.Now().Add(-10*time.Minute), time.Second))
+			})
+		})
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := timestamp.UnmarshalFlag("yesterday")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `Time must be an RFC3339 timestamp such as "2006-01-02T15:04:05Z" or a duration such as "10m"`,
+				}))
+				Expect(timestamp.IsSet()).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// PositiveDuration is a Go duration string, such as "90s" or "1m", that is
+// longer than zero.
+type PositiveDuration struct {
+	Duration time.Duration
+}
+
+func (d *PositiveDuration) UnmarshalFlag(val string) error {
+	duration, err := time.ParseDuration(val)
+	if err != nil || duration <= 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `Duration must be greater than zero, such as "30s" or "5m"`,
+		}
+	}
+
+	d.Duration = duration
+	return nil
+}
+
+func (d PositiveDuration) IsSet() bool {
+	return d.Duration != 0
+}
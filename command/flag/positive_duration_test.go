@@ -0,0 +1,47 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PositiveDuration", func() {
+	var duration PositiveDuration
+
+	BeforeEach(func() {
+		duration = PositiveDuration{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("accepts durations greater than zero",
+			func(input string, expected time.Duration) {
+				err := duration.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(duration.Duration).To(Equal(expected))
+				Expect(duration.IsSet()).To(BeTrue())
+			},
+			Entry("seconds", "30s", 30*time.Second),
+			Entry("minutes", "5m", 5*time.Minute),
+			Entry("compound", "1h30m", 90*time.Minute),
+		)
+
+		DescribeTable("rejects anything else",
+			func(input string) {
+				err := duration.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `Duration must be greater than zero, such as "30s" or "5m"`,
+				}))
+				Expect(duration.IsSet()).To(BeFalse())
+			},
+			Entry("zero", "0s"),
+			Entry("negative", "-1m"),
+			Entry("no unit", "30"),
+			Entry("words", "soon"),
+		)
+	})
+})
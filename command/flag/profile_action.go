@@ -0,0 +1,36 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+const (
+	ProfileActionCreate = "create"
+	ProfileActionUse    = "use"
+)
+
+type ProfileAction struct {
+	Action string
+}
+
+func (ProfileAction) Complete(prefix string) []flags.Completion {
+	return completions([]string{ProfileActionCreate, ProfileActionUse}, prefix, false)
+}
+
+func (p *ProfileAction) UnmarshalFlag(val string) error {
+	switch strings.ToLower(val) {
+	case ProfileActionCreate:
+		p.Action = ProfileActionCreate
+	case ProfileActionUse:
+		p.Action = ProfileActionUse
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `ACTION must be "create" or "use"`,
+		}
+	}
+
+	return nil
+}
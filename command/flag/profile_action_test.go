@@ -0,0 +1,56 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProfileAction", func() {
+	var profileAction ProfileAction
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := profileAction.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'create' when passed 'c'", "c",
+				[]flags.Completion{{Item: "create"}}),
+			Entry("returns 'use' when passed 'U'", "U",
+				[]flags.Completion{{Item: "use"}}),
+			Entry("returns 'create' and 'use' when passed nothing", "",
+				[]flags.Completion{{Item: "create"}, {Item: "use"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			profileAction = ProfileAction{}
+		})
+
+		It("accepts create", func() {
+			err := profileAction.UnmarshalFlag("Create")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(profileAction).To(Equal(ProfileAction{Action: "create"}))
+		})
+
+		It("accepts use", func() {
+			err := profileAction.UnmarshalFlag("use")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(profileAction).To(Equal(ProfileAction{Action: "use"}))
+		})
+
+		It("errors on anything else", func() {
+			err := profileAction.UnmarshalFlag("delete")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `ACTION must be "create" or "use"`,
+			}))
+			Expect(profileAction.Action).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+var topSortColumns = []string{"app", "cpu", "crashes", "disk", "log-rate", "memory", "state"}
+
+type TopSortColumn string
+
+func (TopSortColumn) Complete(prefix string) []flags.Completion {
+	return completions(topSortColumns, prefix, false)
+}
+
+func (c *TopSortColumn) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	for _, column := range topSortColumns {
+		if valLower == column {
+			*c = TopSortColumn(valLower)
+			return nil
+		}
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: `COLUMN must be "app", "cpu", "crashes", "disk", "log-rate", "memory" or "state"`,
+	}
+}
@@ -0,0 +1,52 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TopSortColumn", func() {
+	var column TopSortColumn
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := column.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'cpu' and 'crashes' when passed 'c'", "c",
+				[]flags.Completion{{Item: "cpu"}, {Item: "crashes"}}),
+			Entry("returns 'log-rate' when passed 'L'", "L",
+				[]flags.Completion{{Item: "log-rate"}}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			column = ""
+		})
+
+		DescribeTable("downcases and sets the column",
+			func(input string, expected TopSortColumn) {
+				err := column.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(column).To(Equal(expected))
+			},
+			Entry("sets 'memory' when passed 'MEMORY'", "MEMORY", TopSortColumn("memory")),
+			Entry("sets 'log-rate' when passed 'log-rate'", "log-rate", TopSortColumn("log-rate")),
+		)
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := column.UnmarshalFlag("uptime")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `COLUMN must be "app", "cpu", "crashes", "disk", "log-rate", "memory" or "state"`,
+				}))
+				Expect(column).To(BeEmpty())
+			})
+		})
+	})
+})
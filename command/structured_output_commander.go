@@ -0,0 +1,9 @@
+package command
+
+// StructuredOutputCommander is implemented by commands that can display their
+// results as a json or yaml document. The global --output flag is rejected for
+// every other command.
+type StructuredOutputCommander interface {
+	ExtendedCommander
+	SupportsStructuredOutput()
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+type AppWaitTimeoutError struct {
+	Name    string
+	Reasons []string
+}
+
+func (AppWaitTimeoutError) Error() string {
+	return "Timed out waiting for app {{.AppName}}:\n{{.Reasons}}"
+}
+
+func (e AppWaitTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.Name,
+		"Reasons": "   " + strings.Join(e.Reasons, "\n   "),
+	})
+}
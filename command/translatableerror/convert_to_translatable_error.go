@@ -28,6 +28,8 @@ func ConvertToTranslatableError(err error) error {
 		return ApplicationNotStartedError(e)
 	case actionerror.AppNotFoundInManifestError:
 		return AppNotFoundInManifestError(e)
+	case actionerror.AppWaitTimeoutError:
+		return AppWaitTimeoutError(e)
 	case actionerror.AssignDropletError:
 		return AssignDropletError(e)
 	case actionerror.BuildpackNotFoundError:
@@ -40,6 +42,8 @@ func ConvertToTranslatableError(err error) error {
 		return DockerPasswordNotSetError{}
 	case actionerror.DomainNotFoundError:
 		return DomainNotFoundError(e)
+	case actionerror.DownloadChecksumMismatchError:
+		return DownloadChecksumMismatchError(e)
 	case manifest.EmptyBuildpacksError:
 		return EmptyBuildpacksError(e)
 	case actionerror.EmptyArchiveError:
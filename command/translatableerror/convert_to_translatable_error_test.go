@@ -47,6 +47,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.AppNotFoundInManifestError{Name: "some-app"},
 			AppNotFoundInManifestError{Name: "some-app"}),
 
+		Entry("actionerror.AppWaitTimeoutError -> AppWaitTimeoutError",
+			actionerror.AppWaitTimeoutError{Name: "some-app", Reasons: []string{"instance #0 is crashed"}},
+			AppWaitTimeoutError{Name: "some-app", Reasons: []string{"instance #0 is crashed"}}),
+
 		Entry("actionerror.AssignDropletError -> AssignDropletError",
 			actionerror.AssignDropletError{Message: "some-message"},
 			AssignDropletError{Message: "some-message"}),
@@ -75,6 +79,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.DomainNotFoundError{Name: "some-domain-name", GUID: "some-domain-guid"},
 			DomainNotFoundError{Name: "some-domain-name", GUID: "some-domain-guid"}),
 
+		Entry("actionerror.DownloadChecksumMismatchError -> DownloadChecksumMismatchError",
+			actionerror.DownloadChecksumMismatchError{Type: "sha256", Expected: "some-checksum", Actual: "other-checksum"},
+			DownloadChecksumMismatchError{Type: "sha256", Expected: "some-checksum", Actual: "other-checksum"}),
+
 		Entry("actionerror.EmptyBuildpacksError -> EmptyBuildpacksError",
 			manifest.EmptyBuildpacksError{},
 			EmptyBuildpacksError{},
@@ -0,0 +1,19 @@
+package translatableerror
+
+type DownloadChecksumMismatchError struct {
+	Type     string
+	Expected string
+	Actual   string
+}
+
+func (DownloadChecksumMismatchError) Error() string {
+	return "Downloaded bits have {{.Type}} checksum {{.Actual}} but {{.Expected}} was expected.\nPlease try again."
+}
+
+func (e DownloadChecksumMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Type":     e.Type,
+		"Expected": e.Expected,
+		"Actual":   e.Actual,
+	})
+}
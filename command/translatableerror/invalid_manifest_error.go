@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidManifestError is returned when validate-manifest finds problems in a
+// manifest.
+type InvalidManifestError struct {
+	PathToManifest string
+	IssueCount     int
+}
+
+func (InvalidManifestError) Error() string {
+	return "Manifest {{.PathToManifest}} is invalid: {{.IssueCount}} problem(s) found."
+}
+
+func (e InvalidManifestError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PathToManifest": e.PathToManifest,
+		"IssueCount":     e.IssueCount,
+	})
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+// OutputFlagNotSupportedError is returned when the global --output flag is
+// used with a command that cannot display its results as a document.
+type OutputFlagNotSupportedError struct{}
+
+func (OutputFlagNotSupportedError) Error() string {
+	return "Flag '--output' is not supported by this command."
+}
+
+func (e OutputFlagNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type PackageFileError struct {
+	Err error
+}
+
+func (PackageFileError) Error() string {
+	return "Error creating package file: {{.Error}}"
+}
+
+func (e PackageFileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Error": e.Err,
+	})
+}
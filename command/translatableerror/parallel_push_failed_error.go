@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// ParallelPushFailedError is returned when one or more apps pushed with
+// --parallel failed or were skipped.
+type ParallelPushFailedError struct {
+	AppNames []string
+}
+
+func (ParallelPushFailedError) Error() string {
+	return "Push unsuccessful for apps: {{.AppNames}}"
+}
+
+func (e ParallelPushFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, ", "),
+	})
+}
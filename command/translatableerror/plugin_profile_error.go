@@ -0,0 +1,15 @@
+package translatableerror
+
+type PluginProfileError struct {
+	Command string
+}
+
+func (e PluginProfileError) Error() string {
+	return "Plugin command '{{.Command}}' cannot be run with --profile. Run 'cf profile use NAME' to switch to the profile first."
+}
+
+func (e PluginProfileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Command": e.Command,
+	})
+}
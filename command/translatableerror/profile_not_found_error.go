@@ -0,0 +1,15 @@
+package translatableerror
+
+type ProfileNotFoundError struct {
+	Name string
+}
+
+func (e ProfileNotFoundError) Error() string {
+	return "Profile '{{.Name}}' does not exist. Run 'cf profiles' to list the available profiles."
+}
+
+func (e ProfileNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
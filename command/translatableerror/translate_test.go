@@ -40,6 +40,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("APIRequestError", APIRequestError{}),
 		Entry("ApplicationNotFoundError", ApplicationNotFoundError{}),
 		Entry("AppNotFoundInManifestError", AppNotFoundInManifestError{}),
+		Entry("AppWaitTimeoutError", AppWaitTimeoutError{}),
 		Entry("ArgumentCombinationError", ArgumentCombinationError{}),
 		Entry("AssignDropletError", AssignDropletError{}),
 		Entry("BadCredentialsError", UnauthorizedError{}),
@@ -26,6 +26,7 @@ type UI interface {
 	DisplayKeyValueTable(prefix string, table [][]string, padding int)
 	DisplayKeyValueTableForApp(table [][]string)
 	DisplayLogMessage(message ui.LogMessage, displayHeader bool)
+	DisplayLogMessageWithPrefix(prefix string, message ui.LogMessage, displayHeader bool)
 	DisplayNewline()
 	DisplayNonWrappingTable(prefix string, table [][]string, padding int)
 	DisplayOK()
@@ -38,8 +39,11 @@ type UI interface {
 	DisplayTextPrompt(template string, templateValues ...map[string]interface{}) (string, error)
 	DisplayTextWithBold(text string, keys ...map[string]interface{})
 	DisplayTextWithFlavor(text string, keys ...map[string]interface{})
+	DisplayTextWithPrefix(prefix string, template string, templateValues ...map[string]interface{})
 	DisplayWarning(formattedString string, keys ...map[string]interface{})
 	DisplayWarnings(warnings []string)
+	DisplayWarningsWithPrefix(prefix string, warnings []string)
+	DisplayYAML(name string, yamlData interface{}) error
 	GetErr() io.Writer
 	GetIn() io.Reader
 	GetOut() io.Writer
@@ -3,6 +3,7 @@ package v7
 import (
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type AppCommand struct {
@@ -14,6 +15,8 @@ type AppCommand struct {
 	relatedCommands interface{}  `related_commands:"apps, events, logs, map-route, unmap-route, push"`
 }
 
+func (AppCommand) SupportsStructuredOutput() {}
+
 func (cmd AppCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -29,21 +32,28 @@ func (cmd AppCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"AppName":   cmd.RequiredArgs.AppName,
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatDefault {
+		cmd.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
-	appSummaryDisplayer := shared.NewAppSummaryDisplayer(cmd.UI)
 	summary, warnings, err := cmd.Actor.GetDetailedAppSummary(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, false)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
+	if outputFormat != configv3.OutputFormatDefault {
+		return shared.DisplayOutputResource(cmd.UI, outputFormat, "app", shared.NewAppDetailOutput(summary))
+	}
+
+	appSummaryDisplayer := shared.NewAppSummaryDisplayer(cmd.UI)
 	appSummaryDisplayer.AppDisplay(summary, false)
 	return nil
 }
@@ -236,6 +236,29 @@ var _ = Describe("app Command", func() {
 				Expect(spaceGUID).To(Equal("some-space-guid"))
 				Expect(withObfuscatedValues).To(BeFalse())
 			})
+
+			When("an output format is requested", func() {
+				BeforeEach(func() {
+					fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+				})
+
+				It("displays the application summary as a versioned json document", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Showing health and status"))
+					Expect(testUI.Out).To(Say(`"version": 1,`))
+					Expect(testUI.Out).To(Say(`"kind": "app",`))
+					Expect(testUI.Out).To(Say(`"resource": {`))
+					Expect(testUI.Out).To(Say(`"name": "some-app",`))
+					Expect(testUI.Out).To(Say(`"requested_state": "started",`))
+					Expect(testUI.Out).To(Say(`"stack": "cflinuxfs4",`))
+					Expect(testUI.Out).To(Say(`"name": "ruby_buildpack",`))
+					Expect(testUI.Out).To(Say(`"detect_output": "some-detect-output",`))
+
+					Expect(testUI.Err).To(Say("warning-1"))
+					Expect(testUI.Err).To(Say("warning-2"))
+				})
+			})
 		})
 	})
 })
@@ -0,0 +1,143 @@
+package v7
+
+import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+const (
+	defaultAppCrashesWindow = 24 * time.Hour
+	defaultCrashOutputLines = 10
+)
+
+type AppCrashesCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName         `positional-args:"yes"`
+	Since           flag.LogTimestamp    `long:"since" description:"Show crashes newer than a duration such as 6h, or an RFC3339 timestamp (Default: 24h)"`
+	Lines           flag.PositiveInteger `long:"lines" short:"n" description:"Number of log lines to show from before each crash (Default: 10)"`
+	usage           interface{}          `usage:"CF_NAME app-crashes APP_NAME [--since TIME] [--lines N]\n\nEXAMPLES:\n   CF_NAME app-crashes my-app\n   CF_NAME app-crashes my-app --since 1h --lines 30"`
+	relatedCommands interface{}          `related_commands:"app, events, logs"`
+
+	LogCacheClient sharedaction.LogCacheClient
+}
+
+func (cmd *AppCrashesCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	return err
+}
+
+func (cmd AppCrashesCommand) Execute(args []string) error {
+	since := cmd.Since.Time
+	if !cmd.Since.IsSet() {
+		since = time.Now().Add(-defaultAppCrashesWindow)
+	}
+	if !since.Before(time.Now()) {
+		return translatableerror.IncorrectUsageError{Message: "--since must be in the past"}
+	}
+
+	lines := int(cmd.Lines.Value)
+	if lines == 0 {
+		lines = defaultCrashOutputLines
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting crashes for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	crashes, warnings, err := cmd.Actor.GetAppCrashesByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.LogCacheClient, since, lines)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	window := map[string]interface{}{
+		"Crashes": len(crashes.Crashes),
+		"Since":   since.UTC().Format(time.RFC3339),
+	}
+	if len(crashes.Crashes) == 0 {
+		cmd.UI.DisplayText("No crashes since {{.Since}}.", window)
+		return nil
+	}
+	cmd.UI.DisplayText("{{.Crashes}} crashes since {{.Since}}.", window)
+	cmd.UI.DisplayNewline()
+
+	reasons := [][]string{
+		{
+			cmd.UI.TranslateText("crashes"),
+			cmd.UI.TranslateText("first"),
+			cmd.UI.TranslateText("last"),
+			cmd.UI.TranslateText("reason"),
+		},
+	}
+	for _, reason := range crashes.Reasons {
+		reasons = append(reasons, []string{
+			strconv.Itoa(reason.Crashes),
+			reason.First.Format(time.RFC3339),
+			reason.Last.Format(time.RFC3339),
+			reason.ExitDescription,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", reasons, ui.DefaultTableSpacePadding)
+
+	recent := crashes.Crashes
+	if len(recent) > v7action.AppCrashesWithOutput {
+		recent = recent[:v7action.AppCrashesWithOutput]
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Output of the {{.Count}} most recent crashes:", map[string]interface{}{
+		"Count": len(recent),
+	})
+	for _, crash := range recent {
+		cmd.displayCrash(crash)
+	}
+
+	return nil
+}
+
+func (cmd AppCrashesCommand) displayCrash(crash v7action.AppCrash) {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("{{.Time}}  {{.ProcessType}} instance #{{.Index}}  exit status {{.ExitStatus}}  {{.Description}}", map[string]interface{}{
+		"Time":        crash.Time.Format(time.RFC3339),
+		"ProcessType": crash.ProcessType,
+		"Index":       crash.Index,
+		"ExitStatus":  crash.ExitStatus,
+		"Description": crash.ExitDescription,
+	})
+
+	if len(crash.Output) == 0 {
+		cmd.UI.DisplayText("   No output found in Log Cache")
+		return
+	}
+	for _, message := range crash.Output {
+		cmd.UI.DisplayLogMessage(message, true)
+	}
+}
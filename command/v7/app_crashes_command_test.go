@@ -0,0 +1,185 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("app-crashes Command", func() {
+	var (
+		cmd                v7.AppCrashesCommand
+		testUI             *ui.UI
+		fakeConfig         *commandfakes.FakeConfig
+		fakeSharedActor    *commandfakes.FakeSharedActor
+		fakeActor          *v7fakes.FakeActor
+		fakeLogCacheClient *sharedactionfakes.FakeLogCacheClient
+		binaryName         string
+		executeErr         error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v7.AppCrashesCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			LogCacheClient: fakeLogCacheClient,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("--since is in the future", func() {
+		BeforeEach(func() {
+			cmd.Since = flag.LogTimestamp{Time: time.Now().Add(time.Hour)}
+		})
+
+		It("returns a usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--since must be in the past"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+		})
+	})
+
+	When("the user is logged in", func() {
+		var crashTime time.Time
+
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+			crashTime = time.Date(2021, 3, 4, 5, 30, 0, 0, time.UTC)
+			fakeActor.GetAppCrashesByNameAndSpaceReturns(
+				v7action.AppCrashes{
+					Crashes: []v7action.AppCrash{
+						{
+							Time:            crashTime,
+							ProcessType:     "web",
+							Index:           1,
+							ExitStatus:      137,
+							ExitDescription: "APP/PROC/WEB: Exited with status 137 (out of memory)",
+							Output: []sharedaction.LogMessage{
+								*sharedaction.NewLogMessage("fatal error: out of memory", "ERR", crashTime.Add(-time.Second), "APP/PROC/WEB", "1"),
+							},
+						},
+						{
+							Time:            crashTime.Add(-time.Hour),
+							ProcessType:     "worker",
+							Index:           0,
+							ExitStatus:      1,
+							ExitDescription: "APP/PROC/WORKER: Exited with status 1",
+						},
+					},
+					Reasons: []v7action.CrashReason{
+						{ExitDescription: "APP/PROC/WEB: Exited with status 137 (out of memory)", Crashes: 1, First: crashTime, Last: crashTime},
+						{ExitDescription: "APP/PROC/WORKER: Exited with status 1", Crashes: 1, First: crashTime.Add(-time.Hour), Last: crashTime.Add(-time.Hour)},
+					},
+				},
+				v7action.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("gets the crashes of the last day with 10 lines of output", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetAppCrashesByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID, client, since, lines := fakeActor.GetAppCrashesByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(client).To(Equal(fakeLogCacheClient))
+			Expect(since).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Second))
+			Expect(lines).To(Equal(10))
+		})
+
+		It("displays the crash reasons and the output before each crash", func() {
+			Expect(testUI.Out).To(Say(`Getting crashes for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`2 crashes since`))
+			Expect(testUI.Out).To(Say(`crashes\s+first\s+last\s+reason`))
+			Expect(testUI.Out).To(Say(`1\s+2021-03-04T05:30:00Z\s+2021-03-04T05:30:00Z\s+APP/PROC/WEB: Exited with status 137 \(out of memory\)`))
+			Expect(testUI.Out).To(Say(`1\s+2021-03-04T04:30:00Z\s+2021-03-04T04:30:00Z\s+APP/PROC/WORKER: Exited with status 1`))
+			Expect(testUI.Out).To(Say(`Output of the 2 most recent crashes:`))
+			Expect(testUI.Out).To(Say(`2021-03-04T05:30:00Z  web instance #1  exit status 137  APP/PROC/WEB: Exited with status 137 \(out of memory\)`))
+			Expect(testUI.Out).To(Say(`\[APP/PROC/WEB/1\]\s+ERR fatal error: out of memory`))
+			Expect(testUI.Out).To(Say(`2021-03-04T04:30:00Z  worker instance #0  exit status 1  APP/PROC/WORKER: Exited with status 1`))
+			Expect(testUI.Out).To(Say(`No output found in Log Cache`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		When("the flags are provided", func() {
+			BeforeEach(func() {
+				cmd.Since = flag.LogTimestamp{Time: time.Now().Add(-time.Hour)}
+				cmd.Lines = flag.PositiveInteger{Value: 30}
+			})
+
+			It("passes them to the actor", func() {
+				_, _, _, since, lines := fakeActor.GetAppCrashesByNameAndSpaceArgsForCall(0)
+				Expect(since).To(Equal(cmd.Since.Time))
+				Expect(lines).To(Equal(30))
+			})
+		})
+
+		When("the app has not crashed", func() {
+			BeforeEach(func() {
+				fakeActor.GetAppCrashesByNameAndSpaceReturns(v7action.AppCrashes{}, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`No crashes since`))
+				Expect(testUI.Out).ToNot(Say(`reason`))
+			})
+		})
+
+		When("getting the crashes fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetAppCrashesByNameAndSpaceReturns(v7action.AppCrashes{}, v7action.Warnings{"some-warning"}, errors.New("crashes-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("crashes-error"))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+	})
+})
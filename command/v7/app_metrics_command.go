@@ -0,0 +1,173 @@
+package v7
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+const (
+	defaultAppMetricsWindow = time.Hour
+	defaultAppMetricsStep   = time.Minute
+)
+
+type AppMetricsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName          `positional-args:"yes"`
+	ProcessType     string                `long:"process" default:"web" description:"App process to show metrics for"`
+	Since           flag.LogTimestamp     `long:"since" description:"Show metrics newer than a duration such as 30m, or an RFC3339 timestamp (Default: 1h)"`
+	Step            flag.PositiveDuration `long:"step" description:"Width of each sparkline step, such as 30s or 5m. Requires --sparklines (Default: 1m)"`
+	Sparklines      bool                  `long:"sparklines" description:"Show how each metric changed over time as a sparkline"`
+	usage           interface{}           `usage:"CF_NAME app-metrics APP_NAME [--process PROCESS] [--since TIME] [--step DURATION] [--sparklines]\n\nEXAMPLES:\n   CF_NAME app-metrics my-app\n   CF_NAME app-metrics my-app --process worker --since 6h --step 15m --sparklines"`
+	relatedCommands interface{}           `related_commands:"app, logs, scale"`
+
+	LogCacheClient sharedaction.LogCacheClient
+}
+
+func (cmd *AppMetricsCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	return err
+}
+
+func (cmd AppMetricsCommand) Execute(args []string) error {
+	if cmd.Step.IsSet() && !cmd.Sparklines {
+		return translatableerror.RequiredFlagsError{Arg1: "--step", Arg2: "--sparklines"}
+	}
+
+	query := v7action.AppMetricsQuery{
+		ProcessType: cmd.ProcessType,
+		Since:       cmd.Since.Time,
+		Until:       time.Now(),
+	}
+	if !cmd.Since.IsSet() {
+		query.Since = query.Until.Add(-defaultAppMetricsWindow)
+	}
+	if !query.Since.Before(query.Until) {
+		return translatableerror.IncorrectUsageError{Message: "--since must be in the past"}
+	}
+
+	// Steps are only needed to draw the sparklines.
+	if cmd.Sparklines {
+		query.Step = defaultAppMetricsStep
+		if cmd.Step.IsSet() {
+			query.Step = cmd.Step.Duration
+		}
+		if float64(query.Until.Sub(query.Since))/float64(query.Step) > v7action.MaxAppMetricsSteps {
+			return translatableerror.IncorrectUsageError{
+				Message: fmt.Sprintf("--step is too small for the time window, which can be split into at most %d steps", v7action.MaxAppMetricsSteps),
+			}
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting metrics for process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ProcessType": cmd.ProcessType,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	instances, warnings, err := cmd.Actor.GetApplicationMetricsByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.LogCacheClient, query)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(instances) == 0 {
+		cmd.UI.DisplayText("No metrics found between {{.Since}} and {{.Until}}.", map[string]interface{}{
+			"Since": query.Since.Format(time.RFC3339),
+			"Until": query.Until.Format(time.RFC3339),
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayText("Showing metrics between {{.Since}} and {{.Until}}.", map[string]interface{}{
+		"Since": query.Since.Format(time.RFC3339),
+		"Until": query.Until.Format(time.RFC3339),
+	})
+	cmd.UI.DisplayNewline()
+
+	header := []string{
+		"",
+		cmd.UI.TranslateText("metric"),
+		cmd.UI.TranslateText("min"),
+		cmd.UI.TranslateText("avg"),
+		cmd.UI.TranslateText("max"),
+	}
+	if cmd.Sparklines {
+		header = append(header, cmd.UI.TranslateText("trend"))
+	}
+	table := [][]string{header}
+
+	for _, instance := range instances {
+		rows := []struct {
+			name    string
+			summary v7action.MetricSummary
+			format  func(float64) string
+		}{
+			{"cpu", instance.CPU, formatCPUMetric},
+			{"memory", instance.Memory, formatBytesMetric},
+			{"disk", instance.Disk, formatBytesMetric},
+			{"log rate", instance.LogRate, formatLogRateMetric},
+		}
+
+		for i, row := range rows {
+			index := ""
+			if i == 0 {
+				index = fmt.Sprintf("#%d", instance.Index)
+			}
+
+			min, avg, max := "-", "-", "-"
+			if row.summary.Samples > 0 {
+				min, avg, max = row.format(row.summary.Min), row.format(row.summary.Avg), row.format(row.summary.Max)
+			}
+
+			tableRow := []string{index, cmd.UI.TranslateText(row.name), min, avg, max}
+			if cmd.Sparklines {
+				tableRow = append(tableRow, shared.Sparkline(row.summary.Steps))
+			}
+			table = append(table, tableRow)
+		}
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
+
+func formatCPUMetric(value float64) string {
+	return fmt.Sprintf("%.1f%%", value)
+}
+
+func formatBytesMetric(value float64) string {
+	return bytefmt.ByteSize(uint64(value))
+}
+
+func formatLogRateMetric(value float64) string {
+	return bytefmt.ByteSize(uint64(value)) + "/s"
+}
@@ -0,0 +1,220 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("app-metrics Command", func() {
+	var (
+		cmd                v7.AppMetricsCommand
+		testUI             *ui.UI
+		fakeConfig         *commandfakes.FakeConfig
+		fakeSharedActor    *commandfakes.FakeSharedActor
+		fakeActor          *v7fakes.FakeActor
+		fakeLogCacheClient *sharedactionfakes.FakeLogCacheClient
+		binaryName         string
+		executeErr         error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v7.AppMetricsCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			ProcessType:  "web",
+
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			LogCacheClient: fakeLogCacheClient,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("--since is in the future", func() {
+		BeforeEach(func() {
+			cmd.Since = flag.LogTimestamp{Time: time.Now().Add(time.Hour)}
+		})
+
+		It("returns a usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--since must be in the past"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--step is provided without --sparklines", func() {
+		BeforeEach(func() {
+			cmd.Step = flag.PositiveDuration{Duration: 15 * time.Minute}
+		})
+
+		It("returns a usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--step", Arg2: "--sparklines"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--step splits the window into too many steps", func() {
+		BeforeEach(func() {
+			cmd.Sparklines = true
+			cmd.Since = flag.LogTimestamp{Time: time.Now().Add(-24 * time.Hour)}
+			cmd.Step = flag.PositiveDuration{Duration: time.Minute}
+		})
+
+		It("returns a usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--step is too small for the time window, which can be split into at most 1000 steps"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		})
+
+		When("getting the metrics succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationMetricsByNameAndSpaceReturns(
+					[]v7action.InstanceMetrics{
+						{
+							Index: 0,
+							CPU: v7action.MetricSummary{
+								Samples: 3, Min: 0.5, Avg: 2.25, Max: 8,
+								Steps: []types.NullFloat64{{IsSet: true, Value: 0.5}, {}, {IsSet: true, Value: 8}},
+							},
+							Memory:  v7action.MetricSummary{Samples: 3, Min: 100 * 1024 * 1024, Avg: 128 * 1024 * 1024, Max: 256 * 1024 * 1024},
+							Disk:    v7action.MetricSummary{Samples: 3, Min: 1024 * 1024 * 1024, Avg: 1024 * 1024 * 1024, Max: 1024 * 1024 * 1024},
+							LogRate: v7action.MetricSummary{},
+						},
+					},
+					v7action.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("queries the last hour of the web process without steps", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetApplicationMetricsByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID, client, query := fakeActor.GetApplicationMetricsByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(client).To(Equal(fakeLogCacheClient))
+				Expect(query.ProcessType).To(Equal("web"))
+				Expect(query.Until).To(BeTemporally("~", time.Now(), time.Second))
+				Expect(query.Since).To(Equal(query.Until.Add(-time.Hour)))
+				Expect(query.Step).To(BeZero())
+			})
+
+			It("displays a table of the metrics of each instance", func() {
+				Expect(testUI.Out).To(Say(`Getting metrics for process web of app some-app in org some-org / space some-space as steve\.\.\.`))
+				Expect(testUI.Out).To(Say(`Showing metrics between .+ and .+\.`))
+				Expect(testUI.Out).To(Say(`\s+metric\s+min\s+avg\s+max\n`))
+				Expect(testUI.Out).To(Say(`#0\s+cpu\s+0\.5%\s+2\.2%\s+8\.0%`))
+				Expect(testUI.Out).To(Say(`\s+memory\s+100M\s+128M\s+256M`))
+				Expect(testUI.Out).To(Say(`\s+disk\s+1G\s+1G\s+1G`))
+				Expect(testUI.Out).To(Say(`\s+log rate\s+-\s+-\s+-`))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+
+			When("the window, step and --sparklines are provided", func() {
+				BeforeEach(func() {
+					cmd.ProcessType = "worker"
+					cmd.Since = flag.LogTimestamp{Time: time.Now().Add(-6 * time.Hour)}
+					cmd.Step = flag.PositiveDuration{Duration: 15 * time.Minute}
+					cmd.Sparklines = true
+				})
+
+				It("queries that window", func() {
+					_, _, _, query := fakeActor.GetApplicationMetricsByNameAndSpaceArgsForCall(0)
+					Expect(query.ProcessType).To(Equal("worker"))
+					Expect(query.Since).To(Equal(cmd.Since.Time))
+					Expect(query.Step).To(Equal(15 * time.Minute))
+				})
+			})
+
+			When("--sparklines is provided", func() {
+				BeforeEach(func() {
+					cmd.Sparklines = true
+				})
+
+				It("queries one minute steps", func() {
+					_, _, _, query := fakeActor.GetApplicationMetricsByNameAndSpaceArgsForCall(0)
+					Expect(query.Step).To(Equal(time.Minute))
+				})
+
+				It("adds a trend column", func() {
+					Expect(testUI.Out).To(Say(`\s+metric\s+min\s+avg\s+max\s+trend\n`))
+					Expect(testUI.Out).To(Say(`#0\s+cpu\s+0\.5%\s+2\.2%\s+8\.0%\s+▁ █`))
+				})
+			})
+		})
+
+		When("there are no metrics in the window", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationMetricsByNameAndSpaceReturns(nil, v7action.Warnings{"some-warning"}, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`No metrics found between .+ and .+\.`))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+
+		When("getting the metrics fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationMetricsByNameAndSpaceReturns(nil, v7action.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("displays warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,145 @@
+package v7
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+const defaultAppRequestsWindow = 15 * time.Minute
+
+type AppRequestsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName      `positional-args:"yes"`
+	Since           flag.LogTimestamp `long:"since" description:"Summarise requests newer than a duration such as 1h, or an RFC3339 timestamp (Default: 15m)"`
+	usage           interface{}       `usage:"CF_NAME app-requests APP_NAME [--since TIME]\n\nEXAMPLES:\n   CF_NAME app-requests my-app\n   CF_NAME app-requests my-app --since 1h"`
+	relatedCommands interface{}       `related_commands:"app, app-metrics, logs"`
+
+	LogCacheClient sharedaction.LogCacheClient
+}
+
+func (cmd *AppRequestsCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	return err
+}
+
+func (cmd AppRequestsCommand) Execute(args []string) error {
+	since := cmd.Since.Time
+	if !cmd.Since.IsSet() {
+		since = time.Now().Add(-defaultAppRequestsWindow)
+	}
+	if !since.Before(time.Now()) {
+		return translatableerror.IncorrectUsageError{Message: "--since must be in the past"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting requests for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	requests, warnings, err := cmd.Actor.GetAppRequestsByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.LogCacheClient, since)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	window := map[string]interface{}{
+		"Requests": requests.Total.Requests,
+		"Since":    requests.Since.Format(time.RFC3339),
+		"Until":    requests.Until.Format(time.RFC3339),
+	}
+	switch {
+	case requests.Total.Requests == 0:
+		cmd.UI.DisplayText("No requests found between {{.Since}} and {{.Until}}.", window)
+		return nil
+	case requests.Truncated:
+		cmd.UI.DisplayText("Showing the most recent {{.Requests}} requests, made between {{.Since}} and {{.Until}}.", window)
+	default:
+		cmd.UI.DisplayText("Showing {{.Requests}} requests made between {{.Since}} and {{.Until}}.", window)
+	}
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("instance"),
+			cmd.UI.TranslateText("requests"),
+			cmd.UI.TranslateText("rate"),
+			"2xx",
+			"3xx",
+			"4xx",
+			"5xx",
+			"p50",
+			"p95",
+			"p99",
+			cmd.UI.TranslateText("top path"),
+		},
+		cmd.requestSummaryRow(cmd.UI.TranslateText("all"), requests.Total),
+	}
+	for _, instance := range requests.Instances {
+		table = append(table, cmd.requestSummaryRow(fmt.Sprintf("#%d", instance.Index), instance.RequestSummary))
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	cmd.UI.DisplayNewline()
+	paths := [][]string{
+		{cmd.UI.TranslateText("path"), cmd.UI.TranslateText("requests")},
+	}
+	for _, path := range requests.Total.TopPaths {
+		paths = append(paths, []string{path.Path, strconv.Itoa(path.Requests)})
+	}
+	cmd.UI.DisplayTableWithHeader("", paths, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func (cmd AppRequestsCommand) requestSummaryRow(name string, summary v7action.RequestSummary) []string {
+	topPath := ""
+	if len(summary.TopPaths) > 0 {
+		topPath = fmt.Sprintf("%s (%d%%)", summary.TopPaths[0].Path, summary.TopPaths[0].Requests*100/summary.Requests)
+	}
+
+	return []string{
+		name,
+		strconv.Itoa(summary.Requests),
+		fmt.Sprintf("%.2f/s", summary.Rate),
+		strconv.Itoa(summary.StatusClassCount(2)),
+		strconv.Itoa(summary.StatusClassCount(3)),
+		strconv.Itoa(summary.StatusClassCount(4)),
+		strconv.Itoa(summary.StatusClassCount(5)),
+		formatLatency(summary.P50),
+		formatLatency(summary.P95),
+		formatLatency(summary.P99),
+		topPath,
+	}
+}
+
+func formatLatency(latency time.Duration) string {
+	return fmt.Sprintf("%.1fms", float64(latency)/float64(time.Millisecond))
+}
@@ -0,0 +1,185 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("app-requests Command", func() {
+	var (
+		cmd                v7.AppRequestsCommand
+		testUI             *ui.UI
+		fakeConfig         *commandfakes.FakeConfig
+		fakeSharedActor    *commandfakes.FakeSharedActor
+		fakeActor          *v7fakes.FakeActor
+		fakeLogCacheClient *sharedactionfakes.FakeLogCacheClient
+		binaryName         string
+		executeErr         error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v7.AppRequestsCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			LogCacheClient: fakeLogCacheClient,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("--since is in the future", func() {
+		BeforeEach(func() {
+			cmd.Since = flag.LogTimestamp{Time: time.Now().Add(time.Hour)}
+		})
+
+		It("returns a usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--since must be in the past"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+		})
+	})
+
+	When("the user is logged in", func() {
+		var requests v7action.AppRequests
+
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+			requests = v7action.AppRequests{
+				Since: time.Date(2021, 3, 4, 5, 0, 0, 0, time.UTC),
+				Until: time.Date(2021, 3, 4, 5, 15, 0, 0, time.UTC),
+				Total: v7action.RequestSummary{
+					Requests:    4,
+					Rate:        0.0044,
+					StatusCodes: map[int]int{200: 2, 404: 1, 503: 1},
+					P50:         12 * time.Millisecond,
+					P95:         250 * time.Millisecond,
+					P99:         1500 * time.Millisecond,
+					TopPaths:    []v7action.PathRequests{{Path: "/api", Requests: 3}, {Path: "/", Requests: 1}},
+				},
+				Instances: []v7action.InstanceRequests{
+					{
+						Index: 0,
+						RequestSummary: v7action.RequestSummary{
+							Requests:    4,
+							Rate:        0.0044,
+							StatusCodes: map[int]int{200: 2, 404: 1, 503: 1},
+							TopPaths:    []v7action.PathRequests{{Path: "/api", Requests: 3}},
+						},
+					},
+				},
+			}
+			fakeActor.GetAppRequestsByNameAndSpaceReturns(requests, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("summarises the last 15 minutes by default", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetAppRequestsByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID, client, since := fakeActor.GetAppRequestsByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(client).To(Equal(fakeLogCacheClient))
+			Expect(since).To(BeTemporally("~", time.Now().Add(-15*time.Minute), time.Second))
+		})
+
+		It("displays the request summary", func() {
+			Expect(testUI.Out).To(Say(`Getting requests for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`Showing 4 requests made between 2021-03-04T05:00:00Z and 2021-03-04T05:15:00Z\.`))
+			Expect(testUI.Out).To(Say(`instance\s+requests\s+rate\s+2xx\s+3xx\s+4xx\s+5xx\s+p50\s+p95\s+p99\s+top path`))
+			Expect(testUI.Out).To(Say(`all\s+4\s+0\.00/s\s+2\s+0\s+1\s+1\s+12\.0ms\s+250\.0ms\s+1500\.0ms\s+/api \(75%\)`))
+			Expect(testUI.Out).To(Say(`#0\s+4\s+`))
+			Expect(testUI.Out).To(Say(`path\s+requests`))
+			Expect(testUI.Out).To(Say(`/api\s+3`))
+			Expect(testUI.Out).To(Say(`/\s+1`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		When("--since is provided", func() {
+			BeforeEach(func() {
+				cmd.Since = flag.LogTimestamp{Time: time.Now().Add(-time.Hour)}
+			})
+
+			It("summarises requests since then", func() {
+				_, _, _, since := fakeActor.GetAppRequestsByNameAndSpaceArgsForCall(0)
+				Expect(since).To(Equal(cmd.Since.Time))
+			})
+		})
+
+		When("there were too many requests to summarise", func() {
+			BeforeEach(func() {
+				requests.Truncated = true
+				fakeActor.GetAppRequestsByNameAndSpaceReturns(requests, nil, nil)
+			})
+
+			It("says only the most recent were summarised", func() {
+				Expect(testUI.Out).To(Say(`Showing the most recent 4 requests, made between`))
+			})
+		})
+
+		When("there were no requests", func() {
+			BeforeEach(func() {
+				fakeActor.GetAppRequestsByNameAndSpaceReturns(v7action.AppRequests{Since: requests.Since, Until: requests.Until}, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`No requests found between 2021-03-04T05:00:00Z and 2021-03-04T05:15:00Z\.`))
+				Expect(testUI.Out).ToNot(Say("instance"))
+			})
+		})
+
+		When("getting the requests fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetAppRequestsByNameAndSpaceReturns(v7action.AppRequests{}, v7action.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("displays warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,298 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/spacebundle"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+type ApplySpaceCommand struct {
+	BaseCommand
+
+	Path             flag.PathWithExistenceCheck   `long:"path" description:"Directory containing the manifest.yml and space.yml files written by export-space. If not specified, the current working directory is used."`
+	Force            bool                          `short:"f" long:"force" description:"Apply the changes without asking for confirmation"`
+	Vars             []template.VarKV              `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	usage            interface{}                   `usage:"CF_NAME apply-space [--path DIR] [--vars-file VARS_FILE_PATH]... [--var KEY=VALUE]... [-f]\n\n   Creates the service instances, routes, route mappings and network policies of an exported space that are missing from the targeted space and applies its manifest, then pushes the apps that have never been pushed from the path or docker image of their manifest entry. Nothing is ever deleted. Environment variable groups apply to the whole platform and are only compared."`
+	relatedCommands  interface{}                   `related_commands:"apply-manifest, export-space, push"`
+
+	ManifestParser  ManifestParser
+	DiffDisplayer   DiffDisplayer
+	NetworkingActor v7action.SpaceNetworkingActor
+	PushActor       v7action.SpacePushActor
+	CWD             string
+}
+
+func (cmd *ApplySpaceCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+	cmd.PushActor = v7pushaction.NewActor(cmd.Actor, sharedaction.NewActor(config))
+	cmd.ManifestParser = manifestparser.ManifestParser{}
+	cmd.DiffDisplayer = &shared.ManifestDiffDisplayer{UI: ui}
+
+	currentDir, err := os.Getwd()
+	cmd.CWD = currentDir
+
+	return err
+}
+
+func (cmd ApplySpaceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	dir := cmd.CWD
+	if cmd.Path != "" {
+		dir = string(cmd.Path)
+	}
+
+	space, err := spacebundle.ReadSpace(dir)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Planning changes to space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"Username":  user.Name,
+	})
+
+	manifestPath := filepath.Join(dir, spacebundle.ManifestFileName)
+	plan, err := cmd.planSpace(manifestPath, space)
+	if err != nil {
+		return err
+	}
+
+	cmd.displayEnvironmentVariableGroupWarnings(plan)
+	cmd.displayAppsWithoutSourceWarnings(plan)
+
+	if plan.IsEmpty() {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Space {{.SpaceName}} is already up to date.", map[string]interface{}{
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	err = cmd.displayPlan(plan)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		apply, promptErr := cmd.UI.DisplayBoolPrompt(false, "Apply these changes to space {{.SpaceName}}?", map[string]interface{}{
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !apply {
+			cmd.UI.DisplayText("Apply cancelled")
+			return nil
+		}
+	}
+
+	warnings, err := cmd.Actor.ApplySpacePlan(plan, cmd.NetworkingActor, cmd.PushActor, cmd.displayStep)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd ApplySpaceCommand) planSpace(pathToManifest string, space spacebundle.Space) (v7action.SpacePlan, error) {
+	var pathsToVarsFiles []string
+	for _, varFilePath := range cmd.PathsToVarsFiles {
+		pathsToVarsFiles = append(pathsToVarsFiles, string(varFilePath))
+	}
+
+	interpolatedManifest, err := cmd.ManifestParser.InterpolateManifest(pathToManifest, pathsToVarsFiles, cmd.Vars)
+	if err != nil {
+		return v7action.SpacePlan{}, err
+	}
+
+	manifest, err := cmd.ManifestParser.ParseManifest(pathToManifest, interpolatedManifest)
+	if err != nil {
+		return v7action.SpacePlan{}, err
+	}
+
+	plan, warnings, err := cmd.Actor.PlanSpace(
+		cmd.Config.TargetedSpace().GUID,
+		cmd.Config.TargetedSpace().Name,
+		cmd.Config.TargetedOrganization().GUID,
+		cmd.Config.TargetedOrganization().Name,
+		manifest,
+		space,
+		cmd.NetworkingActor,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	return plan, err
+}
+
+func (cmd ApplySpaceCommand) displayPlan(plan v7action.SpacePlan) error {
+	if len(plan.ServiceInstances) > 0 || len(plan.UserProvidedServices) > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Service instances to create:")
+		for _, instance := range plan.ServiceInstances {
+			cmd.UI.DisplayText("  + {{.Name}} ({{.Offering}} {{.Plan}})", map[string]interface{}{
+				"Name":     instance.Name,
+				"Offering": instance.Offering,
+				"Plan":     instance.Plan,
+			})
+		}
+		for _, instance := range plan.UserProvidedServices {
+			cmd.UI.DisplayText("  + {{.Name}} (user-provided)", map[string]interface{}{
+				"Name": instance.Name,
+			})
+		}
+	}
+
+	if len(plan.Routes) > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Routes to create:")
+		for _, route := range plan.Routes {
+			cmd.UI.DisplayText("  + {{.URL}}", map[string]interface{}{
+				"URL": route.URL(),
+			})
+		}
+	}
+
+	if len(plan.RouteMappings) > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Routes to map:")
+		for _, mapping := range plan.RouteMappings {
+			cmd.UI.DisplayText("  + {{.URL}} -> {{.AppName}}", map[string]interface{}{
+				"URL":     mapping.Route.URL(),
+				"AppName": mapping.AppName,
+			})
+		}
+	}
+
+	if len(plan.NetworkPolicies) > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Network policies to add:")
+		for _, policy := range plan.NetworkPolicies {
+			cmd.UI.DisplayText("  + {{.Source}} -> {{.Destination}} {{.Protocol}}:{{.Ports}}", map[string]interface{}{
+				"Source":      policy.Source,
+				"Destination": policyDestination(policy),
+				"Protocol":    policy.Protocol,
+				"Ports":       policyPorts(policy),
+			})
+		}
+	}
+
+	if len(plan.ManifestDiff.Diffs) > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Manifest changes:")
+		err := cmd.DiffDisplayer.DisplayDiff(plan.Manifest, plan.ManifestDiff)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(plan.Apps) > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Apps to push:")
+		for _, appName := range plan.Apps {
+			cmd.UI.DisplayText("  + {{.AppName}}", map[string]interface{}{
+				"AppName": appName,
+			})
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd ApplySpaceCommand) displayAppsWithoutSourceWarnings(plan v7action.SpacePlan) {
+	for _, appName := range plan.AppsWithoutSource {
+		cmd.UI.DisplayWarning("App {{.AppName}} has never been pushed and its manifest entry has neither a path nor a docker image, so it is not pushed. Add one to manifest.yml or use '{{.Command}}'.", map[string]interface{}{
+			"AppName": appName,
+			"Command": fmt.Sprintf("%s push %s", cmd.Config.BinaryName(), appName),
+		})
+	}
+}
+
+func (cmd ApplySpaceCommand) displayEnvironmentVariableGroupWarnings(plan v7action.SpacePlan) {
+	for _, group := range plan.ChangedEnvironmentVariableGroups {
+		cmd.UI.DisplayWarning("The {{.Group}} environment variable group differs from space.yml. It applies to every app on the platform and is not changed by apply-space. Use '{{.Command}}' to change it.", map[string]interface{}{
+			"Group":   group,
+			"Command": fmt.Sprintf("%s set-%s-environment-variable-group", cmd.Config.BinaryName(), group),
+		})
+	}
+}
+
+func (cmd ApplySpaceCommand) displayStep(step v7action.SpaceApplyStep) {
+	switch step.Type {
+	case v7action.CreatingServiceInstanceStep:
+		cmd.UI.DisplayText("Creating service instance {{.Name}}...", map[string]interface{}{"Name": step.Name})
+	case v7action.CreatingUserProvidedServiceStep:
+		cmd.UI.DisplayText("Creating user provided service {{.Name}}...", map[string]interface{}{"Name": step.Name})
+	case v7action.CreatingRouteStep:
+		cmd.UI.DisplayText("Creating route {{.URL}}...", map[string]interface{}{"URL": step.Name})
+	case v7action.ApplyingManifestStep:
+		cmd.UI.DisplayText("Applying manifest...")
+	case v7action.MappingRouteStep:
+		cmd.UI.DisplayText("Mapping route {{.URL}} to app {{.AppName}}...", map[string]interface{}{
+			"URL":     step.Name,
+			"AppName": step.AppName,
+		})
+	case v7action.AddingNetworkPolicyStep:
+		cmd.UI.DisplayText("Adding network policy from app {{.Source}} to app {{.Destination}}...", map[string]interface{}{
+			"Source":      step.NetworkPolicy.Source,
+			"Destination": policyDestination(step.NetworkPolicy),
+		})
+	case v7action.PushingAppStep:
+		cmd.UI.DisplayText("Pushing app {{.AppName}}...", map[string]interface{}{"AppName": step.AppName})
+	}
+}
+
+func policyDestination(policy spacebundle.NetworkPolicy) string {
+	if policy.DestinationSpace == "" {
+		return policy.Destination
+	}
+	if policy.DestinationOrg == "" {
+		return fmt.Sprintf("%s (space %s)", policy.Destination, policy.DestinationSpace)
+	}
+	return fmt.Sprintf("%s (org %s / space %s)", policy.Destination, policy.DestinationOrg, policy.DestinationSpace)
+}
+
+func policyPorts(policy spacebundle.NetworkPolicy) string {
+	if policy.StartPort == policy.EndPort {
+		return fmt.Sprint(policy.StartPort)
+	}
+	return fmt.Sprintf("%d-%d", policy.StartPort, policy.EndPort)
+}
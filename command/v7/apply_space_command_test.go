@@ -0,0 +1,499 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/spacebundle"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-space Command", func() {
+	var (
+		cmd                 ApplySpaceCommand
+		input               *Buffer
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v7fakes.FakeActor
+		fakeParser          *v7fakes.FakeManifestParser
+		fakeDiffDisplayer   *v7fakes.FakeDiffDisplayer
+		fakeNetworkingActor *v7actionfakes.FakeSpaceNetworkingActor
+		fakePushActor       *v7actionfakes.FakeSpacePushActor
+		bundleDir           string
+		bundle              spacebundle.Space
+		manifest            manifestparser.Manifest
+		binaryName          string
+		plan                v7action.SpacePlan
+		executeErr          error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeParser = new(v7fakes.FakeManifestParser)
+		fakeDiffDisplayer = new(v7fakes.FakeDiffDisplayer)
+		fakeNetworkingActor = new(v7actionfakes.FakeSpaceNetworkingActor)
+		fakePushActor = new(v7actionfakes.FakeSpacePushActor)
+
+		var err error
+		bundleDir, err = os.MkdirTemp("", "apply-space-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		bundle = spacebundle.Space{
+			ServiceInstances: []spacebundle.ServiceInstance{
+				{Name: "db", Offering: "postgres", Plan: "small", Tags: []string{"sql"}},
+			},
+			Routes: []spacebundle.Route{
+				{Host: "api", Domain: "example.com", Path: "/v1", Apps: []string{"web"}},
+			},
+		}
+		_, _, err = spacebundle.Write(bundleDir, []byte("applications:\n- name: web\n"), bundle)
+		Expect(err).ToNot(HaveOccurred())
+
+		cmd = ApplySpaceCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			ManifestParser:  fakeParser,
+			DiffDisplayer:   fakeDiffDisplayer,
+			NetworkingActor: fakeNetworkingActor,
+			PushActor:       fakePushActor,
+			CWD:             bundleDir,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeParser.InterpolateManifestReturns([]byte("interpolated"), nil)
+		manifest = manifestparser.Manifest{Applications: []manifestparser.Application{{Name: "web", Path: "web-dir"}}}
+		fakeParser.ParseManifestReturns(manifest, nil)
+
+		plan = v7action.SpacePlan{
+			SpaceGUID: "some-space-guid",
+			ServiceInstances: []spacebundle.ServiceInstance{
+				{Name: "db", Offering: "postgres", Plan: "small"},
+			},
+			UserProvidedServices: []spacebundle.UserProvidedService{
+				{Name: "logs"},
+			},
+			Routes: []spacebundle.Route{
+				{Host: "api", Domain: "example.com", Path: "/v1"},
+			},
+			RouteMappings: []v7action.SpaceRouteMapping{
+				{Route: spacebundle.Route{Host: "api", Domain: "example.com", Path: "/v1"}, AppName: "web"},
+			},
+			NetworkPolicies: []spacebundle.NetworkPolicy{
+				{Source: "web", Destination: "billing", DestinationSpace: "other-space", Protocol: "tcp", StartPort: 9000, EndPort: 9010},
+			},
+			Apps:                []string{"web"},
+			ApplicationManifest: manifest,
+			Manifest:            []byte("marshalled"),
+			ManifestDiff:        resources.ManifestDiff{Diffs: []resources.Diff{{Op: resources.AddOperation, Path: "/applications/0"}}},
+		}
+		fakeActor.PlanSpaceStub = func(string, string, string, string, manifestparser.Manifest, spacebundle.Space, v7action.SpaceNetworkingActor) (v7action.SpacePlan, v7action.Warnings, error) {
+			return plan, v7action.Warnings{"plan-warning"}, nil
+		}
+
+		fakeActor.ApplySpacePlanStub = func(_ v7action.SpacePlan, _ v7action.SpaceNetworkingActor, _ v7action.SpacePushActor, handleStep func(v7action.SpaceApplyStep)) (v7action.Warnings, error) {
+			handleStep(v7action.SpaceApplyStep{Type: v7action.CreatingServiceInstanceStep, Name: "db"})
+			handleStep(v7action.SpaceApplyStep{Type: v7action.CreatingUserProvidedServiceStep, Name: "logs"})
+			handleStep(v7action.SpaceApplyStep{Type: v7action.CreatingRouteStep, Name: "api.example.com/v1"})
+			handleStep(v7action.SpaceApplyStep{Type: v7action.ApplyingManifestStep})
+			handleStep(v7action.SpaceApplyStep{Type: v7action.MappingRouteStep, Name: "api.example.com/v1", AppName: "web"})
+			handleStep(v7action.SpaceApplyStep{Type: v7action.AddingNetworkPolicyStep, NetworkPolicy: plan.NetworkPolicies[0]})
+			handleStep(v7action.SpaceApplyStep{Type: v7action.PushingAppStep, AppName: "web"})
+			return v7action.Warnings{"apply-warning"}, nil
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(bundleDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	It("plans the changes to the targeted space", func() {
+		Expect(testUI.Out).To(Say(`Planning changes to space some-space in org some-org as some-user\.\.\.`))
+		Expect(testUI.Err).To(Say("plan-warning"))
+
+		Expect(fakeParser.InterpolateManifestCallCount()).To(Equal(1))
+		path, _, _ := fakeParser.InterpolateManifestArgsForCall(0)
+		Expect(path).To(Equal(filepath.Join(bundleDir, "manifest.yml")))
+
+		Expect(fakeActor.PlanSpaceCallCount()).To(Equal(1))
+		spaceGUID, spaceName, orgGUID, orgName, parsedManifest, space, networking := fakeActor.PlanSpaceArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(spaceName).To(Equal("some-space"))
+		Expect(orgGUID).To(Equal("some-org-guid"))
+		Expect(orgName).To(Equal("some-org"))
+		Expect(parsedManifest).To(Equal(manifest))
+		Expect(space).To(Equal(bundle))
+		Expect(networking).To(Equal(fakeNetworkingActor))
+	})
+
+	It("displays the plan", func() {
+		Expect(testUI.Out).To(Say(`Service instances to create:`))
+		Expect(testUI.Out).To(Say(`\+ db \(postgres small\)`))
+		Expect(testUI.Out).To(Say(`\+ logs \(user-provided\)`))
+		Expect(testUI.Out).To(Say(`Routes to create:`))
+		Expect(testUI.Out).To(Say(`\+ api\.example\.com/v1`))
+		Expect(testUI.Out).To(Say(`Routes to map:`))
+		Expect(testUI.Out).To(Say(`\+ api\.example\.com/v1 -> web`))
+		Expect(testUI.Out).To(Say(`Network policies to add:`))
+		Expect(testUI.Out).To(Say(`\+ web -> billing \(space other-space\) tcp:9000-9010`))
+		Expect(testUI.Out).To(Say(`Manifest changes:`))
+		Expect(testUI.Out).To(Say(`Apps to push:`))
+		Expect(testUI.Out).To(Say(`\+ web`))
+
+		Expect(fakeDiffDisplayer.DisplayDiffCallCount()).To(Equal(1))
+		rawManifest, diff := fakeDiffDisplayer.DisplayDiffArgsForCall(0)
+		Expect(rawManifest).To(Equal([]byte("marshalled")))
+		Expect(diff).To(Equal(plan.ManifestDiff))
+	})
+
+	When("the environment variable groups differ from the bundle", func() {
+		BeforeEach(func() {
+			plan.ChangedEnvironmentVariableGroups = []constant.EnvironmentVariableGroupName{constant.StagingEnvironmentVariableGroup}
+		})
+
+		It("warns that they are not changed", func() {
+			Expect(testUI.Err).To(Say(`The staging environment variable group differs from space\.yml\. It applies to every app on the platform and is not changed by apply-space\. Use 'faceman set-staging-environment-variable-group' to change it\.`))
+		})
+	})
+
+	When("an app that was never pushed has no path or docker image", func() {
+		BeforeEach(func() {
+			plan.AppsWithoutSource = []string{"admin"}
+		})
+
+		It("warns that it is not pushed", func() {
+			Expect(testUI.Err).To(Say(`App admin has never been pushed and its manifest entry has neither a path nor a docker image, so it is not pushed\. Add one to manifest\.yml or use 'faceman push admin'\.`))
+		})
+	})
+
+	When("the user declines the prompt", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not change anything", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Apply these changes to space some-space\?`))
+			Expect(testUI.Out).To(Say("Apply cancelled"))
+			Expect(fakeActor.ApplySpacePlanCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the user confirms the prompt", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("applies the plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.ApplySpacePlanCallCount()).To(Equal(1))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("--force is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+			cmd.Path = flag.PathWithExistenceCheck(bundleDir)
+			cmd.CWD = "some-other-dir"
+		})
+
+		It("applies the plan without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).NotTo(Say(`Apply these changes`))
+
+			Expect(fakeActor.ApplySpacePlanCallCount()).To(Equal(1))
+			appliedPlan, networking, pusher, _ := fakeActor.ApplySpacePlanArgsForCall(0)
+			Expect(appliedPlan).To(Equal(plan))
+			Expect(networking).To(Equal(fakeNetworkingActor))
+			Expect(pusher).To(Equal(fakePushActor))
+		})
+
+		It("displays each change and the warnings", func() {
+			Expect(testUI.Out).To(Say(`Creating service instance db\.\.\.`))
+			Expect(testUI.Out).To(Say(`Creating user provided service logs\.\.\.`))
+			Expect(testUI.Out).To(Say(`Creating route api\.example\.com/v1\.\.\.`))
+			Expect(testUI.Out).To(Say(`Applying manifest\.\.\.`))
+			Expect(testUI.Out).To(Say(`Mapping route api\.example\.com/v1 to app web\.\.\.`))
+			Expect(testUI.Out).To(Say(`Adding network policy from app web to app billing \(space other-space\)\.\.\.`))
+			Expect(testUI.Out).To(Say(`Pushing app web\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+		})
+
+		When("applying the plan fails", func() {
+			BeforeEach(func() {
+				fakeActor.ApplySpacePlanStub = nil
+				fakeActor.ApplySpacePlanReturns(v7action.Warnings{"apply-warning"}, errors.New("apply-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("apply-error"))
+				Expect(testUI.Err).To(Say("apply-warning"))
+				Expect(testUI.Out).NotTo(Say("OK"))
+			})
+		})
+	})
+
+	When("the space already matches the bundle", func() {
+		BeforeEach(func() {
+			plan = v7action.SpacePlan{SpaceGUID: "some-space-guid"}
+		})
+
+		It("says the space is up to date without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Space some-space is already up to date."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).NotTo(Say("Apply these changes"))
+			Expect(fakeActor.ApplySpacePlanCallCount()).To(Equal(0))
+		})
+	})
+
+	When("planning fails", func() {
+		BeforeEach(func() {
+			fakeActor.PlanSpaceStub = nil
+			fakeActor.PlanSpaceReturns(v7action.SpacePlan{}, v7action.Warnings{"plan-warning"}, errors.New("plan-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("plan-error"))
+			Expect(testUI.Err).To(Say("plan-warning"))
+		})
+	})
+})
+
+var _ = Describe("export-space and apply-space round trip", func() {
+	var (
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		sourceCC            *v7actionfakes.FakeCloudControllerClient
+		targetCC            *v7actionfakes.FakeCloudControllerClient
+		fakeDiffDisplayer   *v7fakes.FakeDiffDisplayer
+		bundleDir           string
+		targetRoutes        []resources.Route
+		manifestApplied     bool
+		exportErr           error
+		applyErr            error
+		newActor            func(v7action.CloudControllerClient) Actor
+		fakePoliciesActor   *v7fakes.FakeNetworkPoliciesActor
+		fakeNetworkingActor *v7actionfakes.FakeSpaceNetworkingActor
+		fakePushActor       *v7actionfakes.FakeSpacePushActor
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeDiffDisplayer = new(v7fakes.FakeDiffDisplayer)
+		fakePoliciesActor = new(v7fakes.FakeNetworkPoliciesActor)
+		fakeNetworkingActor = new(v7actionfakes.FakeSpaceNetworkingActor)
+		fakePushActor = new(v7actionfakes.FakeSpacePushActor)
+
+		var err error
+		bundleDir, err = os.MkdirTemp("", "space-round-trip-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		newActor = func(client v7action.CloudControllerClient) Actor {
+			actor := v7action.NewActor(client, nil, nil, nil, nil, nil)
+			actor.AuthActor = currentUserActor{user: configv3.User{Name: "some-user"}}
+			return actor
+		}
+
+		sourceCC = new(v7actionfakes.FakeCloudControllerClient)
+		sourceCC.GetApplicationsReturns([]resources.Application{{Name: "web", GUID: "web-guid"}}, nil, nil)
+		sourceCC.GetApplicationManifestReturns([]byte("applications:\n- name: web\n  routes:\n  - route: web.example.com\n"), nil, nil)
+		sourceCC.GetServiceInstancesReturns([]resources.ServiceInstance{
+			{
+				Name:           "logs",
+				Type:           resources.UserProvidedServiceInstance,
+				SyslogDrainURL: types.NewOptionalString("syslog://logs.example.com"),
+			},
+		}, ccv3.IncludedResources{}, nil, nil)
+		sourceCC.GetRoutesReturns([]resources.Route{
+			{
+				GUID:         "web-route-guid",
+				Host:         "web",
+				DomainGUID:   "domain-guid",
+				URL:          "web.example.com",
+				Destinations: []resources.RouteDestination{{App: resources.RouteDestinationApp{GUID: "web-guid"}}},
+			},
+		}, nil, nil)
+		sourceCC.GetDomainsReturns([]resources.Domain{{GUID: "domain-guid", Name: "example.com"}}, nil, nil)
+
+		// The target space is empty. Applying the manifest creates the app and
+		// maps the routes listed in it, creating them if they are missing, like
+		// the Cloud Controller does.
+		targetRoutes = nil
+		manifestApplied = false
+		targetCC = new(v7actionfakes.FakeCloudControllerClient)
+		targetCC.GetSpaceManifestDiffReturns(resources.ManifestDiff{Diffs: []resources.Diff{{Op: resources.AddOperation, Path: "/applications/0"}}}, nil, nil)
+		targetCC.GetDomainsReturns([]resources.Domain{{GUID: "new-domain-guid", Name: "example.com"}}, nil, nil)
+		targetCC.GetApplicationsStub = func(...ccv3.Query) ([]resources.Application, ccv3.Warnings, error) {
+			if !manifestApplied {
+				return nil, nil, nil
+			}
+			return []resources.Application{{Name: "web", GUID: "new-web-guid"}}, nil, nil
+		}
+		targetCC.GetRoutesStub = func(...ccv3.Query) ([]resources.Route, ccv3.Warnings, error) {
+			return targetRoutes, nil, nil
+		}
+		targetCC.CreateRouteStub = func(route resources.Route) (resources.Route, ccv3.Warnings, error) {
+			if len(targetRoutes) > 0 {
+				return resources.Route{}, nil, ccerror.RouteNotUniqueError{}
+			}
+			route.GUID = "new-route-guid"
+			route.URL = "web.example.com"
+			targetRoutes = append(targetRoutes, route)
+			return route, nil, nil
+		}
+		targetCC.UpdateSpaceApplyManifestStub = func(string, []byte) (ccv3.JobURL, ccv3.Warnings, error) {
+			manifestApplied = true
+			if len(targetRoutes) == 0 {
+				targetRoutes = append(targetRoutes, resources.Route{GUID: "new-route-guid", Host: "web", DomainGUID: "new-domain-guid", URL: "web.example.com"})
+			}
+			targetRoutes[0].Destinations = []resources.RouteDestination{{App: resources.RouteDestinationApp{GUID: "new-web-guid", Process: struct{ Type string }{Type: "web"}}}}
+			return "some-job-url", nil, nil
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(bundleDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		exportCmd := ExportSpaceCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: new(commandfakes.FakeSharedActor),
+				Actor:       newActor(sourceCC),
+			},
+			NetworkingActor: fakePoliciesActor,
+			PWD:             bundleDir,
+		}
+		exportErr = exportCmd.Execute(nil)
+
+		applyCmd := ApplySpaceCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: new(commandfakes.FakeSharedActor),
+				Actor:       newActor(targetCC),
+			},
+			Force:           true,
+			ManifestParser:  manifestparser.ManifestParser{},
+			DiffDisplayer:   fakeDiffDisplayer,
+			NetworkingActor: fakeNetworkingActor,
+			PushActor:       fakePushActor,
+			CWD:             bundleDir,
+		}
+		applyErr = applyCmd.Execute(nil)
+	})
+
+	It("recreates the exported space in an empty space", func() {
+		Expect(exportErr).ToNot(HaveOccurred())
+		Expect(applyErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say("Manifest file created successfully"))
+		Expect(testUI.Out).To(Say(`Creating user provided service logs\.\.\.`))
+		Expect(testUI.Out).To(Say(`Creating route web\.example\.com\.\.\.`))
+		Expect(testUI.Out).To(Say(`Applying manifest\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(targetCC.CreateServiceInstanceCallCount()).To(Equal(1))
+		Expect(targetCC.CreateServiceInstanceArgsForCall(0).Name).To(Equal("logs"))
+
+		Expect(targetCC.CreateRouteCallCount()).To(Equal(1))
+		route := targetCC.CreateRouteArgsForCall(0)
+		Expect(route.SpaceGUID).To(Equal("some-space-guid"))
+		Expect(route.DomainGUID).To(Equal("new-domain-guid"))
+		Expect(route.Host).To(Equal("web"))
+
+		Expect(targetCC.UpdateSpaceApplyManifestCallCount()).To(Equal(1))
+		spaceGUID, manifest := targetCC.UpdateSpaceApplyManifestArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(string(manifest)).To(ContainSubstring("route: web.example.com"))
+
+		Expect(targetCC.MapRouteCallCount()).To(Equal(0))
+		Expect(targetRoutes).To(HaveLen(1))
+
+		// The exported manifest has no path, so the app cannot be pushed.
+		Expect(testUI.Err).To(Say(`App web has never been pushed and its manifest entry has neither a path nor a docker image`))
+		Expect(fakePushActor.PushSpaceApplicationCallCount()).To(Equal(0))
+	})
+
+	When("the route already exists when it is created", func() {
+		BeforeEach(func() {
+			targetCC.GetRoutesStub = func(...ccv3.Query) ([]resources.Route, ccv3.Warnings, error) {
+				if targetCC.CreateRouteCallCount() == 0 {
+					return nil, nil, nil
+				}
+				return targetRoutes, nil, nil
+			}
+			targetRoutes = []resources.Route{{GUID: "new-route-guid", Host: "web", DomainGUID: "new-domain-guid", URL: "web.example.com"}}
+		})
+
+		It("applies the rest of the space", func() {
+			Expect(applyErr).ToNot(HaveOccurred())
+			Expect(targetCC.CreateRouteCallCount()).To(Equal(1))
+			Expect(targetCC.UpdateSpaceApplyManifestCallCount()).To(Equal(1))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+})
+
+type currentUserActor struct {
+	v7action.AuthActor
+	user configv3.User
+}
+
+func (actor currentUserActor) GetCurrentUser() (configv3.User, error) {
+	return actor.user, nil
+}
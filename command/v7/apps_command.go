@@ -3,7 +3,9 @@ package v7
 import (
 	"strings"
 
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
@@ -17,6 +19,8 @@ type AppsCommand struct {
 	OmitStats bool   `long:"no-stats" description:"Do not retrieve process stats"`
 }
 
+func (AppsCommand) SupportsStructuredOutput() {}
+
 func (cmd AppsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -28,12 +32,15 @@ func (cmd AppsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatDefault {
+		cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	summaries, warnings, err := cmd.Actor.GetAppSummariesForSpace(cmd.Config.TargetedSpace().GUID, cmd.Labels, cmd.OmitStats)
 	cmd.UI.DisplayWarnings(warnings)
@@ -41,6 +48,10 @@ func (cmd AppsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if outputFormat != configv3.OutputFormatDefault {
+		return shared.DisplayOutputList(cmd.UI, outputFormat, "apps", shared.NewAppOutputs(summaries))
+	}
+
 	if len(summaries) == 0 {
 		cmd.UI.DisplayText("No apps found")
 		return nil
@@ -11,6 +11,7 @@ import (
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo/v2"
@@ -310,6 +311,90 @@ var _ = Describe("apps Command", func() {
 		})
 	})
 
+	When("an output format is requested", func() {
+		BeforeEach(func() {
+			appSummaries := []v7action.ApplicationSummary{
+				{
+					Application: resources.Application{
+						GUID:  "app-guid-1",
+						Name:  "some-app-1",
+						State: constant.ApplicationStarted,
+					},
+					ProcessSummaries: []v7action.ProcessSummary{
+						{
+							Process: resources.Process{
+								Type:      constant.ProcessTypeWeb,
+								Instances: types.NullInt{Value: 2, IsSet: true},
+							},
+							InstanceDetails: []v7action.ProcessInstance{
+								{Index: 0, State: constant.ProcessInstanceRunning},
+								{Index: 1, State: constant.ProcessInstanceDown},
+							},
+						},
+					},
+					Routes: []resources.Route{
+						{URL: "some-app-1.some-domain"},
+					},
+				},
+			}
+			fakeActor.GetAppSummariesForSpaceReturns(appSummaries, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		When("the format is json", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			})
+
+			It("displays a versioned json document instead of the table", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting apps"))
+				Expect(testUI.Out).To(Say(`"version": 1,`))
+				Expect(testUI.Out).To(Say(`"kind": "apps",`))
+				Expect(testUI.Out).To(Say(`"name": "some-app-1",`))
+				Expect(testUI.Out).To(Say(`"guid": "app-guid-1",`))
+				Expect(testUI.Out).To(Say(`"requested_state": "started",`))
+				Expect(testUI.Out).To(Say(`"type": "web",`))
+				Expect(testUI.Out).To(Say(`"instances": 2,`))
+				Expect(testUI.Out).To(Say(`"running_instances": 1,`))
+				Expect(testUI.Out).To(Say(`"some-app-1.some-domain"`))
+
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+
+		When("the format is yaml", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+			})
+
+			It("displays a versioned yaml document instead of the table", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting apps"))
+				Expect(testUI.Out).To(Say(`version: 1\n`))
+				Expect(testUI.Out).To(Say(`kind: apps\n`))
+				Expect(testUI.Out).To(Say(`- name: some-app-1\n`))
+				Expect(testUI.Out).To(Say(`requested_state: started\n`))
+				Expect(testUI.Out).To(Say(`- some-app-1.some-domain\n`))
+			})
+		})
+
+		When("there are no apps", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+				fakeActor.GetAppSummariesForSpaceReturns(nil, nil, nil)
+			})
+
+			It("displays an empty list of resources", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("No apps found"))
+				Expect(testUI.Out).To(Say(`"resources": \[\]`))
+			})
+		})
+	})
+
 	Context("when '--skip-stats' flag is set", func() {
 		BeforeEach(func() {
 			cmd.OmitStats = true
@@ -3,6 +3,9 @@ package v7
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/api/uaa/uaaversion"
@@ -15,12 +18,13 @@ import (
 type AuthCommand struct {
 	BaseCommand
 
-	RequiredArgs      flag.Authentication `positional-args:"yes"`
-	ClientCredentials bool                `long:"client-credentials" description:"Use (non-user) service account (also called client credentials)"`
-	Origin            string              `long:"origin" description:"Indicates the identity provider to be used for authentication"`
-	Assertion         string              `long:"assertion" description:"Token based authentication with assertion (user) or in combination with client-credentials (non-user)"`
-	usage             interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth USERNAME PASSWORD --origin ORIGIN\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --assertion ID-TOKEN\n   CF_NAME auth CLIENT_ID --client-credentials --assertion ACCESS-TOKEN\n\nENVIRONMENT VARIABLES:\n   CF_USERNAME=user          Authenticating user. Overridden if USERNAME argument is provided.\n   CF_PASSWORD=password      Password associated with user. Overridden if PASSWORD argument is provided.\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n   Consider using the CF_PASSWORD environment variable instead\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
-	relatedCommands   interface{}         `related_commands:"api, login, target"`
+	RequiredArgs      flag.Authentication         `positional-args:"yes"`
+	ClientCredentials bool                        `long:"client-credentials" description:"Use (non-user) service account (also called client credentials)"`
+	Origin            string                      `long:"origin" description:"Indicates the identity provider to be used for authentication"`
+	Assertion         string                      `long:"assertion" description:"Token based authentication with assertion (user) or in combination with client-credentials (non-user)"`
+	AssertionFile     flag.PathWithExistenceCheck `long:"assertion-file" description:"Token based authentication with the assertion (user) in the file, read again whenever the token is refreshed"`
+	usage             interface{}                 `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth USERNAME PASSWORD --origin ORIGIN\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --assertion ID-TOKEN\n   CF_NAME auth CLIENT_ID --client-credentials --assertion ACCESS-TOKEN\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --assertion-file ID-TOKEN-FILE\n\nENVIRONMENT VARIABLES:\n   CF_USERNAME=user          Authenticating user. Overridden if USERNAME argument is provided.\n   CF_PASSWORD=password      Password associated with user. Overridden if PASSWORD argument is provided.\n   CF_ASSERTION_FILE=file    File containing the assertion (user). Overridden if --assertion or --assertion-file is provided.\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n   Consider using the CF_PASSWORD environment variable instead\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
+	relatedCommands   interface{}                 `related_commands:"api, login, target"`
 }
 
 func (cmd AuthCommand) Execute(args []string) error {
@@ -42,6 +46,18 @@ func (cmd AuthCommand) Execute(args []string) error {
 		}
 	}
 
+	assertionFile, err := cmd.getAssertionFile()
+	if err != nil {
+		return err
+	}
+
+	if assertionFile != "" {
+		cmd.Assertion, err = readAssertion(assertionFile)
+		if err != nil {
+			return err
+		}
+	}
+
 	username, password, err := cmd.getUsernamePassword()
 	if err != nil {
 		return err
@@ -64,7 +80,7 @@ func (cmd AuthCommand) Execute(args []string) error {
 	if !cmd.ClientCredentials {
 		if cmd.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
 			return translatableerror.PasswordGrantTypeLogoutRequiredError{}
-		} else if (cmd.Assertion == "" && cmd.Config.UAAOAuthClient() != "cf") || cmd.Config.UAAOAuthClientSecret() != "" {
+		} else if (cmd.Assertion == "" && cmd.Config.UAAOAuthClient() != "cf") || (cmd.Config.UAAOAuthClientSecret() != "" && cmd.Config.UAAAssertionFile() == "") {
 			return translatableerror.ManualClientCredentialsError{}
 		}
 	}
@@ -136,6 +152,12 @@ func (cmd AuthCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.Config.SetUAAAssertionFile(assertionFile)
+	if assertionFile != "" && password != "" {
+		// The assertion is exchanged again with the client secret whenever the
+		// token is refreshed.
+		cmd.Config.SetUAAClientCredentials(username, password)
+	}
 
 	cmd.UI.DisplayOK()
 	cmd.UI.DisplayTextWithFlavor(
@@ -147,6 +169,38 @@ func (cmd AuthCommand) Execute(args []string) error {
 	return nil
 }
 
+// getAssertionFile returns the file given with --assertion-file or, when
+// no other assertion is given, $CF_ASSERTION_FILE.
+func (cmd AuthCommand) getAssertionFile() (string, error) {
+	if cmd.AssertionFile != "" {
+		if cmd.Assertion != "" {
+			return "", translatableerror.ArgumentCombinationError{
+				Args: []string{"--assertion", "--assertion-file"},
+			}
+		}
+		if cmd.ClientCredentials {
+			return "", translatableerror.ArgumentCombinationError{
+				Args: []string{"--client-credentials", "--assertion-file"},
+			}
+		}
+		return string(cmd.AssertionFile), nil
+	}
+
+	envAssertionFile := cmd.Config.CFAssertionFile()
+	if envAssertionFile == "" || cmd.Assertion != "" || cmd.ClientCredentials {
+		return "", nil
+	}
+	return filepath.Abs(envAssertionFile)
+}
+
+func readAssertion(path string) (string, error) {
+	assertion, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(assertion)), nil
+}
+
 func (cmd AuthCommand) getUsernamePassword() (string, string, error) {
 	var (
 		userMissing     bool
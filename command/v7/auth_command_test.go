@@ -2,20 +2,28 @@ package v7_test
 
 import (
 	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
 
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/api/uaa/uaaversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/ghttp"
 )
 
 var _ = Describe("auth Command", func() {
@@ -217,6 +225,9 @@ var _ = Describe("auth Command", func() {
 				Expect(secret).To(Equal(testSecret))
 				Expect(origin).To(BeEmpty())
 				Expect(grantType).To(Equal(constant.GrantTypeClientCredentials))
+
+				Expect(fakeConfig.SetUAAAssertionFileCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAAssertionFileArgsForCall(0)).To(BeEmpty())
 			})
 		})
 
@@ -298,6 +309,158 @@ var _ = Describe("auth Command", func() {
 				})
 			})
 
+			When("an assertion file is given", func() {
+				var (
+					assertionDir  string
+					assertionFile string
+				)
+
+				BeforeEach(func() {
+					var tempErr error
+					assertionDir, tempErr = os.MkdirTemp("", "auth-assertion")
+					Expect(tempErr).ToNot(HaveOccurred())
+
+					assertionFile = filepath.Join(assertionDir, "id-token")
+					Expect(os.WriteFile(assertionFile, []byte("jwt-token-from-file\n"), 0600)).To(Succeed())
+
+					cmd.RequiredArgs.Username = testID
+					cmd.RequiredArgs.Password = testSecret
+				})
+
+				AfterEach(func() {
+					Expect(os.RemoveAll(assertionDir)).To(Succeed())
+				})
+
+				When("--assertion-file is set", func() {
+					BeforeEach(func() {
+						cmd.AssertionFile = flag.PathWithExistenceCheck(assertionFile)
+					})
+
+					It("authenticates with the assertion in the file and remembers the file", func() {
+						Expect(err).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say("OK"))
+
+						Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+						credentials, _, grantType := fakeActor.AuthenticateArgsForCall(0)
+						Expect(credentials).To(Equal(map[string]string{
+							"assertion":     "jwt-token-from-file",
+							"client_id":     testID,
+							"client_secret": testSecret,
+						}))
+						Expect(grantType).To(Equal(constant.GrantTypeJwtBearer))
+
+						Expect(fakeConfig.SetUAAAssertionFileCallCount()).To(Equal(1))
+						Expect(fakeConfig.SetUAAAssertionFileArgsForCall(0)).To(Equal(assertionFile))
+
+						Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(1))
+						client, secret := fakeConfig.SetUAAClientCredentialsArgsForCall(0)
+						Expect(client).To(Equal(testID))
+						Expect(secret).To(Equal(testSecret))
+					})
+
+					When("the client secret was saved by a previous assertion file login", func() {
+						BeforeEach(func() {
+							fakeConfig.UAAOAuthClientReturns(testID)
+							fakeConfig.UAAOAuthClientSecretReturns(testSecret)
+							fakeConfig.UAAAssertionFileReturns(assertionFile)
+						})
+
+						It("authenticates again", func() {
+							Expect(err).ToNot(HaveOccurred())
+							Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+						})
+					})
+
+					When("no client secret is given", func() {
+						BeforeEach(func() {
+							cmd.RequiredArgs.Password = ""
+						})
+
+						It("does not save the client credentials", func() {
+							Expect(err).ToNot(HaveOccurred())
+							Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(0))
+						})
+					})
+
+					When("--assertion is also set", func() {
+						BeforeEach(func() {
+							cmd.Assertion = "jwt-token"
+						})
+
+						It("returns an error", func() {
+							Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+								Args: []string{"--assertion", "--assertion-file"},
+							}))
+							Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+						})
+					})
+
+					When("--client-credentials is also set", func() {
+						BeforeEach(func() {
+							cmd.ClientCredentials = true
+						})
+
+						It("returns an error", func() {
+							Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+								Args: []string{"--client-credentials", "--assertion-file"},
+							}))
+						})
+					})
+
+					When("authentication fails", func() {
+						BeforeEach(func() {
+							fakeActor.AuthenticateReturns(errors.New("invalid assertion"))
+						})
+
+						It("does not remember the file or the client secret", func() {
+							Expect(err).To(MatchError("invalid assertion"))
+							Expect(fakeConfig.SetUAAAssertionFileCallCount()).To(Equal(0))
+							Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(0))
+						})
+					})
+				})
+
+				When("the file is given in CF_ASSERTION_FILE", func() {
+					BeforeEach(func() {
+						fakeConfig.CFAssertionFileReturns(assertionFile)
+					})
+
+					It("authenticates with the assertion in the file", func() {
+						Expect(err).ToNot(HaveOccurred())
+
+						credentials, _, grantType := fakeActor.AuthenticateArgsForCall(0)
+						Expect(credentials["assertion"]).To(Equal("jwt-token-from-file"))
+						Expect(grantType).To(Equal(constant.GrantTypeJwtBearer))
+						Expect(fakeConfig.SetUAAAssertionFileArgsForCall(0)).To(Equal(assertionFile))
+					})
+
+					When("--assertion is set", func() {
+						BeforeEach(func() {
+							cmd.Assertion = "jwt-token"
+						})
+
+						It("uses the assertion from the flag", func() {
+							Expect(err).ToNot(HaveOccurred())
+
+							credentials, _, _ := fakeActor.AuthenticateArgsForCall(0)
+							Expect(credentials["assertion"]).To(Equal("jwt-token"))
+							Expect(fakeConfig.SetUAAAssertionFileArgsForCall(0)).To(BeEmpty())
+						})
+					})
+
+					When("the file does not exist", func() {
+						BeforeEach(func() {
+							fakeConfig.CFAssertionFileReturns(filepath.Join(assertionDir, "missing"))
+						})
+
+						It("returns the error", func() {
+							Expect(err).To(HaveOccurred())
+							Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+						})
+					})
+				})
+			})
+
 			When("--assertion and --client-credentials is set", func() {
 				BeforeEach(func() {
 					cmd.ClientCredentials = true
@@ -466,4 +629,81 @@ var _ = Describe("auth Command", func() {
 			})
 		})
 	})
+
+	Describe("refreshing the token of an assertion file login with the real config", func() {
+		var (
+			homeDir       string
+			assertionFile string
+			uaaServer     *ghttp.Server
+			realConfig    *configv3.Config
+		)
+
+		newUAAClient := func(config *configv3.Config) *uaa.Client {
+			client := uaa.NewClient(config)
+			Expect(client.SetupResources(uaaServer.URL(), uaaServer.URL())).To(Succeed())
+			return client
+		}
+
+		BeforeEach(func() {
+			var tempErr error
+			homeDir, tempErr = os.MkdirTemp("", "auth-real-config")
+			Expect(tempErr).ToNot(HaveOccurred())
+			Expect(os.Setenv("CF_HOME", homeDir)).To(Succeed())
+
+			assertionFile = filepath.Join(homeDir, "id-token")
+			Expect(os.WriteFile(assertionFile, []byte("first-assertion\n"), 0600)).To(Succeed())
+
+			uaaServer = ghttp.NewServer()
+			uaaServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/oauth/token"),
+					ghttp.VerifyBasicAuth("some-client-id", "some-client-secret"),
+					ghttp.VerifyForm(url.Values{
+						"assertion":  {"first-assertion"},
+						"grant_type": {string(constant.GrantTypeJwtBearer)},
+					}),
+					ghttp.RespondWith(http.StatusOK, `{"access_token": "first-access-token", "refresh_token": "first-refresh-token"}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/oauth/token"),
+					ghttp.VerifyBasicAuth("some-client-id", "some-client-secret"),
+					ghttp.VerifyForm(url.Values{
+						"assertion":  {"second-assertion"},
+						"grant_type": {string(constant.GrantTypeJwtBearer)},
+					}),
+					ghttp.RespondWith(http.StatusOK, `{"access_token": "second-access-token", "token_type": "bearer"}`),
+				),
+			)
+
+			realConfig, tempErr = configv3.LoadConfig()
+			Expect(tempErr).ToNot(HaveOccurred())
+			fakeActor.AuthenticateStub = v7action.NewDefaultAuthActor(realConfig, newUAAClient(realConfig)).Authenticate
+
+			cmd.Config = realConfig
+			cmd.RequiredArgs.Username = "some-client-id"
+			cmd.RequiredArgs.Password = "some-client-secret"
+			cmd.AssertionFile = flag.PathWithExistenceCheck(assertionFile)
+		})
+
+		AfterEach(func() {
+			uaaServer.Close()
+			Expect(os.Unsetenv("CF_HOME")).To(Succeed())
+			Expect(os.RemoveAll(homeDir)).To(Succeed())
+		})
+
+		It("exchanges the new assertion with the client secret", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(realConfig.AccessToken()).To(Equal("bearer first-access-token"))
+			Expect(realConfig.WriteConfig()).To(Succeed())
+
+			Expect(os.WriteFile(assertionFile, []byte("second-assertion\n"), 0600)).To(Succeed())
+			loadedConfig, loadErr := configv3.LoadConfig()
+			Expect(loadErr).ToNot(HaveOccurred())
+
+			tokens, refreshErr := newUAAClient(loadedConfig).RefreshAccessToken(loadedConfig.RefreshToken())
+			Expect(refreshErr).ToNot(HaveOccurred())
+			Expect(tokens.AuthorizationToken()).To(Equal("bearer second-access-token"))
+			Expect(uaaServer.ReceivedRequests()).To(HaveLen(2))
+		})
+	})
 })
@@ -11,9 +11,10 @@ type ConfigCommand struct {
 	Config       command.Config
 	AsyncTimeout flag.Timeout      `long:"async-timeout" description:"Timeout in minutes for async HTTP requests"`
 	Color        flag.Color        `long:"color" description:"Enable or disable color in CLI output"`
+	CredStore    string            `long:"credential-store" description:"Where to keep tokens: 'file' for a file encrypted with $CF_CREDENTIALS_PASSPHRASE, 'none' for config.json, or NAME to run the cf-credential-NAME helper"`
 	Locale       flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
 	Trace        flag.PathWithBool `long:"trace" description:"Trace HTTP requests by default. If a file path is provided then output will write to the file provided. If the file does not exist it will be created."`
-	usage        interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"`
+	usage        interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)] [--credential-store (file | none | NAME)]"`
 }
 
 func (cmd *ConfigCommand) Setup(config command.Config, ui command.UI) error {
@@ -24,10 +25,17 @@ func (cmd *ConfigCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd ConfigCommand) Execute(args []string) error {
-	if !cmd.Color.IsSet && cmd.Trace == "" && cmd.Locale.Locale == "" && !cmd.AsyncTimeout.IsSet {
+	if !cmd.Color.IsSet && cmd.Trace == "" && cmd.Locale.Locale == "" && !cmd.AsyncTimeout.IsSet && cmd.CredStore == "" {
 		return translatableerror.IncorrectUsageError{Message: "at least one flag must be provided"}
 	}
 
+	if cmd.CredStore != "" {
+		err := cmd.Config.CheckCredentialStore(cmd.CredStore)
+		if err != nil {
+			return err
+		}
+	}
+
 	cmd.UI.DisplayText("Setting values in config...")
 
 	if cmd.AsyncTimeout.IsSet {
@@ -46,6 +54,15 @@ func (cmd ConfigCommand) Execute(args []string) error {
 		cmd.Config.SetTrace(string(cmd.Trace))
 	}
 
+	if cmd.CredStore != "" {
+		err := cmd.Config.SetCredentialStore(cmd.CredStore)
+		if err != nil {
+			cmd.UI.DisplayWarning("The credentials could not be erased from the previous credential store: {{.Error}}", map[string]interface{}{
+				"Error": err.Error(),
+			})
+		}
+	}
+
 	cmd.UI.DisplayOK()
 	return nil
 }
@@ -1,6 +1,8 @@
 package v7_test
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -94,4 +96,44 @@ var _ = Describe("ConfigCommand", func() {
 			Expect(value).To(Equal("my-trace-file"))
 		})
 	})
+
+	When("using the credential store flag", func() {
+		BeforeEach(func() {
+			cmd.CredStore = "file"
+		})
+
+		It("checks the store and successfully updates the config", func() {
+			Expect(executeErr).To(Not(HaveOccurred()))
+			Expect(fakeConfig.CheckCredentialStoreCallCount()).To(Equal(1))
+			Expect(fakeConfig.CheckCredentialStoreArgsForCall(0)).To(Equal("file"))
+			Expect(fakeConfig.SetCredentialStoreCallCount()).To(Equal(1))
+			value := fakeConfig.SetCredentialStoreArgsForCall(0)
+			Expect(value).To(Equal("file"))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("the store cannot be used", func() {
+			BeforeEach(func() {
+				fakeConfig.CheckCredentialStoreReturns(errors.New("Unable to use the file credential store: CF_CREDENTIALS_PASSPHRASE must be set to use the file credential store"))
+			})
+
+			It("returns the error without changing the config", func() {
+				Expect(executeErr).To(MatchError("Unable to use the file credential store: CF_CREDENTIALS_PASSPHRASE must be set to use the file credential store"))
+				Expect(fakeConfig.SetCredentialStoreCallCount()).To(Equal(0))
+				Expect(testUI.Out).NotTo(Say("OK"))
+			})
+		})
+
+		When("the credentials cannot be erased from the previous store", func() {
+			BeforeEach(func() {
+				fakeConfig.SetCredentialStoreReturns(errors.New("the keychain is locked"))
+			})
+
+			It("warns and succeeds", func() {
+				Expect(executeErr).To(Not(HaveOccurred()))
+				Expect(testUI.Err).To(Say("The credentials could not be erased from the previous credential store: the keychain is locked"))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+	})
 })
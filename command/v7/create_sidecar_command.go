@@ -0,0 +1,59 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type CreateSidecarCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppSidecarArgs `positional-args:"yes"`
+	Command         string              `long:"command" short:"c" required:"true" description:"The command used to start the sidecar"`
+	ProcessTypes    []string            `long:"process" description:"App process the sidecar runs alongside, can be given multiple times (Default: web)"`
+	Memory          flag.Megabytes      `long:"memory" short:"m" description:"Memory reserved for the sidecar out of its process' memory, with a unit of measurement in M or G (e.g. 256M, 1G)"`
+	usage           interface{}         `usage:"CF_NAME create-sidecar APP_NAME SIDECAR_NAME -c COMMAND [--process PROCESS_TYPE]... [-m MEMORY]\n\nEXAMPLES:\n   CF_NAME create-sidecar my-app auth-proxy -c './proxy --port 8081'\n   CF_NAME create-sidecar my-app log-shipper -c './shipper' --process web --process worker -m 64M"`
+	relatedCommands interface{}         `related_commands:"delete-sidecar, restart, sidecars, update-sidecar"`
+}
+
+func (cmd CreateSidecarCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating sidecar {{.SidecarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"SidecarName": cmd.RequiredArgs.SidecarName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+
+	processTypes := cmd.ProcessTypes
+	if len(processTypes) == 0 {
+		processTypes = []string{"web"}
+	}
+
+	_, warnings, err := cmd.Actor.CreateApplicationSidecar(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, resources.Sidecar{
+		Name:         cmd.RequiredArgs.SidecarName,
+		Command:      *types.NewFilteredString(cmd.Command),
+		ProcessTypes: processTypes,
+		MemoryInMB:   cmd.Memory.NullUint64,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	displaySidecarRestartTip(cmd.UI, cmd.Config.BinaryName(), cmd.RequiredArgs.AppName)
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-sidecar Command", func() {
+	var (
+		cmd             CreateSidecarCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = CreateSidecarCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			Command: "./proxy",
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.SidecarName = "proxy"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.CreateApplicationSidecarReturns(resources.Sidecar{GUID: "sidecar-guid"}, v7action.Warnings{"create-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error and does not create the sidecar", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.CreateApplicationSidecarCallCount()).To(Equal(0))
+		})
+	})
+
+	It("creates the sidecar for the web process by default", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.CreateApplicationSidecarCallCount()).To(Equal(1))
+		appName, spaceGUID, sidecar := fakeActor.CreateApplicationSidecarArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(sidecar).To(Equal(resources.Sidecar{
+			Name:         "proxy",
+			Command:      *types.NewFilteredString("./proxy"),
+			ProcessTypes: []string{"web"},
+		}))
+
+		Expect(testUI.Out).To(Say(`Creating sidecar proxy for app some-app in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say(`TIP: Use 'faceman restart some-app' to ensure your sidecar changes take effect\.`))
+		Expect(testUI.Err).To(Say("create-warning"))
+	})
+
+	When("processes and memory are provided", func() {
+		BeforeEach(func() {
+			cmd.ProcessTypes = []string{"web", "worker"}
+			cmd.Memory = flag.Megabytes{NullUint64: types.NullUint64{IsSet: true, Value: 64}}
+		})
+
+		It("passes them to the actor", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, _, sidecar := fakeActor.CreateApplicationSidecarArgsForCall(0)
+			Expect(sidecar.ProcessTypes).To(Equal([]string{"web", "worker"}))
+			Expect(sidecar.MemoryInMB).To(Equal(types.NullUint64{IsSet: true, Value: 64}))
+		})
+	})
+
+	When("creating the sidecar fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateApplicationSidecarReturns(resources.Sidecar{}, v7action.Warnings{"create-warning"}, errors.New("create-error"))
+		})
+
+		It("displays warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError("create-error"))
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
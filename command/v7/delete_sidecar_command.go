@@ -0,0 +1,65 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type DeleteSidecarCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppSidecarArgs `positional-args:"yes"`
+	Force           bool                `long:"force" short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}         `usage:"CF_NAME delete-sidecar APP_NAME SIDECAR_NAME [-f]"`
+	relatedCommands interface{}         `related_commands:"create-sidecar, restart, sidecars, update-sidecar"`
+}
+
+func (cmd DeleteSidecarCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		response, uiErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the sidecar {{.SidecarName}} of app {{.AppName}}?", map[string]interface{}{
+			"SidecarName": cmd.RequiredArgs.SidecarName,
+			"AppName":     cmd.RequiredArgs.AppName,
+		})
+		if uiErr != nil {
+			return uiErr
+		}
+
+		if !response {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting sidecar {{.SidecarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"SidecarName": cmd.RequiredArgs.SidecarName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+
+	warnings, err := cmd.Actor.DeleteApplicationSidecar(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SidecarName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.SidecarNotFoundError); !ok {
+			return err
+		}
+		cmd.UI.DisplayWarning("Sidecar '{{.SidecarName}}' does not exist.", map[string]interface{}{
+			"SidecarName": cmd.RequiredArgs.SidecarName,
+		})
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
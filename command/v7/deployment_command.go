@@ -0,0 +1,143 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type DeploymentCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Watch           bool         `long:"watch" short:"w" description:"Refresh the status until the deployment finishes"`
+	usage           interface{}  `usage:"CF_NAME deployment APP_NAME [--watch]\n\nEXAMPLES:\n   CF_NAME deployment my-app\n   CF_NAME deployment my-app --watch"`
+	relatedCommands interface{}  `related_commands:"app, cancel-deployment, continue-deployment, push, restart"`
+}
+
+func (cmd DeploymentCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	deployment, warnings, err := cmd.Actor.GetLatestActiveDeploymentForApp(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	for refresh := 0; ; refresh++ {
+		status, warnings, err := cmd.Actor.GetDeploymentStatus(app, deployment.GUID)
+		if err != nil {
+			cmd.UI.DisplayWarnings(warnings)
+			return err
+		}
+
+		switch {
+		case !cmd.Watch:
+		case cmd.Config.IsTTY():
+			fmt.Fprint(cmd.UI.GetOut(), clearScreen)
+		case refresh > 0:
+			cmd.UI.DisplayNewline()
+		}
+		cmd.displayStatus(user.Name, app, status)
+		cmd.UI.DisplayWarnings(warnings)
+
+		if !cmd.Watch || status.Deployment.StatusValue == constant.DeploymentStatusValueFinalized {
+			return nil
+		}
+
+		select {
+		case <-interrupt:
+			return nil
+		case <-time.After(cmd.Config.PollingInterval()):
+		}
+	}
+}
+
+func (cmd DeploymentCommand) displayStatus(username string, app resources.Application, status v7action.DeploymentStatus) {
+	cmd.UI.DisplayTextWithFlavor("Getting deployment for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   app.Name,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  username,
+	})
+	cmd.UI.DisplayNewline()
+
+	deployment := status.Deployment
+	keyValueTable := [][]string{
+		{cmd.UI.TranslateText("guid:"), deployment.GUID},
+		{cmd.UI.TranslateText("strategy:"), strings.ToLower(string(deployment.Strategy))},
+		{cmd.UI.TranslateText("status:"), fmt.Sprintf("%s (%s)", strings.ToLower(string(deployment.StatusValue)), strings.ToLower(string(deployment.StatusReason)))},
+	}
+	if !status.StatusChangedAt.IsZero() {
+		if deployment.StatusReason == constant.DeploymentStatusReasonPaused {
+			keyValueTable = append(keyValueTable, []string{cmd.UI.TranslateText("paused for:"), time.Since(status.StatusChangedAt).Round(time.Second).String()})
+		} else {
+			keyValueTable = append(keyValueTable, []string{cmd.UI.TranslateText("since:"), cmd.UI.UserFriendlyDate(status.StatusChangedAt)})
+		}
+	}
+	if steps := deployment.CanaryStatus.Steps; steps.TotalSteps > 0 {
+		keyValueTable = append(keyValueTable, []string{cmd.UI.TranslateText("canary step:"), fmt.Sprintf("%d/%d", steps.CurrentStep, steps.TotalSteps)})
+	}
+	if deployment.Options.MaxInFlight > 0 {
+		keyValueTable = append(keyValueTable, []string{cmd.UI.TranslateText("max-in-flight:"), strconv.Itoa(deployment.Options.MaxInFlight)})
+	}
+	cmd.UI.DisplayKeyValueTable("", keyValueTable, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("instances"),
+			cmd.UI.TranslateText("desired"),
+			cmd.UI.TranslateText("running"),
+			cmd.UI.TranslateText("starting"),
+			cmd.UI.TranslateText("crashed"),
+			cmd.UI.TranslateText("down"),
+		},
+		deploymentCountsRow(cmd.UI.TranslateText("previous"), status.Previous),
+		deploymentCountsRow(cmd.UI.TranslateText("new"), status.New),
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if deployment.Strategy == constant.DeploymentStrategyCanary && deployment.StatusReason == constant.DeploymentStatusReasonPaused {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText(fmt.Sprintf("Please run `cf continue-deployment %s` to promote the canary deployment, or `cf cancel-deployment %s` to rollback to the previous version.", app.Name, app.Name))
+	}
+}
+
+func deploymentCountsRow(name string, counts v7action.DeploymentInstanceCounts) []string {
+	return []string{
+		name,
+		strconv.Itoa(counts.Desired),
+		strconv.Itoa(counts.Running),
+		strconv.Itoa(counts.Starting),
+		strconv.Itoa(counts.Crashed),
+		strconv.Itoa(counts.Down),
+	}
+}
@@ -0,0 +1,160 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("deployment Command", func() {
+	var (
+		cmd             v7.DeploymentCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+		status          v7action.DeploymentStatus
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.PollingIntervalReturns(time.Millisecond)
+
+		cmd = v7.DeploymentCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{Name: "some-app", GUID: "some-app-guid"}, v7action.Warnings{"app-warning"}, nil)
+		fakeActor.GetLatestActiveDeploymentForAppReturns(resources.Deployment{GUID: "some-deployment-guid"}, v7action.Warnings{"deployment-warning"}, nil)
+
+		status = v7action.DeploymentStatus{
+			Deployment: resources.Deployment{
+				GUID:         "some-deployment-guid",
+				Strategy:     constant.DeploymentStrategyCanary,
+				StatusValue:  constant.DeploymentStatusValueActive,
+				StatusReason: constant.DeploymentStatusReasonPaused,
+				CanaryStatus: resources.CanaryStatus{Steps: resources.CanaryStepStatus{CurrentStep: 1, TotalSteps: 3}},
+				Options:      resources.DeploymentOpts{MaxInFlight: 2},
+			},
+			StatusChangedAt: time.Now().Add(-90 * time.Second),
+			Previous:        v7action.DeploymentInstanceCounts{Desired: 4, Running: 4},
+			New:             v7action.DeploymentInstanceCounts{Desired: 1, Starting: 1},
+		}
+		fakeActor.GetDeploymentStatusReturns(status, v7action.Warnings{"status-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+		})
+	})
+
+	When("the app has no active deployment", func() {
+		BeforeEach(func() {
+			fakeActor.GetLatestActiveDeploymentForAppReturns(resources.Deployment{}, v7action.Warnings{"deployment-warning"}, actionerror.ActiveDeploymentNotFoundError{})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ActiveDeploymentNotFoundError{}))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("deployment-warning"))
+			Expect(fakeActor.GetDeploymentStatusCallCount()).To(Equal(0))
+		})
+	})
+
+	It("displays the status of the active deployment", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.GetLatestActiveDeploymentForAppArgsForCall(0)).To(Equal("some-app-guid"))
+		app, deploymentGUID := fakeActor.GetDeploymentStatusArgsForCall(0)
+		Expect(app.GUID).To(Equal("some-app-guid"))
+		Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+		Expect(fakeActor.GetDeploymentStatusCallCount()).To(Equal(1))
+
+		Expect(testUI.Out).To(Say(`Getting deployment for app some-app in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`guid:\s+some-deployment-guid`))
+		Expect(testUI.Out).To(Say(`strategy:\s+canary`))
+		Expect(testUI.Out).To(Say(`status:\s+active \(paused\)`))
+		Expect(testUI.Out).To(Say(`paused for:\s+1m3\ds`))
+		Expect(testUI.Out).To(Say(`canary step:\s+1/3`))
+		Expect(testUI.Out).To(Say(`max-in-flight:\s+2`))
+		Expect(testUI.Out).To(Say(`instances\s+desired\s+running\s+starting\s+crashed\s+down`))
+		Expect(testUI.Out).To(Say(`previous\s+4\s+4\s+0\s+0\s+0`))
+		Expect(testUI.Out).To(Say(`new\s+1\s+0\s+1\s+0\s+0`))
+		Expect(testUI.Out).To(Say("Please run `cf continue-deployment some-app` to promote the canary deployment, or `cf cancel-deployment some-app` to rollback to the previous version."))
+
+		Expect(testUI.Err).To(Say("app-warning"))
+		Expect(testUI.Err).To(Say("deployment-warning"))
+		Expect(testUI.Err).To(Say("status-warning"))
+	})
+
+	When("--watch is provided", func() {
+		BeforeEach(func() {
+			cmd.Watch = true
+
+			finished := status
+			finished.Deployment.StatusValue = constant.DeploymentStatusValueFinalized
+			finished.Deployment.StatusReason = constant.DeploymentStatusReasonDeployed
+			finished.Deployment.CanaryStatus = resources.CanaryStatus{}
+			fakeActor.GetDeploymentStatusReturnsOnCall(1, finished, nil, nil)
+		})
+
+		It("refreshes the status until the deployment is finalized", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetDeploymentStatusCallCount()).To(Equal(2))
+
+			Expect(testUI.Out).To(Say(`status:\s+active \(paused\)`))
+			Expect(testUI.Out).To(Say(`status:\s+finalized \(deployed\)`))
+		})
+	})
+
+	When("getting the status fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetDeploymentStatusReturns(v7action.DeploymentStatus{}, v7action.Warnings{"status-warning"}, errors.New("status-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("status-error"))
+			Expect(testUI.Err).To(Say("status-warning"))
+		})
+	})
+})
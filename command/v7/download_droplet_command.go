@@ -7,8 +7,11 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/progressbar"
 )
 
 type DownloadDropletCommand struct {
@@ -19,6 +22,13 @@ type DownloadDropletCommand struct {
 	Path            string       `long:"path" short:"p" description:"File path to download droplet to (default: current working directory)."`
 	usage           interface{}  `usage:"CF_NAME download-droplet APP_NAME [--droplet DROPLET_GUID] [--path /path/to/droplet.tgz]"`
 	relatedCommands interface{}  `related_commands:"apps, droplets, push, set-droplet"`
+
+	ProgressBar v7action.DownloadProgressBar
+}
+
+func (cmd *DownloadDropletCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.ProgressBar = progressbar.NewProgressBar()
+	return cmd.BaseCommand.Setup(config, ui)
 }
 
 func (cmd DownloadDropletCommand) Execute(args []string) error {
@@ -33,23 +43,20 @@ func (cmd DownloadDropletCommand) Execute(args []string) error {
 	}
 
 	var (
-		rawDropletBytes []byte
-		dropletGUID     string
-		warnings        v7action.Warnings
+		droplet  resources.Droplet
+		warnings v7action.Warnings
 	)
 
 	if cmd.Droplet != "" {
-		dropletGUID = cmd.Droplet
-
 		cmd.UI.DisplayTextWithFlavor("Downloading droplet {{.DropletGUID}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-			"DropletGUID": dropletGUID,
+			"DropletGUID": cmd.Droplet,
 			"AppName":     cmd.RequiredArgs.AppName,
 			"OrgName":     cmd.Config.TargetedOrganization().Name,
 			"SpaceName":   cmd.Config.TargetedSpace().Name,
 			"Username":    user.Name,
 		})
 
-		rawDropletBytes, warnings, err = cmd.Actor.DownloadDropletByGUIDAndAppName(dropletGUID, cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		droplet, warnings, err = cmd.Actor.GetDropletByGUIDAndAppName(cmd.Droplet, cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
 	} else {
 		cmd.UI.DisplayTextWithFlavor("Downloading current droplet for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 			"AppName":   cmd.RequiredArgs.AppName,
@@ -58,7 +65,7 @@ func (cmd DownloadDropletCommand) Execute(args []string) error {
 			"Username":  user.Name,
 		})
 
-		rawDropletBytes, dropletGUID, warnings, err = cmd.Actor.DownloadCurrentDropletByAppName(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		droplet, warnings, err = cmd.Actor.GetCurrentDropletByAppName(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -78,22 +85,35 @@ func (cmd DownloadDropletCommand) Execute(args []string) error {
 			return err
 		}
 
-		pathToDroplet = filepath.Join(currentDir, fmt.Sprintf("droplet_%s.tgz", dropletGUID))
+		pathToDroplet = filepath.Join(currentDir, fmt.Sprintf("droplet_%s.tgz", droplet.GUID))
 	} else {
 		stats, err := os.Stat(cmd.Path)
 
 		if err == nil && stats.IsDir() {
-			pathToDroplet = filepath.Join(cmd.Path, fmt.Sprintf("droplet_%s.tgz", dropletGUID))
+			pathToDroplet = filepath.Join(cmd.Path, fmt.Sprintf("droplet_%s.tgz", droplet.GUID))
 		} else {
 			pathToDroplet = cmd.Path
 		}
 	}
 
-	err = os.WriteFile(pathToDroplet, rawDropletBytes, 0666)
+	dropletFile, err := os.OpenFile(pathToDroplet, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
 	if err != nil {
 		return translatableerror.DropletFileError{Err: err}
 	}
 
+	warnings, err = cmd.Actor.DownloadDroplet(droplet, dropletFile, cmd.ProgressBar)
+	cmd.UI.DisplayWarnings(warnings)
+	closeErr := dropletFile.Close()
+	if err != nil {
+		_ = os.Remove(pathToDroplet)
+		return err
+	}
+	if closeErr != nil {
+		return translatableerror.DropletFileError{Err: closeErr}
+	}
+
+	cmd.UI.DisplayNewline()
+
 	cmd.UI.DisplayText("Droplet downloaded successfully at {{.FilePath}}", map[string]interface{}{
 		"FilePath": pathToDroplet,
 	})
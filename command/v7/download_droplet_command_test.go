@@ -3,16 +3,19 @@ package v7_test
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"regexp"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo/v2"
@@ -28,6 +31,7 @@ var _ = Describe("download-droplet Command", func() {
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v7fakes.FakeActor
+		fakeProgressBar *v7actionfakes.FakeDownloadProgressBar
 		binaryName      string
 		executeErr      error
 	)
@@ -37,6 +41,7 @@ var _ = Describe("download-droplet Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v7fakes.FakeActor)
+		fakeProgressBar = new(v7actionfakes.FakeDownloadProgressBar)
 
 		cmd = DownloadDropletCommand{
 			BaseCommand: BaseCommand{
@@ -45,6 +50,7 @@ var _ = Describe("download-droplet Command", func() {
 				SharedActor: fakeSharedActor,
 				Actor:       fakeActor,
 			},
+			ProgressBar: fakeProgressBar,
 		}
 
 		cmd.RequiredArgs.AppName = "some-app"
@@ -60,6 +66,9 @@ var _ = Describe("download-droplet Command", func() {
 		fakeActor.GetCurrentUserReturns(
 			configv3.User{Name: "some-user"},
 			nil)
+		fakeActor.DownloadDropletStub = func(resources.Droplet, io.Writer, v7action.DownloadProgressBar) (v7action.Warnings, error) {
+			return v7action.Warnings{"some-download-warning"}, nil
+		}
 	})
 
 	JustBeforeEach(func() {
@@ -89,7 +98,11 @@ var _ = Describe("download-droplet Command", func() {
 
 		BeforeEach(func() {
 			dropletGUID = RandomString("fake-droplet-guid")
-			fakeActor.DownloadCurrentDropletByAppNameReturns([]byte("some-droplet-bytes"), dropletGUID, v7action.Warnings{"some-warning"}, nil)
+			fakeActor.GetCurrentDropletByAppNameReturns(resources.Droplet{GUID: dropletGUID}, v7action.Warnings{"some-warning"}, nil)
+			fakeActor.DownloadDropletStub = func(_ resources.Droplet, writer io.Writer, _ v7action.DownloadProgressBar) (v7action.Warnings, error) {
+				_, err := writer.Write([]byte("some-droplet-bytes"))
+				return v7action.Warnings{"some-download-warning"}, err
+			}
 
 			currentDir, _ := os.Getwd()
 			pathToDropletFile = filepath.Join(currentDir, fmt.Sprintf("droplet_%s.tgz", dropletGUID))
@@ -102,11 +115,16 @@ var _ = Describe("download-droplet Command", func() {
 		It("creates a droplet tarball in the current directory", func() {
 			Expect(executeErr).ToNot(HaveOccurred())
 
-			Expect(fakeActor.DownloadCurrentDropletByAppNameCallCount()).To(Equal(1))
-			appArg, spaceGUIDArg := fakeActor.DownloadCurrentDropletByAppNameArgsForCall(0)
+			Expect(fakeActor.GetCurrentDropletByAppNameCallCount()).To(Equal(1))
+			appArg, spaceGUIDArg := fakeActor.GetCurrentDropletByAppNameArgsForCall(0)
 			Expect(appArg).To(Equal("some-app"))
 			Expect(spaceGUIDArg).To(Equal("some-space-guid"))
 
+			Expect(fakeActor.DownloadDropletCallCount()).To(Equal(1))
+			dropletArg, _, progressBarArg := fakeActor.DownloadDropletArgsForCall(0)
+			Expect(dropletArg).To(Equal(resources.Droplet{GUID: dropletGUID}))
+			Expect(progressBarArg).To(Equal(fakeProgressBar))
+
 			fileContents, err := os.ReadFile(pathToDropletFile)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(string(fileContents)).To(Equal("some-droplet-bytes"))
@@ -115,6 +133,7 @@ var _ = Describe("download-droplet Command", func() {
 		It("displays the file it created and returns no errors", func() {
 			Expect(testUI.Out).To(Say("Downloading current droplet for app some-app in org some-org / space some-space as some-user..."))
 			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Err).To(Say("some-download-warning"))
 			Expect(testUI.Out).To(Say(`Droplet downloaded successfully at .*droplet_%s.tgz`, dropletGUID))
 			Expect(testUI.Out).To(Say("OK"))
 			Expect(executeErr).ToNot(HaveOccurred())
@@ -133,7 +152,11 @@ var _ = Describe("download-droplet Command", func() {
 
 			setFlag(&cmd, "--droplet", dropletGUID)
 
-			fakeActor.DownloadDropletByGUIDAndAppNameReturns([]byte("some-droplet-bytes"), v7action.Warnings{"some-warning"}, nil)
+			fakeActor.GetDropletByGUIDAndAppNameReturns(resources.Droplet{GUID: dropletGUID}, v7action.Warnings{"some-warning"}, nil)
+			fakeActor.DownloadDropletStub = func(_ resources.Droplet, writer io.Writer, _ v7action.DownloadProgressBar) (v7action.Warnings, error) {
+				_, err := writer.Write([]byte("some-droplet-bytes"))
+				return nil, err
+			}
 		})
 
 		AfterEach(func() {
@@ -143,8 +166,8 @@ var _ = Describe("download-droplet Command", func() {
 		It("creates a droplet tarball in the current directory", func() {
 			Expect(executeErr).ToNot(HaveOccurred())
 
-			Expect(fakeActor.DownloadDropletByGUIDAndAppNameCallCount()).To(Equal(1))
-			dropletGUIDArg, appArg, spaceGUIDArg := fakeActor.DownloadDropletByGUIDAndAppNameArgsForCall(0)
+			Expect(fakeActor.GetDropletByGUIDAndAppNameCallCount()).To(Equal(1))
+			dropletGUIDArg, appArg, spaceGUIDArg := fakeActor.GetDropletByGUIDAndAppNameArgsForCall(0)
 			Expect(dropletGUIDArg).To(Equal(dropletGUID))
 			Expect(appArg).To(Equal("some-app"))
 			Expect(spaceGUIDArg).To(Equal("some-space-guid"))
@@ -169,7 +192,11 @@ var _ = Describe("download-droplet Command", func() {
 			filePath = RandomString("fake-file")
 
 			setFlag(&cmd, "--path", filePath)
-			fakeActor.DownloadCurrentDropletByAppNameReturns([]byte("some-droplet"), "some-droplet-guid", v7action.Warnings{"some-warning"}, nil)
+			fakeActor.GetCurrentDropletByAppNameReturns(resources.Droplet{GUID: "some-droplet-guid"}, v7action.Warnings{"some-warning"}, nil)
+			fakeActor.DownloadDropletStub = func(_ resources.Droplet, writer io.Writer, _ v7action.DownloadProgressBar) (v7action.Warnings, error) {
+				_, err := writer.Write([]byte("some-droplet"))
+				return nil, err
+			}
 		})
 
 		AfterEach(func() {
@@ -203,7 +230,11 @@ var _ = Describe("download-droplet Command", func() {
 
 			setFlag(&cmd, "--path", tmpDir)
 
-			fakeActor.DownloadCurrentDropletByAppNameReturns([]byte("some-droplet"), "some-droplet-guid", v7action.Warnings{"some-warning"}, nil)
+			fakeActor.GetCurrentDropletByAppNameReturns(resources.Droplet{GUID: "some-droplet-guid"}, v7action.Warnings{"some-warning"}, nil)
+			fakeActor.DownloadDropletStub = func(_ resources.Droplet, writer io.Writer, _ v7action.DownloadProgressBar) (v7action.Warnings, error) {
+				_, err := writer.Write([]byte("some-droplet"))
+				return nil, err
+			}
 		})
 
 		AfterEach(func() {
@@ -231,7 +262,11 @@ var _ = Describe("download-droplet Command", func() {
 	When("a path to a file in an invalid directory is passed in", func() {
 		BeforeEach(func() {
 			cmd.Path = "not/exist/some-file.tgz"
-			fakeActor.DownloadCurrentDropletByAppNameReturns([]byte("some-droplet"), "some-droplet-guid", v7action.Warnings{"some-warning"}, nil)
+			fakeActor.GetCurrentDropletByAppNameReturns(resources.Droplet{GUID: "some-droplet-guid"}, v7action.Warnings{"some-warning"}, nil)
+			fakeActor.DownloadDropletStub = func(_ resources.Droplet, writer io.Writer, _ v7action.DownloadProgressBar) (v7action.Warnings, error) {
+				_, err := writer.Write([]byte("some-droplet"))
+				return nil, err
+			}
 		})
 
 		It("returns an appropriate error", func() {
@@ -240,20 +275,43 @@ var _ = Describe("download-droplet Command", func() {
 		})
 	})
 
-	When("there is an error downloading the droplet", func() {
+	When("there is an error getting the droplet", func() {
 		BeforeEach(func() {
-			fakeActor.DownloadCurrentDropletByAppNameReturns([]byte{}, "", v7action.Warnings{"some-warning"}, errors.New("something went wrong"))
+			fakeActor.GetCurrentDropletByAppNameReturns(resources.Droplet{}, v7action.Warnings{"some-warning"}, errors.New("something went wrong"))
 		})
 
 		It("displays warnings and returns an error", func() {
 			Expect(testUI.Err).To(Say("some-warning"))
 			Expect(executeErr).To(MatchError("something went wrong"))
+			Expect(fakeActor.DownloadDropletCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there is an error downloading the droplet", func() {
+		var filePath string
+
+		BeforeEach(func() {
+			filePath = RandomString("fake-file")
+			setFlag(&cmd, "--path", filePath)
+
+			fakeActor.GetCurrentDropletByAppNameReturns(resources.Droplet{GUID: "some-droplet-guid"}, nil, nil)
+			fakeActor.DownloadDropletStub = func(_ resources.Droplet, writer io.Writer, _ v7action.DownloadProgressBar) (v7action.Warnings, error) {
+				_, err := writer.Write([]byte("some-dro"))
+				Expect(err).ToNot(HaveOccurred())
+				return v7action.Warnings{"some-download-warning"}, actionerror.DownloadChecksumMismatchError{Type: "sha256"}
+			}
+		})
+
+		It("displays warnings, removes the partial file and returns the error", func() {
+			Expect(testUI.Err).To(Say("some-download-warning"))
+			Expect(executeErr).To(MatchError(actionerror.DownloadChecksumMismatchError{Type: "sha256"}))
+			Expect(filePath).ToNot(BeAnExistingFile())
 		})
 	})
 
 	When("the app does not have a current droplet", func() {
 		BeforeEach(func() {
-			fakeActor.DownloadCurrentDropletByAppNameReturns([]byte{}, "", v7action.Warnings{"some-warning"}, actionerror.DropletNotFoundError{})
+			fakeActor.GetCurrentDropletByAppNameReturns(resources.Droplet{}, v7action.Warnings{"some-warning"}, actionerror.DropletNotFoundError{})
 		})
 
 		It("displays warnings and returns an error", func() {
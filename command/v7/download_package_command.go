@@ -0,0 +1,148 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+type DownloadPackageCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	PackageGUID     string       `long:"package-guid" description:"The guid of the package to download (default: latest ready package)"`
+	Path            string       `long:"path" short:"p" description:"File path to download package to (default: current working directory)."`
+	usage           interface{}  `usage:"CF_NAME download-package APP_NAME [--package-guid PACKAGE_GUID] [--path /path/to/package.zip]"`
+	relatedCommands interface{}  `related_commands:"copy-source, create-package, download-droplet, packages"`
+
+	ProgressBar v7action.DownloadProgressBar
+}
+
+func (cmd *DownloadPackageCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.ProgressBar = progressbar.NewProgressBar()
+	return cmd.BaseCommand.Setup(config, ui)
+}
+
+func (cmd DownloadPackageCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var pkg resources.Package
+
+	if cmd.PackageGUID != "" {
+		cmd.UI.DisplayTextWithFlavor("Downloading package {{.PackageGUID}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"PackageGUID": cmd.PackageGUID,
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"Username":    user.Name,
+		})
+
+		var warnings v7action.Warnings
+		pkg, warnings, err = cmd.Actor.GetPackageByGUIDAndAppName(cmd.PackageGUID, cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return cmd.convertError(err)
+		}
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Downloading latest ready package for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+
+		app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		pkg, warnings, err = cmd.Actor.GetNewestReadyPackageForApplication(app)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return cmd.convertError(err)
+		}
+	}
+
+	pathToPackage, err := cmd.packagePath(pkg.GUID)
+	if err != nil {
+		return err
+	}
+
+	packageFile, err := os.OpenFile(pathToPackage, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
+	if err != nil {
+		return translatableerror.PackageFileError{Err: err}
+	}
+
+	warnings, err := cmd.Actor.DownloadPackage(pkg, packageFile, cmd.ProgressBar)
+	cmd.UI.DisplayWarnings(warnings)
+	closeErr := packageFile.Close()
+	if err != nil {
+		_ = os.Remove(pathToPackage)
+		return err
+	}
+	if closeErr != nil {
+		return translatableerror.PackageFileError{Err: closeErr}
+	}
+
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("Package downloaded successfully at {{.FilePath}}", map[string]interface{}{
+		"FilePath": pathToPackage,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd DownloadPackageCommand) packagePath(packageGUID string) (string, error) {
+	fileName := fmt.Sprintf("package_%s.zip", packageGUID)
+
+	if cmd.Path == "" {
+		currentDir, err := os.Getwd()
+		if err != nil {
+			return "", err
+		}
+
+		return filepath.Join(currentDir, fileName), nil
+	}
+
+	stats, err := os.Stat(cmd.Path)
+	if err == nil && stats.IsDir() {
+		return filepath.Join(cmd.Path, fileName), nil
+	}
+
+	return cmd.Path, nil
+}
+
+func (cmd DownloadPackageCommand) convertError(err error) error {
+	switch err.(type) {
+	case actionerror.PackageNotFoundInAppError:
+		return translatableerror.PackageNotFoundInAppError{
+			AppName:    cmd.RequiredArgs.AppName,
+			BinaryName: cmd.Config.BinaryName(),
+		}
+	case actionerror.NoEligiblePackagesError:
+		return translatableerror.NoEligiblePackagesError{
+			AppName:    cmd.RequiredArgs.AppName,
+			BinaryName: cmd.Config.BinaryName(),
+		}
+	}
+	return err
+}
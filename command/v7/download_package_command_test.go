@@ -0,0 +1,215 @@
+package v7_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("download-package Command", func() {
+	var (
+		cmd             DownloadPackageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeProgressBar *v7actionfakes.FakeDownloadProgressBar
+		binaryName      string
+		tmpDir          string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeProgressBar = new(v7actionfakes.FakeDownloadProgressBar)
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "packages")
+		Expect(err).NotTo(HaveOccurred())
+
+		cmd = DownloadPackageCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			ProgressBar: fakeProgressBar,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.Path = tmpDir
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			GUID: "some-space-guid",
+			Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(
+			configv3.User{Name: "some-user"},
+			nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid", Name: "some-app"}, v7action.Warnings{"get-app-warning"}, nil)
+		fakeActor.GetNewestReadyPackageForApplicationReturns(resources.Package{GUID: "some-package-guid"}, v7action.Warnings{"get-package-warning"}, nil)
+		fakeActor.DownloadPackageStub = func(_ resources.Package, writer io.Writer, _ v7action.DownloadProgressBar) (v7action.Warnings, error) {
+			_, err := writer.Write([]byte("some-package-bits"))
+			return v7action.Warnings{"download-warning"}, err
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error if the check fails", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("no package guid is passed in", func() {
+		It("downloads the newest ready package into the given directory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.GetNewestReadyPackageForApplicationCallCount()).To(Equal(1))
+			Expect(fakeActor.GetNewestReadyPackageForApplicationArgsForCall(0)).To(Equal(resources.Application{GUID: "some-app-guid", Name: "some-app"}))
+
+			Expect(fakeActor.DownloadPackageCallCount()).To(Equal(1))
+			pkg, _, progressBar := fakeActor.DownloadPackageArgsForCall(0)
+			Expect(pkg).To(Equal(resources.Package{GUID: "some-package-guid"}))
+			Expect(progressBar).To(Equal(fakeProgressBar))
+
+			fileContents, err := os.ReadFile(filepath.Join(tmpDir, "package_some-package-guid.zip"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(fileContents)).To(Equal("some-package-bits"))
+		})
+
+		It("displays the file it created and all warnings", func() {
+			Expect(testUI.Out).To(Say("Downloading latest ready package for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-package-warning"))
+			Expect(testUI.Err).To(Say("download-warning"))
+			pathRegExp := regexp.QuoteMeta(filepath.Join(tmpDir, "package_some-package-guid.zip"))
+			Expect(testUI.Out).To(Say(`Package downloaded successfully at %s`, pathRegExp))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("the app has no ready packages", func() {
+			BeforeEach(func() {
+				fakeActor.GetNewestReadyPackageForApplicationReturns(resources.Package{}, nil, actionerror.NoEligiblePackagesError{AppName: "some-app"})
+			})
+
+			It("returns a NoEligiblePackagesError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NoEligiblePackagesError{AppName: "some-app", BinaryName: "faceman"}))
+				Expect(fakeActor.DownloadPackageCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("a package guid is passed in", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--package-guid", "some-other-package-guid")
+			fakeActor.GetPackageByGUIDAndAppNameReturns(resources.Package{GUID: "some-other-package-guid"}, v7action.Warnings{"get-package-warning"}, nil)
+		})
+
+		It("downloads that package", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Downloading package some-other-package-guid for app some-app in org some-org / space some-space as some-user..."))
+
+			Expect(fakeActor.GetPackageByGUIDAndAppNameCallCount()).To(Equal(1))
+			packageGUID, appName, spaceGUID := fakeActor.GetPackageByGUIDAndAppNameArgsForCall(0)
+			Expect(packageGUID).To(Equal("some-other-package-guid"))
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(fakeActor.GetNewestReadyPackageForApplicationCallCount()).To(Equal(0))
+
+			Expect(filepath.Join(tmpDir, "package_some-other-package-guid.zip")).To(BeAnExistingFile())
+		})
+
+		When("the package does not belong to the app", func() {
+			BeforeEach(func() {
+				fakeActor.GetPackageByGUIDAndAppNameReturns(resources.Package{}, nil, actionerror.PackageNotFoundInAppError{GUID: "some-other-package-guid", AppName: "some-app"})
+			})
+
+			It("returns a PackageNotFoundInAppError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PackageNotFoundInAppError{AppName: "some-app", BinaryName: "faceman"}))
+			})
+		})
+	})
+
+	When("a path to a file is passed in", func() {
+		BeforeEach(func() {
+			cmd.Path = filepath.Join(tmpDir, "source.zip")
+		})
+
+		It("downloads the package to that file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			fileContents, err := os.ReadFile(filepath.Join(tmpDir, "source.zip"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(fileContents)).To(Equal("some-package-bits"))
+		})
+	})
+
+	When("a path to a file in an invalid directory is passed in", func() {
+		BeforeEach(func() {
+			cmd.Path = filepath.Join(tmpDir, "not", "exist", "source.zip")
+		})
+
+		It("returns a PackageFileError", func() {
+			_, ok := executeErr.(translatableerror.PackageFileError)
+			Expect(ok).To(BeTrue())
+			Expect(fakeActor.DownloadPackageCallCount()).To(Equal(0))
+		})
+	})
+
+	When("downloading the package fails", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadPackageReturns(v7action.Warnings{"download-warning"}, errors.New("download-error"))
+		})
+
+		It("displays warnings, removes the file and returns the error", func() {
+			Expect(executeErr).To(MatchError("download-error"))
+			Expect(testUI.Err).To(Say("download-warning"))
+			Expect(filepath.Join(tmpDir, "package_some-package-guid.zip")).ToNot(BeAnExistingFile())
+		})
+	})
+})
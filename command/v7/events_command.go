@@ -1,20 +1,44 @@
 package v7
 
 import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type EventsCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME events APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, logs, map-route, unmap-route"`
+	RequiredArgs    flag.OptionalAppName `positional-args:"yes"`
+	Space           bool                 `long:"space" description:"Show events for every resource in the targeted space"`
+	Org             bool                 `long:"org" description:"Show events for every resource in the targeted org"`
+	Foundation      bool                 `long:"foundation" description:"Show events for every resource visible to you"`
+	Types           []string             `long:"type" description:"Only show events of this type, such as audit.app.update. Can be repeated"`
+	ActorName       string               `long:"actor" description:"Only show events caused by the user or client with this name or GUID. Filtered after paging, so a page can have fewer events than --per-page"`
+	TargetType      string               `long:"target-type" description:"Only show events for resources of this type, such as app or service_instance. Filtered after paging, so a page can have fewer events than --per-page"`
+	Since           flag.LogTimestamp    `long:"since" description:"Only show events newer than a duration such as 24h, or an RFC3339 timestamp"`
+	Until           flag.LogTimestamp    `long:"until" description:"Only show events older than a duration such as 1h, or an RFC3339 timestamp"`
+	Page            flag.PositiveInteger `long:"page" description:"Page of events to show, most recent first (Default: 1)"`
+	PerPage         flag.PositiveInteger `long:"per-page" description:"Number of events on each page (Default: 50)"`
+	All             bool                 `long:"all" description:"Show every matching event instead of a single page"`
+	usage           interface{}          `usage:"CF_NAME events APP_NAME [FILTERS]\n   CF_NAME events (--space | --org | --foundation) [FILTERS]\n\nFILTERS:\n   [--type TYPE]... [--actor ACTOR] [--target-type TYPE] [--since TIME] [--until TIME] [--page N] [--per-page N] [--all]\n\nEXAMPLES:\n   CF_NAME events my-app\n   CF_NAME events --space --type audit.app.delete-request --since 24h\n   CF_NAME events --org --actor admin --all --output json"`
+	relatedCommands interface{}          `related_commands:"app, logs, map-route, unmap-route"`
 }
 
+func (EventsCommand) SupportsStructuredOutput() {}
+
 func (cmd EventsCommand) Execute(_ []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	err := cmd.validateArgs()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(!cmd.Foundation, cmd.RequiredArgs.AppName != "" || cmd.Space)
 	if err != nil {
 		return err
 	}
@@ -24,46 +48,132 @@ func (cmd EventsCommand) Execute(_ []string) error {
 		return err
 	}
 
-	appName := cmd.RequiredArgs.AppName
-	cmd.UI.DisplayTextWithFlavor("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"AppName":   appName,
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatDefault {
+		cmd.displayFlavorText(user.Name)
+	}
 
-	events, warnings, err := cmd.Actor.GetRecentEventsByApplicationNameAndSpace(
-		appName,
-		cmd.Config.TargetedSpace().GUID,
-	)
+	query := v7action.EventsQuery{
+		Types:      cmd.Types,
+		Actor:      cmd.ActorName,
+		TargetType: cmd.TargetType,
+		Since:      cmd.Since.Time,
+		Until:      cmd.Until.Time,
+		Page:       int(cmd.Page.Value),
+		PerPage:    int(cmd.PerPage.Value),
+	}
+	if query.Page == 0 && !cmd.All {
+		query.Page = 1
+	}
+
+	switch {
+	case cmd.RequiredArgs.AppName != "":
+		app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		query.TargetGUIDs = []string{app.GUID}
+	case cmd.Space:
+		query.SpaceGUID = cmd.Config.TargetedSpace().GUID
+	case cmd.Org:
+		query.OrganizationGUID = cmd.Config.TargetedOrganization().GUID
+	}
+
+	events, warnings, err := cmd.Actor.GetEvents(query)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
+	if outputFormat != configv3.OutputFormatDefault {
+		return shared.DisplayOutputList(cmd.UI, outputFormat, "events", shared.NewEventOutputs(events))
+	}
+
 	if len(events) == 0 {
 		cmd.UI.DisplayText("No events found.")
 	}
 
-	table := [][]string{
-		{
-			cmd.UI.TranslateText("time"),
-			cmd.UI.TranslateText("event"),
-			cmd.UI.TranslateText("actor"),
-			cmd.UI.TranslateText("description"),
-		},
+	withTarget := cmd.RequiredArgs.AppName == ""
+	header := []string{
+		cmd.UI.TranslateText("time"),
+		cmd.UI.TranslateText("event"),
+		cmd.UI.TranslateText("actor"),
+	}
+	if withTarget {
+		header = append(header, cmd.UI.TranslateText("target"))
 	}
+	header = append(header, cmd.UI.TranslateText("description"))
+	table := [][]string{header}
 
 	for _, event := range events {
-		table = append(table, []string{
+		row := []string{
 			event.Time.Local().Format("2006-01-02T15:04:05.00-0700"),
 			event.Type,
 			event.ActorName,
-			event.Description,
-		})
+		}
+		if withTarget {
+			row = append(row, strings.TrimSpace(event.TargetType+" "+event.TargetName))
+		}
+		row = append(row, event.Description)
+		table = append(table, row)
 	}
 
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 
 	return nil
 }
+
+func (cmd EventsCommand) validateArgs() error {
+	var scopes []string
+	if cmd.RequiredArgs.AppName != "" {
+		scopes = append(scopes, "APP_NAME")
+	}
+	if cmd.Space {
+		scopes = append(scopes, "--space")
+	}
+	if cmd.Org {
+		scopes = append(scopes, "--org")
+	}
+	if cmd.Foundation {
+		scopes = append(scopes, "--foundation")
+	}
+
+	switch {
+	case len(scopes) == 0:
+		return translatableerror.IncorrectUsageError{Message: "Provide APP_NAME or one of --space, --org or --foundation"}
+	case len(scopes) > 1:
+		return translatableerror.ArgumentCombinationError{Args: scopes}
+	case cmd.All && cmd.Page.Value != 0:
+		return translatableerror.ArgumentCombinationError{Args: []string{"--all", "--page"}}
+	}
+
+	return nil
+}
+
+func (cmd EventsCommand) displayFlavorText(username string) {
+	switch {
+	case cmd.RequiredArgs.AppName != "":
+		cmd.UI.DisplayTextWithFlavor("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  username,
+		})
+	case cmd.Space:
+		cmd.UI.DisplayTextWithFlavor("Getting events in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  username,
+		})
+	case cmd.Org:
+		cmd.UI.DisplayTextWithFlavor("Getting events in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  cmd.Config.TargetedOrganization().Name,
+			"Username": username,
+		})
+	default:
+		cmd.UI.DisplayTextWithFlavor("Getting events as {{.Username}}...", map[string]interface{}{
+			"Username": username,
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package v7_test
 
 import (
+	"encoding/json"
 	"errors"
 	"regexp"
 	"time"
@@ -11,8 +12,10 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 
@@ -42,7 +45,7 @@ var _ = Describe("events Command", func() {
 		fakeConfig.BinaryNameReturns(binaryName)
 
 		cmd = EventsCommand{
-			RequiredArgs: flag.AppName{AppName: "some-app"},
+			RequiredArgs: flag.OptionalAppName{AppName: "some-app"},
 			BaseCommand: BaseCommand{
 				UI:          testUI,
 				Config:      fakeConfig,
@@ -61,6 +64,7 @@ var _ = Describe("events Command", func() {
 		})
 
 		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{Name: "some-app", GUID: "some-app-guid"}, nil, nil)
 	})
 
 	JustBeforeEach(func() {
@@ -100,7 +104,7 @@ var _ = Describe("events Command", func() {
 
 		BeforeEach(func() {
 			expectedErr = ccerror.RequestError{}
-			fakeActor.GetRecentEventsByApplicationNameAndSpaceReturns(nil, v7action.Warnings{"warning-1", "warning-2"}, expectedErr)
+			fakeActor.GetEventsReturns(nil, v7action.Warnings{"warning-1", "warning-2"}, expectedErr)
 		})
 
 		It("returns the error and prints warnings", func() {
@@ -138,7 +142,7 @@ var _ = Describe("events Command", func() {
 				},
 			}
 
-			fakeActor.GetRecentEventsByApplicationNameAndSpaceReturns(events, v7action.Warnings{"warning-1", "warning-2"}, nil)
+			fakeActor.GetEventsReturns(events, v7action.Warnings{"warning-1", "warning-2"}, nil)
 		})
 
 		It("prints the events and outputs warnings", func() {
@@ -157,16 +161,22 @@ var _ = Describe("events Command", func() {
 			Expect(testUI.Err).To(Say("warning-1"))
 			Expect(testUI.Err).To(Say("warning-2"))
 
-			Expect(fakeActor.GetRecentEventsByApplicationNameAndSpaceCallCount()).To(Equal(1))
-			appName, spaceGUID := fakeActor.GetRecentEventsByApplicationNameAndSpaceArgsForCall(0)
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
 			Expect(appName).To(Equal("some-app"))
 			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.GetEventsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventsQuery{
+				TargetGUIDs: []string{"some-app-guid"},
+				Page:        1,
+			}))
 		})
 	})
 
 	When("getting the application events returns no events", func() {
 		BeforeEach(func() {
-			fakeActor.GetRecentEventsByApplicationNameAndSpaceReturns([]v7action.Event{}, v7action.Warnings{"warning-1", "warning-2"}, nil)
+			fakeActor.GetEventsReturns([]v7action.Event{}, v7action.Warnings{"warning-1", "warning-2"}, nil)
 		})
 
 		It("displays there are no events", func() {
@@ -179,4 +189,165 @@ var _ = Describe("events Command", func() {
 			Expect(testUI.Err).To(Say("warning-2"))
 		})
 	})
+
+	When("no scope is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+		})
+
+		It("returns a usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "Provide APP_NAME or one of --space, --org or --foundation"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("several scopes are provided", func() {
+		BeforeEach(func() {
+			cmd.Org = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--org"}}))
+		})
+	})
+
+	When("--all and --page are provided", func() {
+		BeforeEach(func() {
+			cmd.All = true
+			cmd.Page = flag.PositiveInteger{Value: 2}
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--all", "--page"}}))
+		})
+	})
+
+	When("filters and paging are provided", func() {
+		var since, until time.Time
+
+		BeforeEach(func() {
+			since = time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)
+			until = time.Date(2021, 3, 5, 0, 0, 0, 0, time.UTC)
+			cmd.Types = []string{"audit.app.update", "audit.app.restage"}
+			cmd.ActorName = "admin"
+			cmd.TargetType = "app"
+			cmd.Since = flag.LogTimestamp{Time: since}
+			cmd.Until = flag.LogTimestamp{Time: until}
+			cmd.Page = flag.PositiveInteger{Value: 3}
+			cmd.PerPage = flag.PositiveInteger{Value: 200}
+		})
+
+		It("passes them to the actor", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventsQuery{
+				TargetGUIDs: []string{"some-app-guid"},
+				Types:       []string{"audit.app.update", "audit.app.restage"},
+				Actor:       "admin",
+				TargetType:  "app",
+				Since:       since,
+				Until:       until,
+				Page:        3,
+				PerPage:     200,
+			}))
+		})
+	})
+
+	When("getting the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{}, v7action.Warnings{"app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(fakeActor.GetEventsCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("scopes other than an app", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+			fakeActor.GetEventsReturns([]v7action.Event{
+				{
+					Type:        "audit.service_instance.create",
+					ActorName:   "admin",
+					TargetType:  "service_instance",
+					TargetName:  "some-db",
+					Description: "name: some-db",
+				},
+			}, nil, nil)
+		})
+
+		When("--space is provided", func() {
+			BeforeEach(func() {
+				cmd.Space = true
+			})
+
+			It("lists the events of the targeted space with their targets", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+
+				Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventsQuery{SpaceGUID: "some-space-guid", Page: 1}))
+
+				Expect(testUI.Out).To(Say(`Getting events in org some-org / space some-space as steve\.\.\.`))
+				Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+target\s+description`))
+				Expect(testUI.Out).To(Say(`audit.service_instance.create\s+admin\s+service_instance some-db\s+name: some-db`))
+			})
+		})
+
+		When("--org is provided", func() {
+			BeforeEach(func() {
+				cmd.Org = true
+				cmd.All = true
+			})
+
+			It("lists every event of the targeted org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeFalse())
+
+				Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventsQuery{OrganizationGUID: "some-org-guid"}))
+				Expect(testUI.Out).To(Say(`Getting events in org some-org as steve\.\.\.`))
+			})
+		})
+
+		When("--foundation is provided", func() {
+			BeforeEach(func() {
+				cmd.Foundation = true
+			})
+
+			It("lists events without a scope", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeFalse())
+				Expect(checkTargetedSpace).To(BeFalse())
+
+				Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventsQuery{Page: 1}))
+				Expect(testUI.Out).To(Say(`Getting events as steve\.\.\.`))
+			})
+		})
+
+		When("the global --output flag is json", func() {
+			BeforeEach(func() {
+				cmd.Foundation = true
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			})
+
+			It("displays the events as a json document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				var document map[string]interface{}
+				Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &document)).To(Succeed())
+				Expect(document["kind"]).To(Equal("events"))
+				Expect(document["resources"]).To(ConsistOf(HaveKeyWithValue("target_name", "some-db")))
+			})
+		})
+	})
 })
@@ -0,0 +1,206 @@
+package v7
+
+import (
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/spacebundle"
+)
+
+type ExportSpaceCommand struct {
+	BaseCommand
+
+	Path            flag.Path   `long:"path" description:"Directory to write the manifest and space files to. If not specified, the files are created in the current working directory."`
+	Force           bool        `short:"f" long:"force" description:"Overwrite existing manifest and space files without asking for confirmation"`
+	usage           interface{} `usage:"CF_NAME export-space [--path DIR] [-f]\n\n   Writes manifest.yml with every app in the targeted space and space.yml with its service instances, user-provided services, routes, network policies and environment variable groups."`
+	relatedCommands interface{} `related_commands:"apply-manifest, create-app-manifest, network-policies, services"`
+
+	NetworkingActor NetworkPoliciesActor
+	PWD             string
+}
+
+func (cmd *ExportSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+
+	currentDir, err := os.Getwd()
+	cmd.PWD = currentDir
+
+	return err
+}
+
+func (cmd ExportSpaceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	dir := cmd.PWD
+	if len(cmd.Path) > 0 {
+		dir = cmd.Path.String()
+	}
+
+	if !cmd.Force {
+		overwrite, err := cmd.confirmOverwrite(dir)
+		if err != nil {
+			return err
+		}
+
+		if !overwrite {
+			cmd.UI.DisplayText("Space has not been exported.")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Exporting space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"Username":  user.Name,
+	})
+
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+	export, warnings, err := cmd.Actor.ExportSpace(spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	policies, networkingWarnings, err := cmd.NetworkingActor.NetworkPoliciesBySpace(spaceGUID)
+	cmd.UI.DisplayWarnings(networkingWarnings)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.spaceBundle(export, policies)
+
+	manifestPath, spacePath, err := spacebundle.Write(dir, export.Manifest, space)
+	if err != nil {
+		return translatableerror.FileCreationError{Err: err}
+	}
+
+	if len(space.UserProvidedServices) > 0 {
+		cmd.UI.DisplayWarning("Credentials of user-provided service instances are not exported.")
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Manifest file created successfully at {{.FilePath}}", map[string]interface{}{
+		"FilePath": manifestPath,
+	})
+	cmd.UI.DisplayText("Space file created successfully at {{.FilePath}}", map[string]interface{}{
+		"FilePath": spacePath,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// confirmOverwrite asks before replacing bundle files already in dir, such
+// as the manifest.yml of a project in the current directory.
+func (cmd ExportSpaceCommand) confirmOverwrite(dir string) (bool, error) {
+	existing, err := spacebundle.ExistingFiles(dir)
+	if err != nil {
+		return false, err
+	}
+
+	if len(existing) == 0 {
+		return true, nil
+	}
+
+	return cmd.UI.DisplayBoolPrompt(false, "Really overwrite {{.Files}}?", map[string]interface{}{
+		"Files": strings.Join(existing, ", "),
+	})
+}
+
+func (cmd ExportSpaceCommand) spaceBundle(export v7action.SpaceExport, policies []cfnetworkingaction.Policy) spacebundle.Space {
+	var space spacebundle.Space
+
+	for _, instance := range export.ServiceInstances {
+		if instance.Type == resources.UserProvidedServiceInstance {
+			space.UserProvidedServices = append(space.UserProvidedServices, spacebundle.UserProvidedService{
+				Name:            instance.Name,
+				Tags:            instance.Tags,
+				SyslogDrainURL:  instance.SyslogDrainURL,
+				RouteServiceURL: instance.RouteServiceURL,
+			})
+			continue
+		}
+
+		space.ServiceInstances = append(space.ServiceInstances, spacebundle.ServiceInstance{
+			Name:       instance.Name,
+			Offering:   instance.ServiceOfferingName,
+			Plan:       instance.ServicePlanName,
+			Broker:     instance.ServiceBrokerName,
+			Tags:       instance.Tags,
+			Parameters: instance.Parameters,
+		})
+	}
+
+	for _, route := range export.Routes {
+		space.Routes = append(space.Routes, spacebundle.Route{
+			Host:     route.Host,
+			Domain:   route.DomainName,
+			Path:     route.Path,
+			Port:     route.Port,
+			Protocol: route.Protocol,
+			Apps:     route.AppNames,
+		})
+	}
+
+	spaceName := cmd.Config.TargetedSpace().Name
+	orgName := cmd.Config.TargetedOrganization().Name
+	for _, policy := range policies {
+		networkPolicy := spacebundle.NetworkPolicy{
+			Source:      policy.SourceName,
+			Destination: policy.DestinationName,
+			Protocol:    policy.Protocol,
+			StartPort:   policy.StartPort,
+			EndPort:     policy.EndPort,
+		}
+		if policy.DestinationSpaceName != spaceName || policy.DestinationOrgName != orgName {
+			networkPolicy.DestinationSpace = policy.DestinationSpaceName
+			networkPolicy.DestinationOrg = policy.DestinationOrgName
+		}
+		space.NetworkPolicies = append(space.NetworkPolicies, networkPolicy)
+	}
+
+	space.EnvironmentVariableGroups = spacebundle.EnvironmentVariableGroups{
+		Running: environmentVariableValues(export.RunningEnvironmentVariables),
+		Staging: environmentVariableValues(export.StagingEnvironmentVariables),
+	}
+
+	return space
+}
+
+func environmentVariableValues(group v7action.EnvironmentVariableGroup) map[string]string {
+	if len(group) == 0 {
+		return nil
+	}
+
+	values := make(map[string]string, len(group))
+	for key, value := range group {
+		values[key] = value.Value
+	}
+	return values
+}
@@ -0,0 +1,255 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/spacebundle"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-space Command", func() {
+	var (
+		cmd                      ExportSpaceCommand
+		testUI                   *ui.UI
+		input                    *Buffer
+		fakeConfig               *commandfakes.FakeConfig
+		fakeSharedActor          *commandfakes.FakeSharedActor
+		fakeActor                *v7fakes.FakeActor
+		fakeNetworkPoliciesActor *v7fakes.FakeNetworkPoliciesActor
+		binaryName               string
+		exportDir                string
+		executeErr               error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeNetworkPoliciesActor = new(v7fakes.FakeNetworkPoliciesActor)
+
+		var err error
+		exportDir, err = os.MkdirTemp("", "export-space-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		cmd = ExportSpaceCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			NetworkingActor: fakeNetworkPoliciesActor,
+			PWD:             exportDir,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.ExportSpaceReturns(
+			v7action.SpaceExport{
+				Manifest: []byte("applications:\n- name: web\n"),
+				ServiceInstances: []v7action.ExportedServiceInstance{
+					{
+						Name:                "db",
+						Type:                resources.ManagedServiceInstance,
+						ServiceOfferingName: "postgres",
+						ServicePlanName:     "small",
+						Tags:                []string{"sql"},
+						Parameters:          v7action.ServiceInstanceParameters{"size": "small"},
+					},
+					{
+						Name:           "logs",
+						Type:           resources.UserProvidedServiceInstance,
+						SyslogDrainURL: "syslog://logs.example.com",
+					},
+				},
+				Routes: []v7action.ExportedRoute{
+					{Host: "web", DomainName: "example.com", Protocol: "http", AppNames: []string{"web"}},
+				},
+				RunningEnvironmentVariables: v7action.EnvironmentVariableGroup{
+					"RUNNING": types.FilteredString{Value: "yes", IsSet: true},
+				},
+			},
+			v7action.Warnings{"export-warning"},
+			nil,
+		)
+		fakeNetworkPoliciesActor.NetworkPoliciesBySpaceReturns(
+			[]cfnetworkingaction.Policy{
+				{SourceName: "web", DestinationName: "worker", DestinationSpaceName: "some-space", DestinationOrgName: "some-org", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+				{SourceName: "web", DestinationName: "billing", DestinationSpaceName: "other-space", DestinationOrgName: "some-org", Protocol: "tcp", StartPort: 9000, EndPort: 9010},
+			},
+			cfnetworkingaction.Warnings{"policies-warning"},
+			nil,
+		)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(exportDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+			Expect(fakeActor.ExportSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	It("writes the manifest and space files to the current directory", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Exporting space some-space in org some-org as some-user\.\.\.`))
+		Expect(testUI.Err).To(Say("export-warning"))
+		Expect(testUI.Err).To(Say("policies-warning"))
+		Expect(testUI.Err).To(Say("Credentials of user-provided service instances are not exported."))
+		Expect(testUI.Out).To(Say("Manifest file created successfully at %s", regexp.QuoteMeta(filepath.Join(exportDir, "manifest.yml"))))
+		Expect(testUI.Out).To(Say("Space file created successfully at %s", regexp.QuoteMeta(filepath.Join(exportDir, "space.yml"))))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(fakeActor.ExportSpaceArgsForCall(0)).To(Equal("some-space-guid"))
+		Expect(fakeNetworkPoliciesActor.NetworkPoliciesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+		manifest, err := os.ReadFile(filepath.Join(exportDir, "manifest.yml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(manifest)).To(Equal("applications:\n- name: web\n"))
+
+		space, err := spacebundle.ReadSpace(exportDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(space).To(Equal(spacebundle.Space{
+			ServiceInstances: []spacebundle.ServiceInstance{
+				{Name: "db", Offering: "postgres", Plan: "small", Tags: []string{"sql"}, Parameters: map[string]interface{}{"size": "small"}},
+			},
+			UserProvidedServices: []spacebundle.UserProvidedService{
+				{Name: "logs", SyslogDrainURL: "syslog://logs.example.com"},
+			},
+			Routes: []spacebundle.Route{
+				{Host: "web", Domain: "example.com", Protocol: "http", Apps: []string{"web"}},
+			},
+			NetworkPolicies: []spacebundle.NetworkPolicy{
+				{Source: "web", Destination: "worker", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+				{Source: "web", Destination: "billing", DestinationSpace: "other-space", DestinationOrg: "some-org", Protocol: "tcp", StartPort: 9000, EndPort: 9010},
+			},
+			EnvironmentVariableGroups: spacebundle.EnvironmentVariableGroups{
+				Running: map[string]string{"RUNNING": "yes"},
+			},
+		}))
+	})
+
+	When("--path is provided", func() {
+		BeforeEach(func() {
+			cmd.Path = flag.Path(filepath.Join(exportDir, "nested", "bundle"))
+		})
+
+		It("creates the directory and writes the files there", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(filepath.Join(exportDir, "nested", "bundle", "manifest.yml")).To(BeARegularFile())
+			Expect(filepath.Join(exportDir, "nested", "bundle", "space.yml")).To(BeARegularFile())
+		})
+	})
+
+	When("the directory already has a manifest", func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(filepath.Join(exportDir, "manifest.yml"), []byte("applications:\n- name: project\n"), 0644)).To(Succeed())
+		})
+
+		When("the user declines to overwrite it", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("keeps the manifest and does not export the space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Really overwrite %s\?`, regexp.QuoteMeta(filepath.Join(exportDir, "manifest.yml"))))
+				Expect(testUI.Out).To(Say("Space has not been exported."))
+				Expect(fakeActor.ExportSpaceCallCount()).To(Equal(0))
+
+				manifest, err := os.ReadFile(filepath.Join(exportDir, "manifest.yml"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(manifest)).To(Equal("applications:\n- name: project\n"))
+				Expect(filepath.Join(exportDir, "space.yml")).ToNot(BeAnExistingFile())
+			})
+		})
+
+		When("the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("overwrites the manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				manifest, err := os.ReadFile(filepath.Join(exportDir, "manifest.yml"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(manifest)).To(Equal("applications:\n- name: web\n"))
+			})
+		})
+
+		When("--force is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			It("overwrites the manifest without asking", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Really overwrite"))
+
+				manifest, err := os.ReadFile(filepath.Join(exportDir, "manifest.yml"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(manifest)).To(Equal("applications:\n- name: web\n"))
+			})
+		})
+	})
+
+	When("exporting the space fails", func() {
+		BeforeEach(func() {
+			fakeActor.ExportSpaceReturns(v7action.SpaceExport{}, v7action.Warnings{"export-warning"}, errors.New("export-error"))
+		})
+
+		It("returns the error without writing files", func() {
+			Expect(executeErr).To(MatchError("export-error"))
+			Expect(testUI.Err).To(Say("export-warning"))
+			Expect(filepath.Join(exportDir, "manifest.yml")).ToNot(BeAnExistingFile())
+		})
+	})
+
+	When("getting the network policies fails", func() {
+		BeforeEach(func() {
+			fakeNetworkPoliciesActor.NetworkPoliciesBySpaceReturns(nil, cfnetworkingaction.Warnings{"policies-warning"}, errors.New("policies-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("policies-error"))
+			Expect(testUI.Err).To(Say("policies-warning"))
+		})
+	})
+})
@@ -8,6 +8,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/browser"
 	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/clock"
 
@@ -38,6 +39,18 @@ func (a ActualActorReloader) Reload(config command.Config, ui command.UI) (Actor
 	return v7action.NewActor(ccClient, config, nil, uaaClient, routingClient, clock.NewClock()), nil
 }
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . BrowserOpener
+
+type BrowserOpener interface {
+	Open(url string) error
+}
+
+type ActualBrowserOpener struct{}
+
+func (b ActualBrowserOpener) Open(url string) error {
+	return browser.Open(url)
+}
+
 const maxLoginTries = 3
 
 type LoginCommand struct {
@@ -45,8 +58,11 @@ type LoginCommand struct {
 	Actor         Actor
 	Config        command.Config
 	ActorReloader ActorReloader
+	BrowserOpener BrowserOpener
 
 	APIEndpoint       string      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
+	Browser           bool        `long:"browser" description:"Open a browser to login"`
+	Device            bool        `long:"device" description:"Login with a user code entered in a browser on another device"`
 	Organization      string      `short:"o" description:"Org"`
 	Password          string      `short:"p" description:"Password"`
 	Space             string      `short:"s" description:"Space"`
@@ -55,7 +71,7 @@ type LoginCommand struct {
 	SSOPasscode       string      `long:"sso-passcode" description:"One-time passcode"`
 	Username          string      `short:"u" description:"Username"`
 	Origin            string      `long:"origin" description:"Indicates the identity provider to be used for login"`
-	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE] [--origin ORIGIN]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --origin ldap"`
+	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE | --browser | --device] [--origin ORIGIN]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --browser (CF_NAME will open a browser to login)\n   CF_NAME login --device (CF_NAME will provide a url and a code to login from another device)\n   CF_NAME login --origin ldap"`
 	relatedCommands   interface{} `related_commands:"api, auth, target"`
 }
 
@@ -63,6 +79,7 @@ func (cmd *LoginCommand) Setup(config command.Config, ui command.UI) error {
 	ccClient := shared.NewWrappedCloudControllerClient(config, ui)
 	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, nil, clock.NewClock())
 	cmd.ActorReloader = ActualActorReloader{}
+	cmd.BrowserOpener = ActualBrowserOpener{}
 
 	cmd.UI = ui
 	cmd.Config = config
@@ -121,7 +138,11 @@ func (cmd *LoginCommand) Execute(args []string) error {
 	defer cmd.showStatus()
 
 	var authErr error
-	if cmd.SSO || cmd.SSOPasscode != "" {
+	if cmd.Browser {
+		authErr = cmd.authenticateBrowser()
+	} else if cmd.Device {
+		authErr = cmd.authenticateDevice()
+	} else if cmd.SSO || cmd.SSOPasscode != "" {
 		authErr = cmd.authenticateSSO()
 	} else {
 		authErr = cmd.authenticate()
@@ -357,6 +378,68 @@ func (cmd *LoginCommand) authenticateSSO() error {
 	return err
 }
 
+func (cmd *LoginCommand) authenticateBrowser() error {
+	login, err := cmd.Actor.StartBrowserLogin(cmd.Origin)
+	if err != nil {
+		cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
+		return err
+	}
+
+	cmd.UI.DisplayText("Opening your browser to login. If it does not open, visit:")
+	cmd.UI.DisplayText(login.URL)
+	cmd.UI.DisplayNewline()
+
+	err = cmd.BrowserOpener.Open(login.URL)
+	if err != nil {
+		cmd.UI.DisplayWarning("Unable to open a browser: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+
+	cmd.UI.DisplayText("Waiting for the login to complete in your browser...")
+	err = cmd.Actor.FinishBrowserLogin(login)
+	if err != nil {
+		cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
+		cmd.UI.DisplayNewline()
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd *LoginCommand) authenticateDevice() error {
+	login, err := cmd.Actor.StartDeviceLogin()
+	if err != nil {
+		cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
+		return err
+	}
+
+	cmd.UI.DisplayText("To login, visit {{.URL}} in a browser and enter the code {{.UserCode}}", map[string]interface{}{
+		"URL":      login.VerificationURI,
+		"UserCode": login.UserCode,
+	})
+	if login.VerificationURIComplete != "" {
+		cmd.UI.DisplayText("or visit {{.URL}}", map[string]interface{}{
+			"URL": login.VerificationURIComplete,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("Waiting for the login to complete...")
+	err = cmd.Actor.FinishDeviceLogin(login)
+	if err != nil {
+		cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
+		cmd.UI.DisplayNewline()
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
 func (cmd *LoginCommand) groupPrompts(prompts map[string]coreconfig.AuthPrompt) (map[string]coreconfig.AuthPrompt, map[string]coreconfig.AuthPrompt) {
 	var (
 		nonPasswordPrompts = make(map[string]coreconfig.AuthPrompt)
@@ -577,6 +660,66 @@ func (cmd *LoginCommand) validateFlags() error {
 		}
 	}
 
+	if cmd.Browser && cmd.SSO {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--browser", "--sso"},
+		}
+	}
+
+	if cmd.Browser && cmd.SSOPasscode != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--browser", "--sso-passcode"},
+		}
+	}
+
+	if cmd.Browser && cmd.Username != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--browser", "-u"},
+		}
+	}
+
+	if cmd.Browser && cmd.Password != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--browser", "-p"},
+		}
+	}
+
+	if cmd.Device && cmd.SSO {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--device", "--sso"},
+		}
+	}
+
+	if cmd.Device && cmd.SSOPasscode != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--device", "--sso-passcode"},
+		}
+	}
+
+	if cmd.Device && cmd.Browser {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--device", "--browser"},
+		}
+	}
+
+	if cmd.Device && cmd.Origin != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--device", "--origin"},
+		}
+	}
+
+	if cmd.Device && cmd.Username != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--device", "-u"},
+		}
+	}
+
+	if cmd.Device && cmd.Password != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--device", "-p"},
+		}
+	}
+
 	return nil
 }
 
@@ -594,6 +737,12 @@ func (cmd *LoginCommand) validateTargetSpecificFlags() error {
 	if cmd.SSOPasscode != "" {
 		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--sso-passcode"}
 	}
+	if cmd.Browser {
+		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--browser"}
+	}
+	if cmd.Device {
+		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--device"}
+	}
 	if cmd.Username != "" {
 		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "-u"}
 	}
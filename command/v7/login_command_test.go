@@ -31,6 +31,7 @@ var _ = Describe("login Command", func() {
 		fakeActor         *v7fakes.FakeActor
 		fakeConfig        *commandfakes.FakeConfig
 		fakeActorReloader *v7fakes.FakeActorReloader
+		fakeBrowser       *v7fakes.FakeBrowserOpener
 		executeErr        error
 		input             *Buffer
 	)
@@ -41,6 +42,7 @@ var _ = Describe("login Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeActor = new(v7fakes.FakeActor)
 		fakeActorReloader = new(v7fakes.FakeActorReloader)
+		fakeBrowser = new(v7fakes.FakeBrowserOpener)
 
 		binaryName = "some-executable"
 		fakeConfig.BinaryNameReturns(binaryName)
@@ -52,6 +54,7 @@ var _ = Describe("login Command", func() {
 			Actor:         fakeActor,
 			Config:        fakeConfig,
 			ActorReloader: fakeActorReloader,
+			BrowserOpener: fakeBrowser,
 		}
 		cmd.APIEndpoint = ""
 
@@ -103,6 +106,58 @@ var _ = Describe("login Command", func() {
 			})
 		})
 
+		When("the --browser and the --sso flag are used together", func() {
+			BeforeEach(func() {
+				cmd.Browser = true
+				cmd.SSO = true
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--browser", "--sso"},
+				}))
+			})
+		})
+
+		When("the --browser and the -u flag are used together", func() {
+			BeforeEach(func() {
+				cmd.Browser = true
+				cmd.Username = "some-user"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--browser", "-u"},
+				}))
+			})
+		})
+
+		When("the --device and the --browser flag are used together", func() {
+			BeforeEach(func() {
+				cmd.Device = true
+				cmd.Browser = true
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--device", "--browser"},
+				}))
+			})
+		})
+
+		When("the --device and the --origin flag are used together", func() {
+			BeforeEach(func() {
+				cmd.Device = true
+				cmd.Origin = "some-origin"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--device", "--origin"},
+				}))
+			})
+		})
+
 		When("the user has manually added their client credentials", func() {
 			BeforeEach(func() {
 				fakeConfig.UAAOAuthClientReturns("some-other-client-id")
@@ -160,6 +215,26 @@ var _ = Describe("login Command", func() {
 				})
 			})
 
+			When("browser flag is provider", func() {
+				BeforeEach(func() {
+					cmd.Browser = true
+				})
+
+				It("returns unsupported flag error", func() {
+					Expect(executeErr).To(Equal(translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--browser"}))
+				})
+			})
+
+			When("device flag is provider", func() {
+				BeforeEach(func() {
+					cmd.Device = true
+				})
+
+				It("returns unsupported flag error", func() {
+					Expect(executeErr).To(Equal(translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--device"}))
+				})
+			})
+
 			When("username flag is provider", func() {
 				BeforeEach(func() {
 					cmd.Username = "my-user"
@@ -830,6 +905,120 @@ var _ = Describe("login Command", func() {
 		})
 	})
 
+	Describe("Browser", func() {
+		fakeAPI := "whatever.com"
+		BeforeEach(func() {
+			fakeConfig.TargetReturns(fakeAPI)
+			cmd.Browser = true
+			cmd.Origin = "some-origin"
+
+			fakeActor.StartBrowserLoginReturns(v7action.BrowserLogin{URL: "https://login.example.com/oauth/authorize?some=query"}, nil)
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "potatoface"}, nil)
+		})
+
+		It("opens the authorization URL in a browser and waits for the login", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.StartBrowserLoginCallCount()).To(Equal(1))
+			Expect(fakeActor.StartBrowserLoginArgsForCall(0)).To(Equal("some-origin"))
+			Expect(testUI.Out).To(Say("Opening your browser to login. If it does not open, visit:"))
+			Expect(testUI.Out).To(Say(`https://login\.example\.com/oauth/authorize\?some=query`))
+
+			Expect(fakeBrowser.OpenCallCount()).To(Equal(1))
+			Expect(fakeBrowser.OpenArgsForCall(0)).To(Equal("https://login.example.com/oauth/authorize?some=query"))
+
+			Expect(fakeActor.FinishBrowserLoginCallCount()).To(Equal(1))
+			Expect(fakeActor.FinishBrowserLoginArgsForCall(0).URL).To(Equal("https://login.example.com/oauth/authorize?some=query"))
+			Expect(fakeActor.GetLoginPromptsCallCount()).To(Equal(0))
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`user:\s+potatoface`))
+		})
+
+		When("the browser cannot be opened", func() {
+			BeforeEach(func() {
+				fakeBrowser.OpenReturns(errors.New("no browser"))
+			})
+
+			It("warns and still waits for the login", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Err).To(Say("Unable to open a browser: no browser"))
+				Expect(fakeActor.FinishBrowserLoginCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the login fails", func() {
+			BeforeEach(func() {
+				fakeActor.FinishBrowserLoginReturns(actionerror.BrowserLoginError{Reason: "access_denied"})
+				fakeActor.GetCurrentUserReturns(configv3.User{}, nil)
+			})
+
+			It("displays the reason and returns an error", func() {
+				Expect(testUI.Err).To(Say("Browser login failed: access_denied"))
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(fakeConfig.WriteConfigCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("Device", func() {
+		fakeAPI := "whatever.com"
+		BeforeEach(func() {
+			fakeConfig.TargetReturns(fakeAPI)
+			cmd.Device = true
+
+			fakeActor.StartDeviceLoginReturns(v7action.DeviceLogin{
+				UserCode:                "ABCD-EFGH",
+				VerificationURI:         "https://login.example.com/device",
+				VerificationURIComplete: "https://login.example.com/device?user_code=ABCD-EFGH",
+			}, nil)
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "potatoface"}, nil)
+		})
+
+		It("displays the user code and waits for the login", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`To login, visit https://login\.example\.com/device in a browser and enter the code ABCD-EFGH`))
+			Expect(testUI.Out).To(Say(`or visit https://login\.example\.com/device\?user_code=ABCD-EFGH`))
+			Expect(testUI.Out).To(Say(`Waiting for the login to complete\.\.\.`))
+
+			Expect(fakeActor.FinishDeviceLoginCallCount()).To(Equal(1))
+			Expect(fakeActor.FinishDeviceLoginArgsForCall(0).UserCode).To(Equal("ABCD-EFGH"))
+			Expect(fakeActor.GetLoginPromptsCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`user:\s+potatoface`))
+			Expect(fakeConfig.WriteConfigCallCount()).To(Equal(1))
+		})
+
+		When("the device authorization cannot be started", func() {
+			BeforeEach(func() {
+				fakeActor.StartDeviceLoginReturns(v7action.DeviceLogin{}, errors.New("device flow unavailable"))
+				fakeActor.GetCurrentUserReturns(configv3.User{}, nil)
+			})
+
+			It("displays the error and returns an error", func() {
+				Expect(testUI.Err).To(Say("device flow unavailable"))
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(fakeActor.FinishDeviceLoginCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the login expires", func() {
+			BeforeEach(func() {
+				fakeActor.FinishDeviceLoginReturns(actionerror.DeviceLoginError{Reason: "the user code expired"})
+				fakeActor.GetCurrentUserReturns(configv3.User{}, nil)
+			})
+
+			It("displays the reason and returns an error", func() {
+				Expect(testUI.Err).To(Say("Device login failed: the user code expired"))
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(fakeConfig.WriteConfigCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("Config", func() {
 		When("a user has successfully authenticated", func() {
 			BeforeEach(func() {
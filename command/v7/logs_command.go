@@ -3,6 +3,8 @@ package v7
 import (
 	"os"
 	"os/signal"
+	"regexp"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -11,15 +13,28 @@ import (
 	"code.cloudfoundry.org/cli/api/logcache"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type LogsCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	OptionalArgs    flag.OptionalAppNames `positional-args:"yes"`
+	LabelSelector   string                `long:"label-selector" description:"Show the logs of every app matching the label selector"`
+	Recent          bool                  `long:"recent" description:"Dump recent logs instead of tailing"`
+	Since           flag.LogTimestamp     `long:"since" description:"Only show logs newer than a duration such as 10m, or an RFC3339 timestamp"`
+	Until           flag.LogTimestamp     `long:"until" description:"Only show logs older than a duration such as 10m, or an RFC3339 timestamp"`
+	SourceTypes     []flag.LogSourceType  `long:"source-type" description:"Only show logs from the given source type: API, APP, APP/PROC, CELL, RTR or STG (can be specified multiple times)"`
+	Instance        types.NullInt         `long:"instance" description:"Only show logs from the given instance index"`
+	ProcessType     string                `long:"process" description:"Only show logs from processes of the given type"`
+	Grep            string                `long:"grep" description:"Only show logs whose message matches the given regular expression"`
+	Format          flag.LogFormat        `long:"format" description:"Print each log as a structured record: json, ndjson or logfmt"`
+	Limit           flag.PositiveInteger  `long:"limit" description:"Maximum number of logs to show (default 1000 with --recent)"`
+	usage           interface{}           `usage:"CF_NAME logs (APP_NAME... | --label-selector SELECTOR) [--recent] [--since TIME] [--until TIME] [--source-type TYPE] [--instance INDEX] [--process TYPE] [--grep REGEX] [--limit N] [--format FORMAT]\n\nEXAMPLES:\n   CF_NAME logs my-app --recent --since 15m --source-type RTR\n   CF_NAME logs my-app --process worker --instance 2 --grep 'timeout|refused'\n   CF_NAME logs frontend backend\n   CF_NAME logs --label-selector team=payments --recent\n   CF_NAME logs my-app --recent --format ndjson | jq .message"`
+	relatedCommands interface{}           `related_commands:"app, apps, ssh"`
 
 	LogCacheClient sharedaction.LogCacheClient
 }
@@ -35,7 +50,20 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd LogsCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	appNames := cmd.OptionalArgs.AppNames
+	switch {
+	case len(appNames) > 0 && cmd.LabelSelector != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--label-selector"}}
+	case len(appNames) == 0 && cmd.LabelSelector == "":
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	}
+
+	filter, err := cmd.logFilter()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
@@ -45,17 +73,36 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-		map[string]interface{}{
-			"AppName":   cmd.RequiredArgs.AppName,
-			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	multipleApps := len(appNames) > 1 || cmd.LabelSelector != ""
+	templateValues := map[string]interface{}{
+		"AppName":       strings.Join(appNames, ", "),
+		"LabelSelector": cmd.LabelSelector,
+		"OrgName":       cmd.Config.TargetedOrganization().Name,
+		"SpaceName":     cmd.Config.TargetedSpace().Name,
+		"Username":      user.Name,
+	}
+	printer := logPrinter{UI: cmd.UI, Prefixed: multipleApps}
+	if cmd.Format.Format != "" {
+		printer.Writer = shared.NewStructuredLogWriter(cmd.UI.GetOut(), cmd.Format.Format)
+	} else {
+		switch {
+		case cmd.LabelSelector != "":
+			cmd.UI.DisplayTextWithFlavor("Retrieving logs for apps matching {{.LabelSelector}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+		case multipleApps:
+			cmd.UI.DisplayTextWithFlavor("Retrieving logs for apps {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+		default:
+			cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+		}
+		cmd.UI.DisplayNewline()
+	}
 
 	if cmd.Recent {
-		return cmd.displayRecentLogs()
+		if multipleApps {
+			err = cmd.displayRecentLogsForApps(filter, printer)
+		} else {
+			err = cmd.displayRecentLogs(filter, printer)
+		}
+		return printer.close(err)
 	}
 
 	stop := make(chan struct{})
@@ -66,24 +113,83 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
-	err = cmd.streamLogs()
+	if multipleApps {
+		err = cmd.streamLogsForApps(filter, printer)
+	} else {
+		err = cmd.streamLogs(filter, printer)
+	}
 
 	close(stop)
 	<-stoppedRefreshing
 	<-stoppedOutputtingRefreshErrors
 
+	return printer.close(err)
+}
+
+func (cmd LogsCommand) logFilter() (sharedaction.LogFilter, error) {
+	filter := sharedaction.LogFilter{
+		Since:       cmd.Since.Time,
+		Until:       cmd.Until.Time,
+		Instance:    cmd.Instance.Value,
+		InstanceSet: cmd.Instance.IsSet,
+		ProcessType: cmd.ProcessType,
+		Limit:       int(cmd.Limit.Value),
+	}
+
+	if cmd.Since.IsSet() && cmd.Until.IsSet() && !cmd.Since.Time.Before(cmd.Until.Time) {
+		return sharedaction.LogFilter{}, translatableerror.IncorrectUsageError{Message: "--since must be earlier than --until"}
+	}
+
+	if cmd.Instance.IsSet && cmd.Instance.Value < 0 {
+		return sharedaction.LogFilter{}, translatableerror.IncorrectUsageError{Message: "--instance must be greater than or equal to 0"}
+	}
+
+	for _, sourceType := range cmd.SourceTypes {
+		filter.SourceTypes = append(filter.SourceTypes, string(sourceType))
+	}
+
+	if cmd.Grep != "" {
+		pattern, err := regexp.Compile(cmd.Grep)
+		if err != nil {
+			return sharedaction.LogFilter{}, translatableerror.IncorrectUsageError{Message: "--grep must be a valid regular expression: " + err.Error()}
+		}
+		filter.Pattern = pattern
+	}
+
+	return filter, nil
+}
+
+func (cmd LogsCommand) displayRecentLogs(filter sharedaction.LogFilter, printer logPrinter) error {
+	messages, warnings, err := cmd.Actor.GetFilteredRecentLogsForApplicationByNameAndSpace(
+		cmd.OptionalArgs.AppNames[0],
+		cmd.Config.TargetedSpace().GUID,
+		cmd.LogCacheClient,
+		filter,
+	)
+
+	for _, message := range messages {
+		if printErr := printer.print(cmd.OptionalArgs.AppNames[0], message); printErr != nil {
+			return printErr
+		}
+	}
+
+	cmd.UI.DisplayWarnings(warnings)
 	return err
 }
 
-func (cmd LogsCommand) displayRecentLogs() error {
-	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
+func (cmd LogsCommand) displayRecentLogsForApps(filter sharedaction.LogFilter, printer logPrinter) error {
+	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationsInSpace(
+		cmd.OptionalArgs.AppNames,
+		cmd.LabelSelector,
 		cmd.Config.TargetedSpace().GUID,
 		cmd.LogCacheClient,
+		filter,
 	)
 
 	for _, message := range messages {
-		cmd.UI.DisplayLogMessage(message, true)
+		if printErr := printer.print(message.AppName, message); printErr != nil {
+			return printErr
+		}
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -127,11 +233,58 @@ func (cmd LogsCommand) handleLogErr(logErr error) {
 	}
 }
 
-func (cmd LogsCommand) streamLogs() error {
-	messages, logErrs, stopStreaming, warnings, err := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
+func (cmd LogsCommand) streamLogs(filter sharedaction.LogFilter, printer logPrinter) error {
+	messages, logErrs, stopStreaming, warnings, err := cmd.Actor.GetFilteredStreamingLogsForApplicationByNameAndSpace(
+		cmd.OptionalArgs.AppNames[0],
+		cmd.Config.TargetedSpace().GUID,
+		cmd.LogCacheClient,
+		filter,
+	)
+
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt)
+
+	defer stopStreaming()
+	var messagesClosed, errLogsClosed bool
+	for {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				messagesClosed = true
+				break
+			}
+			if err := printer.print(cmd.OptionalArgs.AppNames[0], message); err != nil {
+				return err
+			}
+		case logErr, ok := <-logErrs:
+			if !ok {
+				errLogsClosed = true
+				break
+			}
+			cmd.handleLogErr(logErr)
+		case <-c:
+			return nil
+		}
+
+		if messagesClosed && errLogsClosed {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (cmd LogsCommand) streamLogsForApps(filter sharedaction.LogFilter, printer logPrinter) error {
+	messages, logErrs, stopStreaming, warnings, err := cmd.Actor.GetStreamingLogsForApplicationsInSpace(
+		cmd.OptionalArgs.AppNames,
+		cmd.LabelSelector,
 		cmd.Config.TargetedSpace().GUID,
 		cmd.LogCacheClient,
+		filter,
 	)
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -150,7 +303,9 @@ func (cmd LogsCommand) streamLogs() error {
 				messagesClosed = true
 				break
 			}
-			cmd.UI.DisplayLogMessage(message, true)
+			if err := printer.print(message.AppName, message); err != nil {
+				return err
+			}
 		case logErr, ok := <-logErrs:
 			if !ok {
 				errLogsClosed = true
@@ -168,3 +323,32 @@ func (cmd LogsCommand) streamLogs() error {
 
 	return nil
 }
+
+// logPrinter displays log messages in the human readable format, or as
+// structured records when Writer is set. Prefixed messages are preceded by
+// the name of the app that emitted them.
+type logPrinter struct {
+	UI       command.UI
+	Writer   *shared.StructuredLogWriter
+	Prefixed bool
+}
+
+func (printer logPrinter) print(appName string, message ui.LogMessage) error {
+	switch {
+	case printer.Writer != nil:
+		return printer.Writer.Write(appName, message)
+	case printer.Prefixed:
+		printer.UI.DisplayLogMessageWithPrefix("["+appName+"]", message, true)
+	default:
+		printer.UI.DisplayLogMessage(message, true)
+	}
+	return nil
+}
+
+// close finishes the structured output, unless displaying the logs failed.
+func (printer logPrinter) close(err error) error {
+	if err != nil || printer.Writer == nil {
+		return err
+	}
+	return printer.Writer.Close()
+}
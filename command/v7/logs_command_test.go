@@ -2,7 +2,9 @@ package v7_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"regexp"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -10,8 +12,11 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo/v2"
@@ -50,7 +55,7 @@ var _ = Describe("logs command", func() {
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
-		cmd.RequiredArgs.AppName = "some-app"
+		cmd.OptionalArgs.AppNames = []string{"some-app"}
 		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
 	})
 
@@ -58,6 +63,27 @@ var _ = Describe("logs command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	When("both app names and a label selector are provided", func() {
+		BeforeEach(func() {
+			cmd.LabelSelector = "team=payments"
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--label-selector"}}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("neither app names nor a label selector are provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppNames = nil
+		})
+
+		It("returns a required argument error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+		})
+	})
+
 	When("the checkTarget fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(
@@ -97,7 +123,7 @@ var _ = Describe("logs command", func() {
 				var expectedErr error
 				BeforeEach(func() {
 					expectedErr = errors.New("some-error")
-					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+					fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(
 						[]sharedaction.LogMessage{
 							*sharedaction.NewLogMessage(
 								"all your base are belong to us",
@@ -121,7 +147,7 @@ var _ = Describe("logs command", func() {
 
 			When("the logs actor returns logs", func() {
 				BeforeEach(func() {
-					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+					fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(
 						[]sharedaction.LogMessage{
 							*sharedaction.NewLogMessage(
 								"i am message 1",
@@ -150,12 +176,218 @@ var _ = Describe("logs command", func() {
 					Expect(testUI.Out).To(Say("i am message 1"))
 					Expect(testUI.Out).To(Say("i am message 2"))
 
-					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-					appName, spaceGUID, client := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					appName, spaceGUID, client, filter := fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
 
 					Expect(appName).To(Equal("some-app"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(client).To(Equal(logCacheClient))
+					Expect(filter).To(Equal(sharedaction.LogFilter{}))
+				})
+			})
+		})
+
+		When("logs for several apps are requested", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.AppNames = []string{"web", "worker"}
+			})
+
+			When("the --recent flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Recent = true
+					fakeActor.GetRecentLogsForApplicationsInSpaceReturns(
+						[]sharedaction.AppLogMessage{
+							{LogMessage: *sharedaction.NewLogMessage("web message", "OUT", time.Unix(0, 0), "APP/PROC/WEB", "0"), AppName: "web"},
+							{LogMessage: *sharedaction.NewLogMessage("worker message", "OUT", time.Unix(1, 0), "APP/PROC/WORKER", "0"), AppName: "worker"},
+						},
+						v7action.Warnings{"recent-warning"},
+						nil,
+					)
+				})
+
+				It("displays the merged logs with app name prefixes", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say(`Retrieving logs for apps web, worker in org some-org-name / space some-space-name as some-user\.\.\.`))
+					Expect(testUI.Out).To(Say(`\[web\] .* \[APP/PROC/WEB/0\] OUT web message`))
+					Expect(testUI.Out).To(Say(`\[worker\] .* \[APP/PROC/WORKER/0\] OUT worker message`))
+					Expect(testUI.Err).To(Say("recent-warning"))
+
+					Expect(fakeActor.GetRecentLogsForApplicationsInSpaceCallCount()).To(Equal(1))
+					appNames, labelSelector, spaceGUID, client, _ := fakeActor.GetRecentLogsForApplicationsInSpaceArgsForCall(0)
+					Expect(appNames).To(Equal([]string{"web", "worker"}))
+					Expect(labelSelector).To(BeEmpty())
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(client).To(Equal(logCacheClient))
+					Expect(fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			When("streaming the logs of apps matching a label selector", func() {
+				var stopped bool
+
+				BeforeEach(func() {
+					cmd.OptionalArgs.AppNames = nil
+					cmd.LabelSelector = "team=payments"
+					stopped = false
+
+					fakeActor.ScheduleTokenRefreshStub = func(
+						after func(time.Duration) <-chan time.Time,
+						stop chan struct{}, stoppedRefreshing chan struct{}) (<-chan error, error) {
+						go func() {
+							<-stop
+							close(stoppedRefreshing)
+						}()
+						return make(chan error), nil
+					}
+
+					fakeActor.GetStreamingLogsForApplicationsInSpaceStub = func(_ []string, _ string, _ string, _ sharedaction.LogCacheClient, _ sharedaction.LogFilter) (<-chan sharedaction.AppLogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+						logStream := make(chan sharedaction.AppLogMessage)
+						errorStream := make(chan error)
+						go func() {
+							logStream <- sharedaction.AppLogMessage{LogMessage: *sharedaction.NewLogMessage("payments message", "OUT", time.Now(), "APP/PROC/WEB", "0"), AppName: "payments"}
+							close(logStream)
+							close(errorStream)
+						}()
+						return logStream, errorStream, func() { stopped = true }, v7action.Warnings{"stream-warning"}, nil
+					}
+				})
+
+				It("streams the merged logs and shares a single token refresher", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say(`Retrieving logs for apps matching team=payments in org some-org-name / space some-space-name as some-user\.\.\.`))
+					Expect(testUI.Out).To(Say(`\[payments\] .* OUT payments message`))
+					Expect(testUI.Err).To(Say("stream-warning"))
+					Expect(stopped).To(BeTrue())
+
+					Expect(fakeActor.ScheduleTokenRefreshCallCount()).To(Equal(1))
+					appNames, labelSelector, _, _, _ := fakeActor.GetStreamingLogsForApplicationsInSpaceArgsForCall(0)
+					Expect(appNames).To(BeEmpty())
+					Expect(labelSelector).To(Equal("team=payments"))
+				})
+			})
+		})
+
+		When("--format is provided", func() {
+			BeforeEach(func() {
+				cmd.Format = flag.LogFormat{Format: "ndjson"}
+			})
+
+			When("the --recent flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Recent = true
+					fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(
+						[]sharedaction.LogMessage{
+							*sharedaction.NewLogMessage("i am message 1", "OUT", time.Unix(0, 0), "APP/PROC/WEB", "0"),
+						},
+						v7action.Warnings{"some-warning"},
+						nil,
+					)
+				})
+
+				It("prints structured records without the flavor text", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(
+						`{"timestamp":"1970-01-01T00:00:00Z","app":"some-app","source_type":"APP/PROC/WEB","instance":"0","stream":"OUT","message":"i am message 1"}` + "\n",
+					))
+					Expect(testUI.Err).To(Say("some-warning"))
+				})
+			})
+
+			When("streaming logs as a json array", func() {
+				BeforeEach(func() {
+					cmd.Format = flag.LogFormat{Format: "json"}
+					fakeActor.ScheduleTokenRefreshStub = func(
+						after func(time.Duration) <-chan time.Time,
+						stop chan struct{}, stoppedRefreshing chan struct{}) (<-chan error, error) {
+						go func() {
+							<-stop
+							close(stoppedRefreshing)
+						}()
+						return make(chan error), nil
+					}
+					fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ sharedaction.LogCacheClient, _ sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+						logStream := make(chan sharedaction.LogMessage)
+						errorStream := make(chan error)
+						go func() {
+							logStream <- *sharedaction.NewLogMessage("first", "OUT", time.Unix(0, 0), "APP/PROC/WEB", "0")
+							logStream <- *sharedaction.NewLogMessage("second", "ERR", time.Unix(1, 0), "APP/PROC/WEB", "0")
+							close(logStream)
+							close(errorStream)
+						}()
+						return logStream, errorStream, func() {}, nil, nil
+					}
+				})
+
+				It("closes the array once the stream ends", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					var records []map[string]string
+					Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &records)).To(Succeed())
+					Expect(records).To(HaveLen(2))
+					Expect(records[0]).To(HaveKeyWithValue("message", "first"))
+					Expect(records[1]).To(HaveKeyWithValue("stream", "ERR"))
+				})
+			})
+		})
+
+		When("filter flags are provided", func() {
+			BeforeEach(func() {
+				cmd.Recent = true
+				cmd.Since = flag.LogTimestamp{Time: time.Unix(100, 0)}
+				cmd.Until = flag.LogTimestamp{Time: time.Unix(200, 0)}
+				cmd.SourceTypes = []flag.LogSourceType{"APP/PROC", "RTR"}
+				cmd.Instance = types.NullInt{Value: 2, IsSet: true}
+				cmd.ProcessType = "worker"
+				cmd.Grep = "time(out)?"
+				cmd.Limit = flag.PositiveInteger{Value: 50}
+			})
+
+			It("passes the filter to the actor", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				_, _, _, filter := fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(filter).To(Equal(sharedaction.LogFilter{
+					Since:       time.Unix(100, 0),
+					Until:       time.Unix(200, 0),
+					SourceTypes: []string{"APP/PROC", "RTR"},
+					Instance:    2,
+					InstanceSet: true,
+					ProcessType: "worker",
+					Pattern:     regexp.MustCompile("time(out)?"),
+					Limit:       50,
+				}))
+			})
+
+			When("--since is not earlier than --until", func() {
+				BeforeEach(func() {
+					cmd.Since = flag.LogTimestamp{Time: time.Unix(300, 0)}
+				})
+
+				It("returns an incorrect usage error", func() {
+					Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--since must be earlier than --until"}))
+					Expect(fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			When("--instance is negative", func() {
+				BeforeEach(func() {
+					cmd.Instance = types.NullInt{Value: -1, IsSet: true}
+				})
+
+				It("returns an incorrect usage error", func() {
+					Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--instance must be greater than or equal to 0"}))
+				})
+			})
+
+			When("--grep is not a valid regular expression", func() {
+				BeforeEach(func() {
+					cmd.Grep = "time(out"
+				})
+
+				It("returns an incorrect usage error", func() {
+					Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--grep must be a valid regular expression: error parsing regexp: missing closing ): `time(out`"}))
+					Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
 				})
 			})
 		})
@@ -180,7 +412,7 @@ var _ = Describe("logs command", func() {
 
 				BeforeEach(func() {
 					expectedErr = errors.New("some-error")
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceReturns(nil,
+					fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceReturns(nil,
 						nil,
 						nil,
 						v7action.Warnings{"some-warning-1",
@@ -204,8 +436,8 @@ var _ = Describe("logs command", func() {
 				BeforeEach(func() {
 					expectedErr = errors.New("banana")
 
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub =
-						func(appName string, spaceGUID string, client sharedaction.LogCacheClient) (
+					fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub =
+						func(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (
 							<-chan sharedaction.LogMessage,
 							<-chan error,
 							context.CancelFunc,
@@ -244,7 +476,7 @@ var _ = Describe("logs command", func() {
 					})
 					It("displays the errors", func() {
 						Expect(executeErr).To(MatchError("firs swimming"))
-						Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+						Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
 					})
 				})
 
@@ -257,8 +489,8 @@ var _ = Describe("logs command", func() {
 
 			When("the logs actor returns logs", func() {
 				BeforeEach(func() {
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub =
-						func(_ string, _ string, _ sharedaction.LogCacheClient) (
+					fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub =
+						func(_ string, _ string, _ sharedaction.LogCacheClient, _ sharedaction.LogFilter) (
 							<-chan sharedaction.LogMessage,
 							<-chan error, context.CancelFunc,
 							v7action.Warnings,
@@ -290,12 +522,13 @@ var _ = Describe("logs command", func() {
 					Expect(testUI.Out).To(Say("Here are some staging logs!"))
 					Expect(testUI.Out).To(Say("Here are some other staging logs!"))
 
-					Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-					appName, spaceGUID, client := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					appName, spaceGUID, client, filter := fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
 
 					Expect(appName).To(Equal("some-app"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(client).To(Equal(logCacheClient))
+					Expect(filter).To(Equal(sharedaction.LogFilter{}))
 				})
 
 				When("scheduling a token refresh errors immediately", func() {
@@ -305,15 +538,15 @@ var _ = Describe("logs command", func() {
 					})
 					It("displays the errors", func() {
 						Expect(executeErr).To(MatchError("fjords pining"))
-						Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+						Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
 					})
 				})
 
 				When("there is an error refreshing a token sometime later", func() {
 					BeforeEach(func() {
 						cmd.Recent = false
-						fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub =
-							func(_ string, _ string, _ sharedaction.LogCacheClient) (
+						fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub =
+							func(_ string, _ string, _ sharedaction.LogCacheClient, _ sharedaction.LogFilter) (
 								<-chan sharedaction.LogMessage,
 								<-chan error, context.CancelFunc,
 								v7action.Warnings,
@@ -345,7 +578,7 @@ var _ = Describe("logs command", func() {
 					})
 					It("displays the errors", func() {
 						Expect(executeErr).NotTo(HaveOccurred())
-						Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+						Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
 						Expect(testUI.Err).To(Say("fjords pining"))
 					})
 				})
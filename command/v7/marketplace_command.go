@@ -6,7 +6,9 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
@@ -21,6 +23,8 @@ type MarketplaceCommand struct {
 	relatedCommands     interface{} `related_commands:"create-service, services"`
 }
 
+func (MarketplaceCommand) SupportsStructuredOutput() {}
+
 func (cmd MarketplaceCommand) Execute(args []string) error {
 	var username string
 
@@ -38,7 +42,10 @@ func (cmd MarketplaceCommand) Execute(args []string) error {
 		filter.SpaceGUID = cmd.Config.TargetedSpace().GUID
 	}
 
-	cmd.displayMessage(username)
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatDefault {
+		cmd.displayMessage(username)
+	}
 
 	offerings, warnings, err := cmd.BaseCommand.Actor.Marketplace(filter)
 	cmd.UI.DisplayWarnings(warnings)
@@ -46,6 +53,10 @@ func (cmd MarketplaceCommand) Execute(args []string) error {
 		return err
 	}
 
+	if outputFormat != configv3.OutputFormatDefault {
+		return shared.DisplayOutputList(cmd.UI, outputFormat, "service_offerings", shared.NewServiceOfferingOutputs(offerings, !cmd.NoPlans))
+	}
+
 	if len(offerings) == 0 {
 		cmd.UI.DisplayNewline()
 		cmd.UI.DisplayText("No service offerings found.")
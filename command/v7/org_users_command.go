@@ -4,7 +4,9 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type OrgUsersCommand struct {
@@ -16,6 +18,8 @@ type OrgUsersCommand struct {
 	relatedCommands interface{}       `related_commands:"orgs, set-org-role"`
 }
 
+func (OrgUsersCommand) SupportsStructuredOutput() {}
+
 func (cmd *OrgUsersCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -27,11 +31,14 @@ func (cmd *OrgUsersCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting users in org {{.Org}} as {{.CurrentUser}}...", map[string]interface{}{
-		"Org":         cmd.RequiredArgs.Organization,
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatDefault {
+		cmd.UI.DisplayTextWithFlavor("Getting users in org {{.Org}} as {{.CurrentUser}}...", map[string]interface{}{
+			"Org":         cmd.RequiredArgs.Organization,
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.RequiredArgs.Organization)
 	cmd.UI.DisplayWarnings(warnings)
@@ -45,6 +52,13 @@ func (cmd *OrgUsersCommand) Execute(args []string) error {
 		return err
 	}
 
+	if outputFormat != configv3.OutputFormatDefault {
+		if !cmd.AllUsers {
+			delete(orgUsersByRoleType, constant.OrgUserRole)
+		}
+		return shared.DisplayOutputList(cmd.UI, outputFormat, "org_users", shared.NewUserOutputs(orgUsersByRoleType))
+	}
+
 	cmd.displayOrgUsers(orgUsersByRoleType)
 
 	return nil
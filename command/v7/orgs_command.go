@@ -1,7 +1,9 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
@@ -13,6 +15,8 @@ type OrgsCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter orgs by labels"`
 }
 
+func (OrgsCommand) SupportsStructuredOutput() {}
+
 func (cmd OrgsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -24,10 +28,13 @@ func (cmd OrgsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting orgs as {{.CurrentUser}}...", map[string]interface{}{
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatDefault {
+		cmd.UI.DisplayTextWithFlavor("Getting orgs as {{.CurrentUser}}...", map[string]interface{}{
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	orgs, warnings, err := cmd.Actor.GetOrganizations(cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
@@ -35,6 +42,10 @@ func (cmd OrgsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if outputFormat != configv3.OutputFormatDefault {
+		return shared.DisplayOutputList(cmd.UI, outputFormat, "orgs", shared.NewOrgOutputs(orgs))
+	}
+
 	if len(orgs) == 0 {
 		cmd.UI.DisplayText("No orgs found.")
 	} else {
@@ -0,0 +1,84 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ProfileCommand struct {
+	UI              command.UI
+	Config          command.Config
+	RequiredArgs    flag.ProfileArgs `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME profile create NAME\n   CF_NAME profile use NAME\n\nEach profile stores its own API endpoint, login and targeted org and space. Use the global --profile flag to run a single command against another profile.\n\nEXAMPLES:\n   CF_NAME profile create staging\n   CF_NAME profile use staging\n   CF_NAME --profile prod apps"`
+	relatedCommands interface{}      `related_commands:"api, login, profiles, target"`
+}
+
+func (cmd *ProfileCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd ProfileCommand) Execute(args []string) error {
+	switch cmd.RequiredArgs.Action.Action {
+	case flag.ProfileActionCreate:
+		return cmd.createProfile()
+	default:
+		return cmd.useProfile()
+	}
+}
+
+func (cmd ProfileCommand) createProfile() error {
+	cmd.UI.DisplayTextWithFlavor("Creating profile {{.Name}}...", map[string]interface{}{
+		"Name": cmd.RequiredArgs.Name,
+	})
+
+	err := cmd.Config.CreateProfile(cmd.RequiredArgs.Name)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use '{{.BinaryName}} profile use {{.Name}}' to switch to this profile.", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+		"Name":       cmd.RequiredArgs.Name,
+	})
+	return nil
+}
+
+func (cmd ProfileCommand) useProfile() error {
+	cmd.UI.DisplayTextWithFlavor("Switching to profile {{.Name}}...", map[string]interface{}{
+		"Name": cmd.RequiredArgs.Name,
+	})
+
+	err := cmd.Config.UseProfile(cmd.RequiredArgs.Name)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{cmd.UI.TranslateText("profile:"), cmd.RequiredArgs.Name},
+		{cmd.UI.TranslateText("API endpoint:"), cmd.Config.Target()},
+	}
+	if cmd.Config.HasTargetedOrganization() {
+		table = append(table, []string{cmd.UI.TranslateText("org:"), cmd.Config.TargetedOrganization().Name})
+	}
+	if cmd.Config.HasTargetedSpace() {
+		table = append(table, []string{cmd.UI.TranslateText("space:"), cmd.Config.TargetedSpace().Name})
+	}
+	cmd.UI.DisplayKeyValueTable("", table, ui.DefaultTableSpacePadding)
+
+	if cmd.Config.Target() == "" {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("TIP: Use '{{.BinaryName}} login -a API_URL' to log in to this profile.", map[string]interface{}{
+			"BinaryName": cmd.Config.BinaryName(),
+		})
+	}
+	return nil
+}
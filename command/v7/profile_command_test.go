@@ -0,0 +1,119 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("profile Command", func() {
+	var (
+		cmd        v7.ProfileCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		cmd = v7.ProfileCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.Name = "prod"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("creating a profile", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Action = flag.ProfileAction{Action: flag.ProfileActionCreate}
+		})
+
+		It("creates the profile and displays how to use it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.CreateProfileCallCount()).To(Equal(1))
+			Expect(fakeConfig.CreateProfileArgsForCall(0)).To(Equal("prod"))
+			Expect(fakeConfig.UseProfileCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say(`Creating profile prod\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`TIP: Use 'faceman profile use prod' to switch to this profile\.`))
+		})
+
+		When("the profile already exists", func() {
+			BeforeEach(func() {
+				fakeConfig.CreateProfileReturns(translatableerror.ProfileAlreadyExistsError{Name: "prod"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ProfileAlreadyExistsError{Name: "prod"}))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+
+	When("using a profile", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Action = flag.ProfileAction{Action: flag.ProfileActionUse}
+
+			fakeConfig.TargetReturns("https://api.prod.example.com")
+			fakeConfig.HasTargetedOrganizationReturns(true)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "prod-org"})
+			fakeConfig.HasTargetedSpaceReturns(true)
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "prod-space"})
+		})
+
+		It("switches to the profile and displays its target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.UseProfileCallCount()).To(Equal(1))
+			Expect(fakeConfig.UseProfileArgsForCall(0)).To(Equal("prod"))
+
+			Expect(testUI.Out).To(Say(`Switching to profile prod\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`profile:\s+prod`))
+			Expect(testUI.Out).To(Say(`API endpoint:\s+https://api.prod.example.com`))
+			Expect(testUI.Out).To(Say(`org:\s+prod-org`))
+			Expect(testUI.Out).To(Say(`space:\s+prod-space`))
+			Expect(testUI.Out).ToNot(Say("TIP"))
+		})
+
+		When("the profile has no API endpoint", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetReturns("")
+				fakeConfig.HasTargetedOrganizationReturns(false)
+				fakeConfig.HasTargetedSpaceReturns(false)
+			})
+
+			It("displays how to log in", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("org:"))
+				Expect(testUI.Out).To(Say(`TIP: Use 'faceman login -a API_URL' to log in to this profile\.`))
+			})
+		})
+
+		When("the profile does not exist", func() {
+			BeforeEach(func() {
+				fakeConfig.UseProfileReturns(translatableerror.ProfileNotFoundError{Name: "prod"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ProfileNotFoundError{Name: "prod"}))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
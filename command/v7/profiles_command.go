@@ -0,0 +1,54 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ProfilesCommand struct {
+	UI              command.UI
+	Config          command.Config
+	usage           interface{} `usage:"CF_NAME profiles"`
+	relatedCommands interface{} `related_commands:"profile, target"`
+}
+
+func (cmd *ProfilesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd ProfilesCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Getting profiles...")
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("api endpoint"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+		},
+	}
+
+	current := cmd.Config.ProfileName()
+	for _, profile := range cmd.Config.Profiles() {
+		var marker string
+		if profile.Name == current {
+			marker = "*"
+		}
+
+		table = append(table, []string{
+			marker,
+			profile.Name,
+			profile.Target,
+			profile.TargetedOrganization.Name,
+			profile.TargetedSpace.Name,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
@@ -0,0 +1,57 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("profiles Command", func() {
+	var (
+		cmd        v7.ProfilesCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = v7.ProfilesCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+
+		fakeConfig.ProfileNameReturns("prod")
+		fakeConfig.ProfilesReturns([]configv3.Profile{
+			{
+				Name:                 "default",
+				Target:               "https://api.dev.example.com",
+				TargetedOrganization: configv3.Organization{Name: "dev-org"},
+				TargetedSpace:        configv3.Space{Name: "dev-space"},
+			},
+			{
+				Name:   "prod",
+				Target: "https://api.prod.example.com",
+			},
+		})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("lists the profiles and marks the current one", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting profiles\.\.\.`))
+		Expect(testUI.Out).To(Say(`name\s+api endpoint\s+org\s+space`))
+		Expect(testUI.Out).To(Say(`\s+default\s+https://api.dev.example.com\s+dev-org\s+dev-space`))
+		Expect(testUI.Out).To(Say(`\*\s+prod\s+https://api.prod.example.com`))
+	})
+})
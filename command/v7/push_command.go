@@ -3,10 +3,14 @@ package v7
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 
+	"code.cloudfoundry.org/bytefmt"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	log "github.com/sirupsen/logrus"
@@ -28,6 +32,7 @@ import (
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/manifestparser"
 	"code.cloudfoundry.org/cli/util/progressbar"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ProgressBar
@@ -44,6 +49,9 @@ type PushActor interface {
 	HandleFlagOverrides(baseManifest manifestparser.Manifest, flagOverrides v7pushaction.FlagOverrides) (manifestparser.Manifest, error)
 	HandleDeploymentScaleFlagOverrides(manifest manifestparser.Manifest, flagOverrides v7pushaction.FlagOverrides) (manifestparser.Manifest, error)
 	CreatePushPlans(spaceGUID string, orgGUID string, manifest manifestparser.Manifest, overrides v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)
+	// CreateDryRunPushPlans plans the push without requiring the apps to exist.
+	CreateDryRunPushPlans(spaceGUID string, orgGUID string, manifest manifestparser.Manifest, overrides v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)
+	MatchPushPlanResources(plan v7pushaction.PushPlan) ([]sharedaction.V3Resource, []sharedaction.V3Resource, v7pushaction.Warnings, error)
 	// Actualize applies any necessary changes.
 	Actualize(plan v7pushaction.PushPlan, progressBar v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent
 }
@@ -88,6 +96,7 @@ type PushCommand struct {
 	DockerImage             flag.DockerImage                    `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
 	DockerUsername          string                              `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
 	DropletPath             flag.PathWithExistenceCheck         `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
+	DryRun                  bool                                `long:"dry-run" description:"Display what the push would do for each app without making any changes"`
 	HealthCheckHTTPEndpoint string                              `long:"endpoint"  description:"Valid path on the app for an HTTP health check. Only used when specifying --health-check-type=http"`
 	HealthCheckType         flag.HealthCheckType                `long:"health-check-type" short:"u" description:"Application health check type. Defaults to 'port'. 'http' requires a valid endpoint, for example, '/health'."`
 	Instances               flag.Instances                      `long:"instances" short:"i" description:"Number of instances"`
@@ -101,6 +110,8 @@ type PushCommand struct {
 	NoRoute                 bool                                `long:"no-route" description:"Do not map a route to this app"`
 	NoStart                 bool                                `long:"no-start" description:"Do not stage and start the app after pushing"`
 	NoWait                  bool                                `long:"no-wait" description:"Exit when the first instance of the web process is healthy"`
+	Parallel                flag.PositiveInteger                `long:"parallel" description:"Maximum number of apps from the manifest to push concurrently (Default: 1)"`
+	FailFast                bool                                `long:"fail-fast" description:"Do not push remaining apps once one has failed. Only applies when --parallel flag is specified."`
 	AppPath                 flag.PathWithExistenceCheck         `long:"path" short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
 	RandomRoute             bool                                `long:"random-route" description:"Create a random route for this app (except when no-route is specified in the manifest)"`
 	RedactEnv               bool                                `long:"redact-env" description:"Do not print values for environment vars set in the application manifest"`
@@ -111,7 +122,7 @@ type PushCommand struct {
 	Vars                    []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
 	PathsToVarsFiles        []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
 	dockerPassword          interface{}                         `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--lifecycle (buildpack | docker | cnb)] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
+	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--lifecycle (buildpack | docker | cnb)] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]... [--parallel NUM_APPS [--fail-fast]] [--dry-run]\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
 	envCFStagingTimeout     interface{}                         `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout     interface{}                         `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
@@ -210,6 +221,10 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.DryRun {
+		return cmd.displayDryRun(transformedFinalManifest, transformedRawFinalManifest, flagOverrides, user)
+	}
+
 	cmd.announcePushing(transformedFinalManifest.AppNames(), user)
 
 	hasManifest := transformedFinalManifest.PathToManifest != ""
@@ -260,6 +275,10 @@ func (cmd PushCommand) Execute(args []string) error {
 		}
 	}()
 
+	if cmd.Parallel.Value > 1 && len(pushPlans) > 1 {
+		return cmd.actualizeInParallel(pushPlans)
+	}
+
 	for _, plan := range pushPlans {
 		log.WithField("app_name", plan.Application.Name).Info("actualizing")
 		eventStream := cmd.PushActor.Actualize(plan, cmd.ProgressBar)
@@ -566,6 +585,9 @@ func (cmd PushCommand) ValidateFlags() error {
 				"--random-route",
 			},
 		}
+	case cmd.FailFast && cmd.Parallel.Value == 0:
+		return translatableerror.RequiredFlagsError{Arg1: "--fail-fast", Arg2: "--parallel"}
+
 	case !cmd.validBuildpacks():
 		return translatableerror.InvalidBuildpacksError{}
 
@@ -754,6 +776,420 @@ func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) e
 	return nil
 }
 
+// parallelPushResult records the outcome of one push plan actualized by
+// actualizeInParallel. A skipped plan was never actualized; blockedBy names
+// the dependency that was not pushed, if that is why it was skipped.
+type parallelPushResult struct {
+	plan      v7pushaction.PushPlan
+	err       error
+	skipped   bool
+	blockedBy string
+}
+
+// silentProgressBar is handed to concurrently actualized plans, since a single
+// terminal progress bar cannot track several uploads at once.
+type silentProgressBar struct{}
+
+func (silentProgressBar) NewProgressBarWrapper(reader io.Reader, _ int64) io.Reader {
+	return reader
+}
+
+// actualizeInParallel actualizes up to cmd.Parallel plans at a time. A plan is
+// only actualized once the plans it depends on have been pushed successfully.
+// Progress is displayed with the app name as prefix, and app summaries are
+// displayed once every plan has finished, followed by a table of per-app
+// results.
+func (cmd PushCommand) actualizeInParallel(pushPlans []v7pushaction.PushPlan) error {
+	var (
+		waitGroup sync.WaitGroup
+		failed    atomic.Bool
+	)
+	results := make([]parallelPushResult, len(pushPlans))
+	slots := make(chan struct{}, cmd.Parallel.Value)
+
+	indexByName := map[string]int{}
+	done := make([]chan struct{}, len(pushPlans))
+	for i, plan := range pushPlans {
+		indexByName[plan.Application.Name] = i
+		done[i] = make(chan struct{})
+	}
+
+	for i, plan := range pushPlans {
+		waitGroup.Add(1)
+		go func(i int, plan v7pushaction.PushPlan) {
+			defer waitGroup.Done()
+			defer close(done[i])
+
+			for _, dependency := range plan.DependsOn {
+				dependencyIndex := indexByName[dependency]
+				<-done[dependencyIndex]
+				if result := results[dependencyIndex]; result.skipped || result.err != nil {
+					results[i] = parallelPushResult{plan: plan, skipped: true, blockedBy: dependency}
+					return
+				}
+			}
+
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			if cmd.FailFast && failed.Load() {
+				results[i] = parallelPushResult{plan: plan, skipped: true}
+				return
+			}
+
+			log.WithField("app_name", plan.Application.Name).Info("actualizing in parallel")
+			eventStream := cmd.PushActor.Actualize(plan, silentProgressBar{})
+			err := cmd.parallelEventStreamHandler(plan.Application.Name, eventStream)
+			if err != nil {
+				failed.Store(true)
+			}
+			results[i] = parallelPushResult{plan: plan, err: err}
+		}(i, plan)
+	}
+	waitGroup.Wait()
+
+	for _, result := range results {
+		if !result.skipped && cmd.shouldDisplaySummary(result.err) {
+			err := cmd.displayAppSummary(result.plan)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return cmd.displayParallelPushResults(results)
+}
+
+func (cmd PushCommand) parallelEventStreamHandler(appName string, eventStream <-chan *v7pushaction.PushEvent) error {
+	prefix := fmt.Sprintf("[%s]", appName)
+	for event := range eventStream {
+		cmd.UI.DisplayWarningsWithPrefix(prefix, event.Warnings)
+		if event.Err != nil {
+			cmd.UI.DisplayTextWithPrefix(prefix, "FAILED")
+			return event.Err
+		}
+		if message := parallelEventMessage(event.Event); message != "" {
+			cmd.UI.DisplayTextWithPrefix(prefix, message)
+		}
+	}
+	cmd.UI.DisplayTextWithPrefix(prefix, "OK")
+	return nil
+}
+
+// parallelEventMessage returns the progress message displayed for an event
+// when pushing in parallel. Staging logs are not traced in this mode.
+func parallelEventMessage(event v7pushaction.Event) string {
+	switch event {
+	case v7pushaction.CreatingArchive:
+		return "Packaging files to upload..."
+	case v7pushaction.UploadingApplicationWithArchive:
+		return "Uploading files..."
+	case v7pushaction.UploadingApplication:
+		return "All files found in remote cache; nothing to upload."
+	case v7pushaction.RetryUpload:
+		return "Retrying upload due to an error..."
+	case v7pushaction.UploadWithArchiveComplete, v7pushaction.UploadDropletComplete:
+		return "Waiting for API to complete processing files..."
+	case v7pushaction.UploadingDroplet:
+		return "Uploading droplet bits..."
+	case v7pushaction.StoppingApplication:
+		return "Stopping Application..."
+	case v7pushaction.StoppingApplicationComplete:
+		return "Application Stopped"
+	case v7pushaction.ApplyManifest:
+		return "Applying manifest..."
+	case v7pushaction.ApplyManifestComplete:
+		return "Manifest applied"
+	case v7pushaction.StartingStaging:
+		return "Staging app..."
+	case v7pushaction.StagingComplete:
+		return "Staging complete"
+	case v7pushaction.RestartingApplication:
+		return "Waiting for app to start..."
+	case v7pushaction.StartingDeployment:
+		return "Starting deployment..."
+	case v7pushaction.WaitingForDeployment:
+		return "Waiting for app to deploy..."
+	}
+	return ""
+}
+
+func (cmd PushCommand) displayParallelPushResults(results []parallelPushResult) error {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("status"),
+			cmd.UI.TranslateText("details"),
+		},
+	}
+
+	var unsuccessful []string
+	for _, result := range results {
+		appName := result.plan.Application.Name
+		switch {
+		case result.skipped:
+			unsuccessful = append(unsuccessful, appName)
+			details := ""
+			if result.blockedBy != "" {
+				details = cmd.UI.TranslateText("dependency {{.AppName}} was not pushed", map[string]interface{}{
+					"AppName": result.blockedBy,
+				})
+			}
+			table = append(table, []string{appName, cmd.UI.TranslateText("skipped"), details})
+		case result.err != nil:
+			unsuccessful = append(unsuccessful, appName)
+			table = append(table, []string{appName, cmd.UI.TranslateText("failed"), cmd.errorDetails(appName, result.err)})
+		default:
+			table = append(table, []string{appName, cmd.UI.TranslateText("pushed"), ""})
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if len(unsuccessful) > 0 {
+		return translatableerror.ParallelPushFailedError{AppNames: unsuccessful}
+	}
+	return nil
+}
+
+// errorDetails returns the first line of the translated error message, so it
+// fits on a row of the results table.
+func (cmd PushCommand) errorDetails(appName string, err error) string {
+	err = translatableerror.ConvertToTranslatableError(cmd.mapErr(appName, err))
+
+	message := err.Error()
+	if translatableErr, ok := err.(translatableerror.TranslatableError); ok {
+		message = translatableErr.Translate(func(template string, values ...interface{}) string {
+			var templateValues []map[string]interface{}
+			for _, value := range values {
+				if valueMap, isMap := value.(map[string]interface{}); isMap {
+					templateValues = append(templateValues, valueMap)
+				}
+			}
+			return cmd.UI.TranslateText(template, templateValues...)
+		})
+	}
+
+	return strings.SplitN(message, "\n", 2)[0]
+}
+
+// displayDryRun displays the manifest diff and the resolved push plan of every
+// app, using only read-only API requests.
+func (cmd PushCommand) displayDryRun(manifest manifestparser.Manifest, rawManifest []byte, flagOverrides v7pushaction.FlagOverrides, user configv3.User) error {
+	cmd.UI.DisplayTextWithFlavor("Planning push of {{.AppName}} to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   strings.Join(manifest.AppNames(), ", "),
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+	if manifest.PathToManifest != "" {
+		cmd.UI.DisplayText("Manifest file {{.Path}} would be applied.", map[string]interface{}{
+			"Path": manifest.PathToManifest,
+		})
+
+		err := cmd.showManifestDiff(spaceGUID, rawManifest)
+		if err != nil {
+			return err
+		}
+	}
+
+	pushPlans, warnings, err := cmd.PushActor.CreateDryRunPushPlans(
+		spaceGUID,
+		cmd.Config.TargetedOrganization().GUID,
+		manifest,
+		flagOverrides,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	manifestApps := map[string]manifestparser.Application{}
+	for _, app := range manifest.Applications {
+		manifestApps[app.Name] = app
+	}
+
+	for _, plan := range pushPlans {
+		err := cmd.displayDryRunPlan(plan, manifestApps[plan.Application.Name])
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Dry run complete. No changes were made.")
+	return nil
+}
+
+func (cmd PushCommand) displayDryRunPlan(plan v7pushaction.PushPlan, manifestApp manifestparser.Application) error {
+	exists := plan.Application.GUID != ""
+	action := "update"
+	if !exists {
+		action = "create"
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithBold("Push plan for app {{.AppName}} ({{.Action}}):", map[string]interface{}{
+		"AppName": plan.Application.Name,
+		"Action":  cmd.UI.TranslateText(action),
+	})
+
+	unset := cmd.UI.TranslateText("unchanged")
+	if !exists {
+		unset = cmd.UI.TranslateText("default")
+	}
+	valueOrUnset := func(value string) string {
+		if value == "" {
+			return unset
+		}
+		return value
+	}
+
+	memory, instances := manifestApp.Memory, ""
+	if manifestApp.Instances != nil {
+		instances = strconv.Itoa(*manifestApp.Instances)
+	}
+	for _, process := range manifestApp.Processes {
+		if process.Type != constant.ProcessTypeWeb {
+			continue
+		}
+		if process.Memory != "" {
+			memory = process.Memory
+		}
+		if process.Instances != nil {
+			instances = strconv.Itoa(*process.Instances)
+		}
+	}
+
+	strategy := string(plan.Strategy)
+	if strategy == "" {
+		strategy = "none"
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("memory:"), valueOrUnset(memory)},
+		{cmd.UI.TranslateText("instances:"), valueOrUnset(instances)},
+		{cmd.UI.TranslateText("routes:"), cmd.dryRunRoutes(manifestApp, unset)},
+	}
+	if plan.DockerImageCredentials.Path != "" {
+		table = append(table, []string{cmd.UI.TranslateText("docker image:"), plan.DockerImageCredentials.Path})
+	} else {
+		buildpacks := manifestStrings(manifestApp.RemainingManifestFields["buildpacks"])
+		table = append(table, []string{cmd.UI.TranslateText("buildpacks:"), valueOrUnset(strings.Join(buildpacks, ", "))})
+	}
+	table = append(table, []string{cmd.UI.TranslateText("strategy:"), strategy})
+
+	if plan.DropletPath != "" {
+		table = append(table, []string{cmd.UI.TranslateText("droplet:"), plan.DropletPath})
+		cmd.UI.DisplayKeyValueTable("", table, ui.DefaultTableSpacePadding)
+		return nil
+	}
+	if plan.DockerImageCredentials.Path != "" {
+		cmd.UI.DisplayKeyValueTable("", table, ui.DefaultTableSpacePadding)
+		return nil
+	}
+
+	matched, unmatched, warnings, err := cmd.PushActor.MatchPushPlanResources(plan)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	table = append(table, []string{
+		cmd.UI.TranslateText("files:"),
+		cmd.UI.TranslateText("{{.UploadCount}} to upload ({{.UploadSize}}), {{.MatchCount}} already cached ({{.MatchSize}})", map[string]interface{}{
+			"UploadCount": countFiles(unmatched),
+			"UploadSize":  bytefmt.ByteSize(sizeOfFiles(unmatched)),
+			"MatchCount":  countFiles(matched),
+			"MatchSize":   bytefmt.ByteSize(sizeOfFiles(matched)),
+		}),
+	})
+	cmd.UI.DisplayKeyValueTable("", table, ui.DefaultTableSpacePadding)
+
+	files := [][]string{}
+	for _, resource := range unmatched {
+		if !resource.Mode.IsDir() {
+			files = append(files, []string{cmd.UI.TranslateText("upload"), resource.FilePath})
+		}
+	}
+	for _, resource := range matched {
+		files = append(files, []string{cmd.UI.TranslateText("cached"), resource.FilePath})
+	}
+	if len(files) > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayNonWrappingTable("  ", files, ui.DefaultTableSpacePadding)
+	}
+
+	return nil
+}
+
+func (cmd PushCommand) dryRunRoutes(manifestApp manifestparser.Application, unset string) string {
+	switch {
+	case manifestApp.NoRoute:
+		return cmd.UI.TranslateText("none")
+	case manifestApp.RandomRoute:
+		return cmd.UI.TranslateText("random route")
+	}
+
+	var routes []string
+	rawRoutes, _ := manifestApp.RemainingManifestFields["routes"].([]interface{})
+	for _, rawRoute := range rawRoutes {
+		if route, ok := rawRoute.(map[interface{}]interface{}); ok {
+			if url, ok := route["route"].(string); ok {
+				routes = append(routes, url)
+			}
+		}
+	}
+
+	if len(routes) > 0 {
+		return strings.Join(routes, ", ")
+	}
+	if manifestApp.DefaultRoute {
+		return cmd.UI.TranslateText("default route")
+	}
+	return unset
+}
+
+// manifestStrings returns the strings of a list read from the remaining
+// manifest fields, which holds []interface{} when parsed and []string when
+// set from flag overrides.
+func manifestStrings(value interface{}) []string {
+	switch list := value.(type) {
+	case []string:
+		return list
+	case []interface{}:
+		var strs []string
+		for _, item := range list {
+			if str, ok := item.(string); ok {
+				strs = append(strs, str)
+			}
+		}
+		return strs
+	}
+	return nil
+}
+
+func countFiles(resources []sharedaction.V3Resource) int {
+	count := 0
+	for _, resource := range resources {
+		if !resource.Mode.IsDir() {
+			count++
+		}
+	}
+	return count
+}
+
+func sizeOfFiles(resources []sharedaction.V3Resource) uint64 {
+	var size int64
+	for _, resource := range resources {
+		size += resource.SizeInBytes
+	}
+	return uint64(size)
+}
+
 func (cmd PushCommand) getLogs(logStream <-chan sharedaction.LogMessage, errStream <-chan error) {
 	for {
 		select {
@@ -3,6 +3,9 @@ package v7_test
 import (
 	"context"
 	"errors"
+	"regexp"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -339,6 +342,100 @@ var _ = Describe("push Command", func() {
 								Expect(actualManifestBytes).To(Equal([]byte("our-manifest")))
 							})
 
+							When("--dry-run is provided", func() {
+								BeforeEach(func() {
+									cmd.DryRun = true
+									instances := 2
+									fakeActor.HandleDeploymentScaleFlagOverridesReturns(
+										manifestparser.Manifest{
+											PathToManifest: "path/to/manifest",
+											Applications: []manifestparser.Application{
+												{
+													Name:      "some-app-name",
+													Memory:    "256M",
+													Instances: &instances,
+													RemainingManifestFields: map[string]interface{}{
+														"routes": []interface{}{
+															map[interface{}]interface{}{"route": "some-app.example.com"},
+														},
+														"buildpacks": []interface{}{"ruby_buildpack"},
+													},
+												},
+												{
+													Name:   "docker-app",
+													Docker: &manifestparser.Docker{Image: "some-image"},
+												},
+											},
+										},
+										nil,
+									)
+									fakeActor.CreateDryRunPushPlansReturns(
+										[]v7pushaction.PushPlan{
+											{
+												Application: resources.Application{Name: "some-app-name", GUID: "some-app-guid"},
+												Strategy:    constant.DeploymentStrategyRolling,
+											},
+											{
+												Application:            resources.Application{Name: "docker-app"},
+												DockerImageCredentials: v7action.DockerImageCredentials{Path: "some-image"},
+											},
+										},
+										v7action.Warnings{"dry-run-plans-warning"},
+										nil,
+									)
+									fakeActor.MatchPushPlanResourcesReturns(
+										[]sharedaction.V3Resource{{FilePath: "cached.rb", SizeInBytes: 1024}},
+										[]sharedaction.V3Resource{{FilePath: "new.rb", SizeInBytes: 2048}},
+										v7pushaction.Warnings{"match-warning"},
+										nil,
+									)
+								})
+
+								It("displays the plan of each app without changing anything", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+
+									Expect(testUI.Out).To(Say(`Planning push of some-app-name, docker-app to org some-org / space some-space as some-user\.\.\.`))
+									Expect(testUI.Out).To(Say(`Manifest file path/to/manifest would be applied\.`))
+									Expect(fakeDiffActor.DiffSpaceManifestCallCount()).To(Equal(1))
+
+									Expect(testUI.Out).To(Say(`Push plan for app some-app-name \(update\):`))
+									Expect(testUI.Out).To(Say(`memory:\s+256M`))
+									Expect(testUI.Out).To(Say(`instances:\s+2`))
+									Expect(testUI.Out).To(Say(`routes:\s+some-app\.example\.com`))
+									Expect(testUI.Out).To(Say(`buildpacks:\s+ruby_buildpack`))
+									Expect(testUI.Out).To(Say(`strategy:\s+rolling`))
+									Expect(testUI.Out).To(Say(`files:\s+1 to upload \(2K\), 1 already cached \(1K\)`))
+									Expect(testUI.Out).To(Say(`upload\s+new\.rb`))
+									Expect(testUI.Out).To(Say(`cached\s+cached\.rb`))
+
+									Expect(testUI.Out).To(Say(`Push plan for app docker-app \(create\):`))
+									Expect(testUI.Out).To(Say(`memory:\s+default`))
+									Expect(testUI.Out).To(Say(`routes:\s+default`))
+									Expect(testUI.Out).To(Say(`docker image:\s+some-image`))
+									Expect(testUI.Out).To(Say(`strategy:\s+none`))
+
+									Expect(testUI.Out).To(Say(`Dry run complete\. No changes were made\.`))
+									Expect(testUI.Err).To(Say("dry-run-plans-warning"))
+									Expect(testUI.Err).To(Say("match-warning"))
+
+									Expect(fakeActor.MatchPushPlanResourcesCallCount()).To(Equal(1))
+									Expect(fakeVersionActor.SetSpaceManifestCallCount()).To(Equal(0))
+									Expect(fakeActor.CreatePushPlansCallCount()).To(Equal(0))
+									Expect(fakeActor.ActualizeCallCount()).To(Equal(0))
+								})
+
+								When("planning fails", func() {
+									BeforeEach(func() {
+										fakeActor.CreateDryRunPushPlansReturns(nil, v7action.Warnings{"plan-warning"}, errors.New("plan-error"))
+									})
+
+									It("returns the error", func() {
+										Expect(executeErr).To(MatchError("plan-error"))
+										Expect(testUI.Err).To(Say("plan-warning"))
+									})
+								})
+							})
+
 							When("the manifest is successfully parsed", func() {
 								var expectedDiff resources.ManifestDiff
 
@@ -482,6 +579,195 @@ var _ = Describe("push Command", func() {
 										Expect(testUI.Err).To(Say("create-push-plans-warnings"))
 									})
 
+									When("--parallel is provided", func() {
+										BeforeEach(func() {
+											cmd.Parallel = flag.PositiveInteger{Value: 2}
+											fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+												return FillInEvents([]Step{
+													{Plan: pushPlan, Event: v7pushaction.CreatingArchive, Warnings: v7pushaction.Warnings{"archive-warning"}},
+													{Plan: pushPlan, Event: v7pushaction.RestartingApplication},
+												})
+											}
+										})
+
+										It("actualizes every plan and prefixes the progress with the app name", func() {
+											Expect(executeErr).ToNot(HaveOccurred())
+
+											Expect(fakeActor.ActualizeCallCount()).To(Equal(2))
+											Expect(fakeProgressBar.ReadyCallCount()).To(Equal(0))
+
+											Expect(string(testUI.Out.(*Buffer).Contents())).To(ContainSubstring("[first-app] Packaging files to upload..."))
+											Expect(string(testUI.Out.(*Buffer).Contents())).To(ContainSubstring("[second-app] Packaging files to upload..."))
+											Expect(string(testUI.Out.(*Buffer).Contents())).To(ContainSubstring("[first-app] Waiting for app to start..."))
+											Expect(string(testUI.Out.(*Buffer).Contents())).To(ContainSubstring("[second-app] OK"))
+											Expect(string(testUI.Err.(*Buffer).Contents())).To(ContainSubstring("[first-app] archive-warning"))
+										})
+
+										It("displays the app summaries followed by the results", func() {
+											Expect(fakeVersionActor.GetDetailedAppSummaryCallCount()).To(Equal(2))
+
+											Expect(testUI.Out).To(Say(`app\s+status\s+details`))
+											Expect(testUI.Out).To(Say(`first-app\s+pushed`))
+											Expect(testUI.Out).To(Say(`second-app\s+pushed`))
+										})
+
+										When("there are more apps than --parallel", func() {
+											var maxInFlight int32
+
+											BeforeEach(func() {
+												fakeActor.CreatePushPlansReturns(
+													[]v7pushaction.PushPlan{
+														{Application: resources.Application{Name: "first-app"}},
+														{Application: resources.Application{Name: "second-app"}},
+														{Application: resources.Application{Name: "third-app"}},
+													},
+													nil,
+													nil,
+												)
+
+												// No push finishes until two have started, so the
+												// test hangs unless the pushes run concurrently.
+												var (
+													inFlight    int32
+													started     int32
+													bothStarted = make(chan struct{})
+												)
+												maxInFlight = 0
+												fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+													current := atomic.AddInt32(&inFlight, 1)
+													for {
+														highest := atomic.LoadInt32(&maxInFlight)
+														if current <= highest || atomic.CompareAndSwapInt32(&maxInFlight, highest, current) {
+															break
+														}
+													}
+													if atomic.AddInt32(&started, 1) == 2 {
+														close(bothStarted)
+													}
+													<-bothStarted
+													atomic.AddInt32(&inFlight, -1)
+													return FillInEvents([]Step{{Plan: pushPlan}})
+												}
+											})
+
+											It("pushes the apps concurrently, never more than --parallel at a time", func() {
+												Expect(executeErr).ToNot(HaveOccurred())
+
+												Expect(fakeActor.ActualizeCallCount()).To(Equal(3))
+												Expect(atomic.LoadInt32(&maxInFlight)).To(Equal(int32(2)))
+												Expect(testUI.Out).To(Say(`first-app\s+pushed`))
+												Expect(testUI.Out).To(Say(`second-app\s+pushed`))
+												Expect(testUI.Out).To(Say(`third-app\s+pushed`))
+											})
+										})
+
+										When("one of the apps fails", func() {
+											BeforeEach(func() {
+												fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+													if pushPlan.Application.Name == "second-app" {
+														return FillInEvents([]Step{
+															{Plan: pushPlan, Error: actionerror.StartupTimeoutError{}},
+														})
+													}
+													return FillInEvents([]Step{{Plan: pushPlan}})
+												}
+											})
+
+											It("pushes the other apps and reports the failure", func() {
+												Expect(executeErr).To(MatchError(translatableerror.ParallelPushFailedError{AppNames: []string{"second-app"}}))
+
+												Expect(fakeActor.ActualizeCallCount()).To(Equal(2))
+												Expect(fakeVersionActor.GetDetailedAppSummaryCallCount()).To(Equal(1))
+												summaryAppName, _, _ := fakeVersionActor.GetDetailedAppSummaryArgsForCall(0)
+												Expect(summaryAppName).To(Equal("first-app"))
+
+												Expect(string(testUI.Out.(*Buffer).Contents())).To(ContainSubstring("[second-app] FAILED"))
+												Expect(testUI.Out).To(Say(`first-app\s+pushed`))
+												Expect(testUI.Out).To(Say(`second-app\s+failed\s+Start app timeout`))
+											})
+										})
+
+										When("--fail-fast is provided and apps fail", func() {
+											BeforeEach(func() {
+												cmd.FailFast = true
+												fakeActor.CreatePushPlansReturns(
+													[]v7pushaction.PushPlan{
+														{Application: resources.Application{Name: "first-app"}},
+														{Application: resources.Application{Name: "second-app"}},
+														{Application: resources.Application{Name: "third-app"}},
+													},
+													nil,
+													nil,
+												)
+												// Both slots are taken before either push fails, so
+												// exactly one app is left to be skipped.
+												var bothStarted sync.WaitGroup
+												bothStarted.Add(2)
+												fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+													bothStarted.Done()
+													bothStarted.Wait()
+													return FillInEvents([]Step{
+														{Plan: pushPlan, Error: errors.New("push-error")},
+													})
+												}
+											})
+
+											It("does not push the remaining apps", func() {
+												Expect(executeErr).To(MatchError(translatableerror.ParallelPushFailedError{
+													AppNames: []string{"first-app", "second-app", "third-app"},
+												}))
+
+												Expect(fakeActor.ActualizeCallCount()).To(Equal(2))
+												output := string(testUI.Out.(*Buffer).Contents())
+												Expect(regexp.MustCompile(`-app\s+failed\s+push-error`).FindAllString(output, -1)).To(HaveLen(2))
+												Expect(regexp.MustCompile(`-app\s+skipped`).FindAllString(output, -1)).To(HaveLen(1))
+											})
+										})
+
+										When("an app depends on another app", func() {
+											BeforeEach(func() {
+												fakeActor.CreatePushPlansReturns(
+													[]v7pushaction.PushPlan{
+														{Application: resources.Application{Name: "first-app"}},
+														{Application: resources.Application{Name: "second-app"}, DependsOn: []string{"first-app"}},
+													},
+													nil,
+													nil,
+												)
+											})
+
+											It("pushes the app after its dependency", func() {
+												Expect(executeErr).ToNot(HaveOccurred())
+
+												Expect(fakeActor.ActualizeCallCount()).To(Equal(2))
+												firstPlan, _ := fakeActor.ActualizeArgsForCall(0)
+												Expect(firstPlan.Application.Name).To(Equal("first-app"))
+												secondPlan, _ := fakeActor.ActualizeArgsForCall(1)
+												Expect(secondPlan.Application.Name).To(Equal("second-app"))
+											})
+
+											When("the dependency fails", func() {
+												BeforeEach(func() {
+													fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+														return FillInEvents([]Step{
+															{Plan: pushPlan, Error: errors.New("push-error")},
+														})
+													}
+												})
+
+												It("skips the app", func() {
+													Expect(executeErr).To(MatchError(translatableerror.ParallelPushFailedError{
+														AppNames: []string{"first-app", "second-app"},
+													}))
+
+													Expect(fakeActor.ActualizeCallCount()).To(Equal(1))
+													Expect(testUI.Out).To(Say(`first-app\s+failed\s+push-error`))
+													Expect(testUI.Out).To(Say(`second-app\s+skipped\s+dependency first-app was not pushed`))
+												})
+											})
+										})
+									})
+
 									Describe("delegating to Actor.Actualize", func() {
 										When("Actualize returns success", func() {
 											BeforeEach(func() {
@@ -1439,6 +1725,12 @@ var _ = Describe("push Command", func() {
 				},
 			}),
 
+		Entry("fail-fast is passed without parallel",
+			func() {
+				cmd.FailFast = true
+			},
+			translatableerror.RequiredFlagsError{Arg1: "--fail-fast", Arg2: "--parallel"}),
+
 		Entry("instance-steps is not a list of ints",
 			func() {
 				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
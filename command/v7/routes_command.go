@@ -5,7 +5,9 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
@@ -18,6 +20,8 @@ type RoutesCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter routes by labels"`
 }
 
+func (RoutesCommand) SupportsStructuredOutput() {}
+
 func (cmd RoutesCommand) Execute(args []string) error {
 	var (
 		routes   []resources.Route
@@ -37,19 +41,24 @@ func (cmd RoutesCommand) Execute(args []string) error {
 
 	targetedOrg := cmd.Config.TargetedOrganization()
 	targetedSpace := cmd.Config.TargetedSpace()
+	outputFormat := cmd.Config.OutputFormat()
 
 	if cmd.Orglevel {
-		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} as {{.CurrentUser}}...\n", map[string]interface{}{
-			"CurrentOrg":  targetedOrg.Name,
-			"CurrentUser": currentUser.Name,
-		})
+		if outputFormat == configv3.OutputFormatDefault {
+			cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} as {{.CurrentUser}}...\n", map[string]interface{}{
+				"CurrentOrg":  targetedOrg.Name,
+				"CurrentUser": currentUser.Name,
+			})
+		}
 		routes, warnings, err = cmd.Actor.GetRoutesByOrg(targetedOrg.GUID, cmd.Labels)
 	} else {
-		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...\n", map[string]interface{}{
-			"CurrentOrg":   targetedOrg.Name,
-			"CurrentSpace": targetedSpace.Name,
-			"CurrentUser":  currentUser.Name,
-		})
+		if outputFormat == configv3.OutputFormatDefault {
+			cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...\n", map[string]interface{}{
+				"CurrentOrg":   targetedOrg.Name,
+				"CurrentSpace": targetedSpace.Name,
+				"CurrentUser":  currentUser.Name,
+			})
+		}
 		routes, warnings, err = cmd.Actor.GetRoutesBySpace(targetedSpace.GUID, cmd.Labels)
 	}
 
@@ -64,6 +73,10 @@ func (cmd RoutesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if outputFormat != configv3.OutputFormatDefault {
+		return shared.DisplayOutputList(cmd.UI, outputFormat, "routes", shared.NewRouteOutputs(routeSummaries))
+	}
+
 	if len(routes) > 0 {
 		cmd.displayRoutesTable(routeSummaries)
 	} else {
@@ -6,7 +6,9 @@ import (
 	"code.cloudfoundry.org/cli/resources"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
@@ -17,13 +19,18 @@ type ServicesCommand struct {
 	relatedCommands interface{} `related_commands:"create-service, marketplace"`
 }
 
+func (ServicesCommand) SupportsStructuredOutput() {}
+
 func (cmd ServicesCommand) Execute(args []string) error {
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
 	}
 
-	if err := cmd.displayMessage(); err != nil {
-		return err
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatDefault {
+		if err := cmd.displayMessage(); err != nil {
+			return err
+		}
 	}
 
 	instances, warnings, err := cmd.Actor.GetServiceInstancesForSpace(cmd.Config.TargetedSpace().GUID, cmd.OmitApps)
@@ -32,6 +39,10 @@ func (cmd ServicesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if outputFormat != configv3.OutputFormatDefault {
+		return shared.DisplayOutputList(cmd.UI, outputFormat, "service_instances", shared.NewServiceInstanceOutputs(instances))
+	}
+
 	cmd.displayTable(instances)
 	return nil
 }
@@ -0,0 +1,49 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// OutputDocumentVersion is the version of the documents displayed when the
+// global --output flag is provided. It must be incremented whenever a field
+// is removed or changes meaning, so that scripts can detect incompatible
+// output instead of silently misreading it.
+const OutputDocumentVersion = 1
+
+// OutputDocument is the envelope for machine-readable command output. Lists
+// of resources are displayed under Resources, a single resource under
+// Resource.
+type OutputDocument struct {
+	Version   int         `json:"version" yaml:"version"`
+	Kind      string      `json:"kind" yaml:"kind"`
+	Resource  interface{} `json:"resource,omitempty" yaml:"resource,omitempty"`
+	Resources interface{} `json:"resources,omitempty" yaml:"resources,omitempty"`
+}
+
+// DisplayOutputList displays a list of resources of the given kind as a
+// versioned document in the requested format.
+func DisplayOutputList(ui command.UI, format configv3.OutputFormat, kind string, resources interface{}) error {
+	return displayOutputDocument(ui, format, OutputDocument{
+		Version:   OutputDocumentVersion,
+		Kind:      kind,
+		Resources: resources,
+	})
+}
+
+// DisplayOutputResource displays a single resource of the given kind as a
+// versioned document in the requested format.
+func DisplayOutputResource(ui command.UI, format configv3.OutputFormat, kind string, resource interface{}) error {
+	return displayOutputDocument(ui, format, OutputDocument{
+		Version:  OutputDocumentVersion,
+		Kind:     kind,
+		Resource: resource,
+	})
+}
+
+func displayOutputDocument(ui command.UI, format configv3.OutputFormat, document OutputDocument) error {
+	if format == configv3.OutputFormatYAML {
+		return ui.DisplayYAML("", document)
+	}
+	return ui.DisplayJSON("", document)
+}
@@ -0,0 +1,149 @@
+package shared_test
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("output documents", func() {
+	var (
+		output *Buffer
+		testUI *ui.UI
+	)
+
+	BeforeEach(func() {
+		output = NewBuffer()
+		testUI = ui.NewTestUI(nil, output, NewBuffer())
+	})
+
+	Describe("DisplayOutputList", func() {
+		It("displays a versioned json document", func() {
+			err := DisplayOutputList(testUI, configv3.OutputFormatJSON, "spaces", []NamedResourceOutput{{Name: "some-space", GUID: "some-space-guid"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			var document map[string]interface{}
+			Expect(json.Unmarshal(output.Contents(), &document)).To(Succeed())
+			Expect(document).To(Equal(map[string]interface{}{
+				"version": float64(OutputDocumentVersion),
+				"kind":    "spaces",
+				"resources": []interface{}{
+					map[string]interface{}{"name": "some-space", "guid": "some-space-guid"},
+				},
+			}))
+		})
+
+		It("displays a versioned yaml document", func() {
+			err := DisplayOutputList(testUI, configv3.OutputFormatYAML, "spaces", []NamedResourceOutput{{Name: "some-space", GUID: "some-space-guid"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(output).To(Say("version: 1\nkind: spaces\nresources:\n- name: some-space\n  guid: some-space-guid\n"))
+		})
+	})
+
+	Describe("DisplayOutputResource", func() {
+		It("displays the resource under the resource key", func() {
+			err := DisplayOutputResource(testUI, configv3.OutputFormatYAML, "app", AppOutput{Name: "some-app"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(output).To(Say("version: 1\nkind: app\nresource:\n  name: some-app\n"))
+		})
+	})
+
+	Describe("NewAppDetailOutput", func() {
+		It("includes process instances, buildpacks and the active deployment", func() {
+			routable := true
+			summary := v7action.DetailedApplicationSummary{
+				ApplicationSummary: v7action.ApplicationSummary{
+					Application: resources.Application{
+						Name:          "some-app",
+						GUID:          "some-app-guid",
+						State:         constant.ApplicationStarted,
+						LifecycleType: constant.AppLifecycleTypeBuildpack,
+					},
+					ProcessSummaries: v7action.ProcessSummaries{
+						{
+							Process: resources.Process{
+								Type:       constant.ProcessTypeWeb,
+								Instances:  types.NullInt{Value: 1, IsSet: true},
+								MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
+							},
+							Sidecars: []resources.Sidecar{{Name: "some-sidecar"}},
+							InstanceDetails: []v7action.ProcessInstance{
+								{
+									Index:          0,
+									State:          constant.ProcessInstanceRunning,
+									CPUEntitlement: types.NullFloat64{Value: 0.5, IsSet: true},
+									MemoryUsage:    1024,
+									Routable:       &routable,
+								},
+							},
+						},
+					},
+				},
+				CurrentDroplet: resources.Droplet{
+					Stack:      "cflinuxfs4",
+					CreatedAt:  "2024-01-01T00:00:00Z",
+					Buildpacks: []resources.DropletBuildpack{{Name: "ruby_buildpack", Version: "1.0.0"}},
+				},
+				Deployment: resources.Deployment{
+					GUID:         "some-deployment-guid",
+					StatusValue:  constant.DeploymentStatusValueActive,
+					StatusReason: constant.DeploymentStatusReasonDeploying,
+					Strategy:     constant.DeploymentStrategyRolling,
+				},
+			}
+
+			app := NewAppDetailOutput(summary)
+
+			Expect(app.Name).To(Equal("some-app"))
+			Expect(app.RequestedState).To(Equal("started"))
+			Expect(app.Stack).To(Equal("cflinuxfs4"))
+			Expect(app.LastUploaded).To(Equal("2024-01-01T00:00:00Z"))
+			Expect(app.Buildpacks).To(ConsistOf(BuildpackOutput{Name: "ruby_buildpack", Version: "1.0.0"}))
+			Expect(app.Deployment).To(Equal(&DeploymentOutput{
+				GUID:         "some-deployment-guid",
+				Strategy:     "rolling",
+				Status:       "active",
+				StatusReason: "deploying",
+			}))
+
+			Expect(app.Processes).To(HaveLen(1))
+			Expect(app.Processes[0].Type).To(Equal("web"))
+			Expect(app.Processes[0].Instances).To(Equal(1))
+			Expect(app.Processes[0].RunningInstances).To(Equal(1))
+			Expect(app.Processes[0].MemoryInMB).To(Equal(uint64(32)))
+			Expect(app.Processes[0].Sidecars).To(ConsistOf("some-sidecar"))
+			Expect(app.Processes[0].InstanceDetails).To(HaveLen(1))
+			Expect(*app.Processes[0].InstanceDetails[0].CPUEntitlement).To(Equal(0.5))
+			Expect(app.Processes[0].InstanceDetails[0].State).To(Equal("running"))
+			Expect(app.Processes[0].InstanceDetails[0].Routable).To(Equal(&routable))
+		})
+	})
+
+	Describe("NewUserOutputs", func() {
+		It("merges the roles of users holding several roles", func() {
+			users := NewUserOutputs(map[constant.RoleType][]resources.User{
+				constant.OrgManagerRole: {{GUID: "user-1", PresentationName: "alice", Origin: "uaa"}},
+				constant.OrgAuditorRole: {
+					{GUID: "user-1", PresentationName: "alice", Origin: "uaa"},
+					{GUID: "user-2", PresentationName: "bob"},
+				},
+			})
+
+			Expect(users).To(Equal([]UserOutput{
+				{GUID: "user-1", Username: "alice", Origin: "uaa", Roles: []string{"organization_auditor", "organization_manager"}},
+				{GUID: "user-2", Username: "bob", Origin: "client", Roles: []string{"organization_auditor"}},
+			}))
+		})
+	})
+})
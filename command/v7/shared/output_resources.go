@@ -0,0 +1,453 @@
+package shared
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// AppOutput is the machine-readable representation of an app summary.
+type AppOutput struct {
+	Name           string          `json:"name" yaml:"name"`
+	GUID           string          `json:"guid" yaml:"guid"`
+	RequestedState string          `json:"requested_state" yaml:"requested_state"`
+	LifecycleType  string          `json:"lifecycle_type" yaml:"lifecycle_type"`
+	Processes      []ProcessOutput `json:"processes" yaml:"processes"`
+	Routes         []string        `json:"routes" yaml:"routes"`
+}
+
+// AppDetailOutput is the machine-readable representation of an app with its
+// droplet and active deployment.
+type AppDetailOutput struct {
+	AppOutput        `yaml:",inline"`
+	IsolationSegment string            `json:"isolation_segment,omitempty" yaml:"isolation_segment,omitempty"`
+	Stack            string            `json:"stack" yaml:"stack"`
+	Buildpacks       []BuildpackOutput `json:"buildpacks,omitempty" yaml:"buildpacks,omitempty"`
+	DockerImage      string            `json:"docker_image,omitempty" yaml:"docker_image,omitempty"`
+	LastUploaded     string            `json:"last_uploaded" yaml:"last_uploaded"`
+	Deployment       *DeploymentOutput `json:"deployment,omitempty" yaml:"deployment,omitempty"`
+}
+
+// BuildpackOutput is the machine-readable representation of a buildpack
+// detected while staging the current droplet.
+type BuildpackOutput struct {
+	Name          string `json:"name" yaml:"name"`
+	DetectOutput  string `json:"detect_output" yaml:"detect_output"`
+	BuildpackName string `json:"buildpack_name" yaml:"buildpack_name"`
+	Version       string `json:"version" yaml:"version"`
+}
+
+// DeploymentOutput is the machine-readable representation of an active
+// deployment.
+type DeploymentOutput struct {
+	GUID         string `json:"guid" yaml:"guid"`
+	Strategy     string `json:"strategy" yaml:"strategy"`
+	Status       string `json:"status" yaml:"status"`
+	StatusReason string `json:"status_reason" yaml:"status_reason"`
+}
+
+// ProcessOutput is the machine-readable representation of a process and,
+// when stats were retrieved, its instances.
+type ProcessOutput struct {
+	Type             string           `json:"type" yaml:"type"`
+	Instances        int              `json:"instances" yaml:"instances"`
+	RunningInstances int              `json:"running_instances" yaml:"running_instances"`
+	MemoryInMB       uint64           `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB         uint64           `json:"disk_in_mb" yaml:"disk_in_mb"`
+	Sidecars         []string         `json:"sidecars,omitempty" yaml:"sidecars,omitempty"`
+	InstanceDetails  []InstanceOutput `json:"instance_details,omitempty" yaml:"instance_details,omitempty"`
+}
+
+// InstanceOutput is the machine-readable representation of a process
+// instance.
+type InstanceOutput struct {
+	Index          int64    `json:"index" yaml:"index"`
+	State          string   `json:"state" yaml:"state"`
+	UptimeSeconds  int64    `json:"uptime_seconds" yaml:"uptime_seconds"`
+	CPUEntitlement *float64 `json:"cpu_entitlement" yaml:"cpu_entitlement"`
+	MemoryUsage    uint64   `json:"memory_usage" yaml:"memory_usage"`
+	MemoryQuota    uint64   `json:"memory_quota" yaml:"memory_quota"`
+	DiskUsage      uint64   `json:"disk_usage" yaml:"disk_usage"`
+	DiskQuota      uint64   `json:"disk_quota" yaml:"disk_quota"`
+	LogRate        uint64   `json:"log_rate" yaml:"log_rate"`
+	LogRateLimit   int64    `json:"log_rate_limit" yaml:"log_rate_limit"`
+	Details        string   `json:"details" yaml:"details"`
+	Routable       *bool    `json:"routable" yaml:"routable"`
+}
+
+// ServiceInstanceOutput is the machine-readable representation of a service
+// instance listed in a space.
+type ServiceInstanceOutput struct {
+	Name             string   `json:"name" yaml:"name"`
+	Type             string   `json:"type" yaml:"type"`
+	Offering         string   `json:"offering" yaml:"offering"`
+	Plan             string   `json:"plan" yaml:"plan"`
+	Broker           string   `json:"broker" yaml:"broker"`
+	BoundApps        []string `json:"bound_apps" yaml:"bound_apps"`
+	LastOperation    string   `json:"last_operation" yaml:"last_operation"`
+	UpgradeAvailable *bool    `json:"upgrade_available" yaml:"upgrade_available"`
+}
+
+// RouteOutput is the machine-readable representation of a route.
+type RouteOutput struct {
+	GUID            string            `json:"guid" yaml:"guid"`
+	URL             string            `json:"url" yaml:"url"`
+	Space           string            `json:"space" yaml:"space"`
+	Host            string            `json:"host" yaml:"host"`
+	Domain          string            `json:"domain" yaml:"domain"`
+	Port            int               `json:"port,omitempty" yaml:"port,omitempty"`
+	Path            string            `json:"path" yaml:"path"`
+	Protocol        string            `json:"protocol" yaml:"protocol"`
+	AppProtocols    []string          `json:"app_protocols" yaml:"app_protocols"`
+	Apps            []string          `json:"apps" yaml:"apps"`
+	ServiceInstance string            `json:"service_instance" yaml:"service_instance"`
+	Options         map[string]string `json:"options,omitempty" yaml:"options,omitempty"`
+}
+
+// EventOutput is the machine-readable representation of an audit event.
+type EventOutput struct {
+	GUID             string `json:"guid" yaml:"guid"`
+	Time             string `json:"time" yaml:"time"`
+	Type             string `json:"type" yaml:"type"`
+	ActorGUID        string `json:"actor_guid" yaml:"actor_guid"`
+	ActorType        string `json:"actor_type" yaml:"actor_type"`
+	ActorName        string `json:"actor_name" yaml:"actor_name"`
+	TargetGUID       string `json:"target_guid" yaml:"target_guid"`
+	TargetType       string `json:"target_type" yaml:"target_type"`
+	TargetName       string `json:"target_name" yaml:"target_name"`
+	SpaceGUID        string `json:"space_guid,omitempty" yaml:"space_guid,omitempty"`
+	OrganizationGUID string `json:"organization_guid,omitempty" yaml:"organization_guid,omitempty"`
+	Description      string `json:"description" yaml:"description"`
+}
+
+// NamedResourceOutput is the machine-readable representation of a resource
+// identified by its name and GUID, such as an org or a space.
+type NamedResourceOutput struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+}
+
+// SidecarOutput is the machine-readable representation of an app sidecar.
+type SidecarOutput struct {
+	Name         string   `json:"name" yaml:"name"`
+	GUID         string   `json:"guid" yaml:"guid"`
+	Command      string   `json:"command" yaml:"command"`
+	ProcessTypes []string `json:"process_types" yaml:"process_types"`
+	MemoryInMB   *uint64  `json:"memory_in_mb,omitempty" yaml:"memory_in_mb,omitempty"`
+	Origin       string   `json:"origin" yaml:"origin"`
+}
+
+// UserOutput is the machine-readable representation of a user holding a
+// role.
+type UserOutput struct {
+	GUID     string   `json:"guid" yaml:"guid"`
+	Username string   `json:"username" yaml:"username"`
+	Origin   string   `json:"origin" yaml:"origin"`
+	Roles    []string `json:"roles" yaml:"roles"`
+}
+
+// ServiceOfferingOutput is the machine-readable representation of a
+// marketplace service offering.
+type ServiceOfferingOutput struct {
+	Name        string              `json:"name" yaml:"name"`
+	GUID        string              `json:"guid" yaml:"guid"`
+	Description string              `json:"description" yaml:"description"`
+	Broker      string              `json:"broker" yaml:"broker"`
+	Plans       []ServicePlanOutput `json:"plans,omitempty" yaml:"plans,omitempty"`
+}
+
+// ServicePlanOutput is the machine-readable representation of a service plan.
+type ServicePlanOutput struct {
+	Name        string                      `json:"name" yaml:"name"`
+	GUID        string                      `json:"guid" yaml:"guid"`
+	Description string                      `json:"description" yaml:"description"`
+	Free        bool                        `json:"free" yaml:"free"`
+	Available   bool                        `json:"available" yaml:"available"`
+	Costs       []resources.ServicePlanCost `json:"costs,omitempty" yaml:"costs,omitempty"`
+}
+
+func NewAppOutputs(summaries []v7action.ApplicationSummary) []AppOutput {
+	apps := []AppOutput{}
+	for _, summary := range summaries {
+		apps = append(apps, newAppOutput(summary, false))
+	}
+	return apps
+}
+
+func NewAppDetailOutput(summary v7action.DetailedApplicationSummary) AppDetailOutput {
+	app := AppDetailOutput{
+		AppOutput:    newAppOutput(summary.ApplicationSummary, true),
+		Stack:        summary.CurrentDroplet.Stack,
+		LastUploaded: summary.CurrentDroplet.CreatedAt,
+	}
+
+	if name, exists := summary.GetIsolationSegmentName(); exists {
+		app.IsolationSegment = name
+	}
+
+	if summary.LifecycleType == constant.AppLifecycleTypeDocker {
+		app.DockerImage = summary.CurrentDroplet.Image
+	} else {
+		for _, buildpack := range summary.CurrentDroplet.Buildpacks {
+			app.Buildpacks = append(app.Buildpacks, BuildpackOutput{
+				Name:          buildpack.Name,
+				DetectOutput:  buildpack.DetectOutput,
+				BuildpackName: buildpack.BuildpackName,
+				Version:       buildpack.Version,
+			})
+		}
+	}
+
+	if summary.Deployment.StatusValue == constant.DeploymentStatusValueActive {
+		app.Deployment = &DeploymentOutput{
+			GUID:         summary.Deployment.GUID,
+			Strategy:     strings.ToLower(string(summary.Deployment.Strategy)),
+			Status:       strings.ToLower(string(summary.Deployment.StatusValue)),
+			StatusReason: strings.ToLower(string(summary.Deployment.StatusReason)),
+		}
+	}
+
+	return app
+}
+
+func newAppOutput(summary v7action.ApplicationSummary, withInstances bool) AppOutput {
+	app := AppOutput{
+		Name:           summary.Name,
+		GUID:           summary.GUID,
+		RequestedState: strings.ToLower(string(summary.State)),
+		LifecycleType:  string(summary.LifecycleType),
+		Processes:      []ProcessOutput{},
+		Routes:         []string{},
+	}
+
+	for _, process := range summary.ProcessSummaries {
+		app.Processes = append(app.Processes, newProcessOutput(process, withInstances))
+	}
+
+	for _, route := range summary.Routes {
+		app.Routes = append(app.Routes, route.URL)
+	}
+
+	return app
+}
+
+func newProcessOutput(process v7action.ProcessSummary, withInstances bool) ProcessOutput {
+	output := ProcessOutput{
+		Type:             process.Type,
+		Instances:        process.Instances.Value,
+		RunningInstances: process.HealthyInstanceCount(),
+		MemoryInMB:       process.MemoryInMB.Value,
+		DiskInMB:         process.DiskInMB.Value,
+	}
+
+	for _, sidecar := range process.Sidecars {
+		output.Sidecars = append(output.Sidecars, sidecar.Name)
+	}
+
+	if !withInstances {
+		return output
+	}
+
+	for _, instance := range process.InstanceDetails {
+		instanceOutput := InstanceOutput{
+			Index:         instance.Index,
+			State:         strings.ToLower(string(instance.State)),
+			UptimeSeconds: int64(instance.Uptime.Seconds()),
+			MemoryUsage:   instance.MemoryUsage,
+			MemoryQuota:   instance.MemoryQuota,
+			DiskUsage:     instance.DiskUsage,
+			DiskQuota:     instance.DiskQuota,
+			LogRate:       instance.LogRate,
+			LogRateLimit:  instance.LogRateLimit,
+			Details:       instance.Details,
+			Routable:      instance.Routable,
+		}
+		if instance.CPUEntitlement.IsSet {
+			cpuEntitlement := instance.CPUEntitlement.Value
+			instanceOutput.CPUEntitlement = &cpuEntitlement
+		}
+		output.InstanceDetails = append(output.InstanceDetails, instanceOutput)
+	}
+
+	return output
+}
+
+func NewServiceInstanceOutputs(instances []v7action.ServiceInstance) []ServiceInstanceOutput {
+	outputs := []ServiceInstanceOutput{}
+	for _, instance := range instances {
+		output := ServiceInstanceOutput{
+			Name:          instance.Name,
+			Type:          string(instance.Type),
+			Offering:      instance.ServiceOfferingName,
+			Plan:          instance.ServicePlanName,
+			Broker:        instance.ServiceBrokerName,
+			BoundApps:     instance.BoundApps,
+			LastOperation: instance.LastOperation,
+		}
+		if output.BoundApps == nil {
+			output.BoundApps = []string{}
+		}
+		if instance.UpgradeAvailable.IsSet {
+			upgradeAvailable := instance.UpgradeAvailable.Value
+			output.UpgradeAvailable = &upgradeAvailable
+		}
+		outputs = append(outputs, output)
+	}
+	return outputs
+}
+
+func NewRouteOutputs(routeSummaries []v7action.RouteSummary) []RouteOutput {
+	outputs := []RouteOutput{}
+	for _, summary := range routeSummaries {
+		output := RouteOutput{
+			GUID:            summary.GUID,
+			URL:             summary.URL,
+			Space:           summary.SpaceName,
+			Host:            summary.Host,
+			Domain:          summary.DomainName,
+			Port:            summary.Port,
+			Path:            summary.Path,
+			Protocol:        summary.Protocol,
+			AppProtocols:    summary.AppProtocols,
+			Apps:            summary.AppNames,
+			ServiceInstance: summary.ServiceInstanceName,
+		}
+		if output.AppProtocols == nil {
+			output.AppProtocols = []string{}
+		}
+		if output.Apps == nil {
+			output.Apps = []string{}
+		}
+		for name, value := range summary.Options {
+			if value == nil {
+				continue
+			}
+			if output.Options == nil {
+				output.Options = map[string]string{}
+			}
+			output.Options[name] = *value
+		}
+		outputs = append(outputs, output)
+	}
+	return outputs
+}
+
+func NewEventOutputs(events []v7action.Event) []EventOutput {
+	outputs := []EventOutput{}
+	for _, event := range events {
+		outputs = append(outputs, EventOutput{
+			GUID:             event.GUID,
+			Time:             event.Time.UTC().Format(time.RFC3339),
+			Type:             event.Type,
+			ActorGUID:        event.ActorGUID,
+			ActorType:        event.ActorType,
+			ActorName:        event.ActorName,
+			TargetGUID:       event.TargetGUID,
+			TargetType:       event.TargetType,
+			TargetName:       event.TargetName,
+			SpaceGUID:        event.SpaceGUID,
+			OrganizationGUID: event.OrganizationGUID,
+			Description:      event.Description,
+		})
+	}
+	return outputs
+}
+
+func NewSpaceOutputs(spaces []resources.Space) []NamedResourceOutput {
+	outputs := []NamedResourceOutput{}
+	for _, space := range spaces {
+		outputs = append(outputs, NamedResourceOutput{Name: space.Name, GUID: space.GUID})
+	}
+	return outputs
+}
+
+func NewSidecarOutputs(sidecars []resources.Sidecar) []SidecarOutput {
+	outputs := []SidecarOutput{}
+	for _, sidecar := range sidecars {
+		output := SidecarOutput{
+			Name:         sidecar.Name,
+			GUID:         sidecar.GUID,
+			Command:      sidecar.Command.Value,
+			ProcessTypes: sidecar.ProcessTypes,
+			Origin:       sidecar.Origin,
+		}
+		if sidecar.MemoryInMB.IsSet {
+			memory := sidecar.MemoryInMB.Value
+			output.MemoryInMB = &memory
+		}
+		outputs = append(outputs, output)
+	}
+	return outputs
+}
+
+func NewOrgOutputs(orgs []resources.Organization) []NamedResourceOutput {
+	outputs := []NamedResourceOutput{}
+	for _, org := range orgs {
+		outputs = append(outputs, NamedResourceOutput{Name: org.Name, GUID: org.GUID})
+	}
+	return outputs
+}
+
+// NewUserOutputs merges users holding several roles into a single entry
+// listing all of their roles, sorted the same way as the human output.
+func NewUserOutputs(usersByRoleType map[constant.RoleType][]resources.User) []UserOutput {
+	var roleTypes []string
+	for roleType := range usersByRoleType {
+		roleTypes = append(roleTypes, string(roleType))
+	}
+	sort.Strings(roleTypes)
+
+	var users []resources.User
+	rolesByUserGUID := map[string][]string{}
+	for _, roleType := range roleTypes {
+		for _, user := range usersByRoleType[constant.RoleType(roleType)] {
+			if _, seen := rolesByUserGUID[user.GUID]; !seen {
+				users = append(users, user)
+			}
+			rolesByUserGUID[user.GUID] = append(rolesByUserGUID[user.GUID], roleType)
+		}
+	}
+	v7action.SortUsers(users)
+
+	outputs := []UserOutput{}
+	for _, user := range users {
+		outputs = append(outputs, UserOutput{
+			GUID:     user.GUID,
+			Username: user.PresentationName,
+			Origin:   v7action.GetHumanReadableOrigin(user),
+			Roles:    rolesByUserGUID[user.GUID],
+		})
+	}
+	return outputs
+}
+
+func NewServiceOfferingOutputs(offerings []v7action.ServiceOfferingWithPlans, withPlans bool) []ServiceOfferingOutput {
+	outputs := []ServiceOfferingOutput{}
+	for _, offering := range offerings {
+		output := ServiceOfferingOutput{
+			Name:        offering.Name,
+			GUID:        offering.GUID,
+			Description: offering.Description,
+			Broker:      offering.ServiceBrokerName,
+		}
+		if withPlans {
+			output.Plans = []ServicePlanOutput{}
+			for _, plan := range offering.Plans {
+				output.Plans = append(output.Plans, ServicePlanOutput{
+					Name:        plan.Name,
+					GUID:        plan.GUID,
+					Description: plan.Description,
+					Free:        plan.Free,
+					Available:   plan.Available,
+					Costs:       plan.Costs,
+				})
+			}
+		}
+		outputs = append(outputs, output)
+	}
+	return outputs
+}
@@ -0,0 +1,44 @@
+package shared
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/types"
+)
+
+var sparklineBars = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline renders the values as a row of block characters scaled between
+// the smallest and the largest set value. Unset values are rendered as
+// spaces.
+func Sparkline(values []types.NullFloat64) string {
+	var min, max float64
+	first := true
+	for _, value := range values {
+		if !value.IsSet {
+			continue
+		}
+		if first || value.Value < min {
+			min = value.Value
+		}
+		if first || value.Value > max {
+			max = value.Value
+		}
+		first = false
+	}
+
+	var sparkline strings.Builder
+	for _, value := range values {
+		if !value.IsSet {
+			sparkline.WriteRune(' ')
+			continue
+		}
+
+		bar := 0
+		if max > min {
+			bar = int((value.Value - min) / (max - min) * float64(len(sparklineBars)-1))
+		}
+		sparkline.WriteRune(sparklineBars[bar])
+	}
+	return sparkline.String()
+}
@@ -0,0 +1,30 @@
+package shared_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sparkline", func() {
+	set := func(value float64) types.NullFloat64 {
+		return types.NullFloat64{IsSet: true, Value: value}
+	}
+
+	It("scales the values between the smallest and the largest", func() {
+		Expect(Sparkline([]types.NullFloat64{set(0), set(5), set(10), set(7)})).To(Equal("▁▄█▅"))
+	})
+
+	It("renders unset values as spaces", func() {
+		Expect(Sparkline([]types.NullFloat64{set(1), {}, set(3)})).To(Equal("▁ █"))
+	})
+
+	It("renders constant values as the lowest bar", func() {
+		Expect(Sparkline([]types.NullFloat64{set(4), set(4)})).To(Equal("▁▁"))
+	})
+
+	It("renders nothing without values", func() {
+		Expect(Sparkline(nil)).To(BeEmpty())
+	})
+})
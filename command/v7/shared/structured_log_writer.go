@@ -0,0 +1,147 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+const (
+	LogFormatJSON   = "json"
+	LogFormatNDJSON = "ndjson"
+	LogFormatLogfmt = "logfmt"
+)
+
+// StructuredLogWriter writes log messages as json, ndjson or logfmt records
+// instead of the human readable format. json output is a single array, so
+// Close must be called once every message has been written.
+type StructuredLogWriter struct {
+	out     io.Writer
+	format  string
+	written int
+}
+
+type structuredLogRecord struct {
+	Timestamp  string `json:"timestamp"`
+	App        string `json:"app,omitempty"`
+	SourceType string `json:"source_type"`
+	Instance   string `json:"instance"`
+	Stream     string `json:"stream"`
+	Message    string `json:"message"`
+}
+
+func NewStructuredLogWriter(out io.Writer, format string) *StructuredLogWriter {
+	return &StructuredLogWriter{
+		out:    out,
+		format: format,
+	}
+}
+
+// Write writes one log message. appName is only included in the record when
+// it is not empty.
+func (w *StructuredLogWriter) Write(appName string, message ui.LogMessage) error {
+	record := structuredLogRecord{
+		Timestamp:  message.Timestamp().UTC().Format(time.RFC3339Nano),
+		App:        appName,
+		SourceType: message.SourceType(),
+		Instance:   message.SourceInstance(),
+		Stream:     message.Type(),
+		Message:    strings.TrimRight(message.Message(), "\r\n"),
+	}
+
+	var err error
+	switch w.format {
+	case LogFormatLogfmt:
+		_, err = fmt.Fprintln(w.out, logfmtRecord(record))
+	case LogFormatJSON:
+		err = w.writeJSONElement(record)
+	default:
+		var line []byte
+		line, err = marshalLogRecord(record)
+		if err == nil {
+			_, err = fmt.Fprintf(w.out, "%s\n", line)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	w.written++
+	return nil
+}
+
+// Close terminates the json array. It does nothing for the other formats.
+func (w *StructuredLogWriter) Close() error {
+	if w.format != LogFormatJSON {
+		return nil
+	}
+
+	if w.written == 0 {
+		_, err := fmt.Fprintln(w.out, "[]")
+		return err
+	}
+	_, err := fmt.Fprint(w.out, "\n]\n")
+	return err
+}
+
+func (w *StructuredLogWriter) writeJSONElement(record structuredLogRecord) error {
+	element, err := marshalLogRecord(record)
+	if err != nil {
+		return err
+	}
+
+	separator := ",\n"
+	if w.written == 0 {
+		separator = "[\n"
+	}
+	_, err = fmt.Fprintf(w.out, "%s  %s", separator, element)
+	return err
+}
+
+func marshalLogRecord(record structuredLogRecord) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(record); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buffer.Bytes(), "\n"), nil
+}
+
+func logfmtRecord(record structuredLogRecord) string {
+	fields := [][2]string{
+		{"timestamp", record.Timestamp},
+		{"app", record.App},
+		{"source_type", record.SourceType},
+		{"instance", record.Instance},
+		{"stream", record.Stream},
+		{"message", record.Message},
+	}
+
+	var pairs []string
+	for _, field := range fields {
+		if field[0] == "app" && field[1] == "" {
+			continue
+		}
+		pairs = append(pairs, field[0]+"="+logfmtValue(field[1]))
+	}
+	return strings.Join(pairs, " ")
+}
+
+func logfmtValue(value string) string {
+	needsQuoting := value == "" || strings.IndexFunc(value, func(r rune) bool {
+		return r == '=' || r == '"' || unicode.IsSpace(r) || !unicode.IsPrint(r)
+	}) >= 0
+
+	if needsQuoting {
+		return strconv.Quote(value)
+	}
+	return value
+}
@@ -0,0 +1,81 @@
+package shared_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	. "code.cloudfoundry.org/cli/command/v7/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("StructuredLogWriter", func() {
+	var (
+		output   *Buffer
+		writer   *StructuredLogWriter
+		message  *sharedaction.LogMessage
+		errorLog *sharedaction.LogMessage
+	)
+
+	BeforeEach(func() {
+		output = NewBuffer()
+		message = sharedaction.NewLogMessage("GET /health <200>\n", "OUT", time.Date(2021, 3, 4, 5, 6, 7, 800000000, time.UTC), "APP/PROC/WEB", "0")
+		errorLog = sharedaction.NewLogMessage(`failed: key="value"`, "ERR", time.Date(2021, 3, 4, 5, 6, 8, 0, time.UTC), "STG", "1")
+	})
+
+	When("the format is ndjson", func() {
+		BeforeEach(func() {
+			writer = NewStructuredLogWriter(output, LogFormatNDJSON)
+		})
+
+		It("writes one json object per line", func() {
+			Expect(writer.Write("", message)).To(Succeed())
+			Expect(writer.Write("worker", errorLog)).To(Succeed())
+			Expect(writer.Close()).To(Succeed())
+
+			Expect(string(output.Contents())).To(Equal(
+				`{"timestamp":"2021-03-04T05:06:07.8Z","source_type":"APP/PROC/WEB","instance":"0","stream":"OUT","message":"GET /health <200>"}` + "\n" +
+					`{"timestamp":"2021-03-04T05:06:08Z","app":"worker","source_type":"STG","instance":"1","stream":"ERR","message":"failed: key=\"value\""}` + "\n",
+			))
+		})
+	})
+
+	When("the format is json", func() {
+		BeforeEach(func() {
+			writer = NewStructuredLogWriter(output, LogFormatJSON)
+		})
+
+		It("writes a single array", func() {
+			Expect(writer.Write("web", message)).To(Succeed())
+			Expect(writer.Write("web", errorLog)).To(Succeed())
+			Expect(writer.Close()).To(Succeed())
+
+			var records []map[string]string
+			Expect(json.Unmarshal(output.Contents(), &records)).To(Succeed())
+			Expect(records).To(HaveLen(2))
+			Expect(records[0]).To(HaveKeyWithValue("app", "web"))
+			Expect(records[1]).To(HaveKeyWithValue("stream", "ERR"))
+		})
+
+		It("writes an empty array when there are no messages", func() {
+			Expect(writer.Close()).To(Succeed())
+			Expect(string(output.Contents())).To(Equal("[]\n"))
+		})
+	})
+
+	When("the format is logfmt", func() {
+		BeforeEach(func() {
+			writer = NewStructuredLogWriter(output, LogFormatLogfmt)
+		})
+
+		It("writes key=value pairs, quoting values when necessary", func() {
+			Expect(writer.Write("", message)).To(Succeed())
+			Expect(writer.Write("worker", errorLog)).To(Succeed())
+
+			Expect(output).To(Say(`timestamp=2021-03-04T05:06:07.8Z source_type=APP/PROC/WEB instance=0 stream=OUT message="GET /health <200>"\n`))
+			Expect(output).To(Say(`timestamp=2021-03-04T05:06:08Z app=worker source_type=STG instance=1 stream=ERR message="failed: key=\\"value\\""\n`))
+		})
+	})
+})
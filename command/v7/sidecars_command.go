@@ -0,0 +1,107 @@
+package v7
+
+import (
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type SidecarsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME sidecars APP_NAME"`
+	relatedCommands interface{}  `related_commands:"app, create-sidecar, delete-sidecar, update-sidecar"`
+}
+
+func (SidecarsCommand) SupportsStructuredOutput() {}
+
+func (cmd SidecarsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatDefault {
+		cmd.UI.DisplayTextWithFlavor("Getting sidecars of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	sidecars, warnings, err := cmd.Actor.GetApplicationSidecars(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if outputFormat != configv3.OutputFormatDefault {
+		return shared.DisplayOutputList(cmd.UI, outputFormat, "sidecars", shared.NewSidecarOutputs(sidecars))
+	}
+
+	if len(sidecars) == 0 {
+		cmd.UI.DisplayText("No sidecars found.")
+		return nil
+	}
+
+	cmd.displaySidecars(sidecars)
+
+	return nil
+}
+
+// displaySidecars displays one row per process a sidecar runs alongside,
+// sorted by process type.
+func (cmd SidecarsCommand) displaySidecars(sidecars []resources.Sidecar) {
+	var rows [][]string
+	for _, sidecar := range sidecars {
+		memory := ""
+		if sidecar.MemoryInMB.IsSet {
+			memory = bytefmt.ByteSize(sidecar.MemoryInMB.Value * bytefmt.MEGABYTE)
+		}
+
+		for _, processType := range sidecar.ProcessTypes {
+			rows = append(rows, []string{
+				processType,
+				sidecar.Name,
+				memory,
+				sidecar.Command.Value,
+				sidecar.Origin,
+			})
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i][0] != rows[j][0] {
+			return strings.ToLower(rows[i][0]) < strings.ToLower(rows[j][0])
+		}
+		return strings.ToLower(rows[i][1]) < strings.ToLower(rows[j][1])
+	})
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("process"),
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("memory"),
+			cmd.UI.TranslateText("command"),
+			cmd.UI.TranslateText("origin"),
+		},
+	}
+	table = append(table, rows...)
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
@@ -0,0 +1,150 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("sidecars Command", func() {
+	var (
+		cmd             SidecarsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = SidecarsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the sidecars fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSidecarsReturns(nil, v7action.Warnings{"get-warning"}, errors.New("get-error"))
+		})
+
+		It("displays warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError("get-error"))
+			Expect(testUI.Err).To(Say("get-warning"))
+		})
+	})
+
+	When("the app has no sidecars", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSidecarsReturns(nil, v7action.Warnings{"get-warning"}, nil)
+		})
+
+		It("says no sidecars were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting sidecars of app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("No sidecars found."))
+			Expect(testUI.Err).To(Say("get-warning"))
+		})
+	})
+
+	When("the app has sidecars", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSidecarsReturns([]resources.Sidecar{
+				{
+					GUID:         "sidecar-guid-1",
+					Name:         "shipper",
+					Command:      *types.NewFilteredString("./shipper"),
+					ProcessTypes: []string{"worker", "web"},
+					MemoryInMB:   types.NullUint64{IsSet: true, Value: 64},
+					Origin:       "user",
+				},
+				{
+					GUID:         "sidecar-guid-2",
+					Name:         "proxy",
+					Command:      *types.NewFilteredString("./proxy"),
+					ProcessTypes: []string{"web"},
+					Origin:       "buildpack",
+				},
+			}, v7action.Warnings{"get-warning"}, nil)
+		})
+
+		It("displays one row per process, sorted by process and name", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetApplicationSidecarsCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationSidecarsArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`process\s+name\s+memory\s+command\s+origin`))
+			Expect(testUI.Out).To(Say(`web\s+proxy\s+\./proxy\s+buildpack`))
+			Expect(testUI.Out).To(Say(`web\s+shipper\s+64M\s+\./shipper\s+user`))
+			Expect(testUI.Out).To(Say(`worker\s+shipper\s+64M\s+\./shipper\s+user`))
+			Expect(testUI.Err).To(Say("get-warning"))
+		})
+
+		When("the output format is json", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			})
+
+			It("displays a versioned json document instead of the table", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting sidecars"))
+				Expect(testUI.Out).To(Say(`"kind": "sidecars",`))
+				Expect(testUI.Out).To(Say(`"name": "shipper",`))
+				Expect(testUI.Out).To(Say(`"guid": "sidecar-guid-1",`))
+				Expect(testUI.Out).To(Say(`"memory_in_mb": 64,`))
+				Expect(testUI.Out).To(Say(`"name": "proxy",`))
+			})
+		})
+	})
+})
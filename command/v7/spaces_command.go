@@ -1,7 +1,9 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
@@ -13,6 +15,8 @@ type SpacesCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter spaces by labels"`
 }
 
+func (SpacesCommand) SupportsStructuredOutput() {}
+
 func (cmd SpacesCommand) Execute([]string) error {
 	err := cmd.SharedActor.CheckTarget(true, false)
 	if err != nil {
@@ -24,11 +28,14 @@ func (cmd SpacesCommand) Execute([]string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting spaces in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
-		"OrgName":     cmd.Config.TargetedOrganization().Name,
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	outputFormat := cmd.Config.OutputFormat()
+	if outputFormat == configv3.OutputFormatDefault {
+		cmd.UI.DisplayTextWithFlavor("Getting spaces in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	spaces, warnings, err := cmd.Actor.GetOrganizationSpacesWithLabelSelector(cmd.Config.TargetedOrganization().GUID, cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
@@ -36,6 +43,10 @@ func (cmd SpacesCommand) Execute([]string) error {
 		return err
 	}
 
+	if outputFormat != configv3.OutputFormatDefault {
+		return shared.DisplayOutputList(cmd.UI, outputFormat, "spaces", shared.NewSpaceOutputs(spaces))
+	}
+
 	if len(spaces) == 0 {
 		cmd.UI.DisplayText("No spaces found.")
 	} else {
@@ -0,0 +1,200 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+const (
+	defaultTopInterval = 5 * time.Second
+	topCrashWindow     = time.Hour
+
+	clearScreen = "\x1b[H\x1b[2J"
+)
+
+type TopCommand struct {
+	BaseCommand
+
+	Sort            flag.TopSortColumn    `long:"sort" description:"Column to sort by: app, cpu, crashes, disk, log-rate, memory or state (Default: app)"`
+	Interval        flag.PositiveDuration `long:"interval" description:"Time between refreshes, such as 2s or 1m (Default: 5s)"`
+	Once            bool                  `long:"once" description:"Display a single snapshot and exit"`
+	usage           interface{}           `usage:"CF_NAME top [--sort COLUMN] [--interval DURATION] [--once]\n\nEXAMPLES:\n   CF_NAME top\n   CF_NAME top --sort cpu --interval 2s\n   CF_NAME top --sort crashes --once"`
+	relatedCommands interface{}           `related_commands:"app, app-metrics, apps, logs"`
+
+	LogCacheClient sharedaction.LogCacheClient
+}
+
+func (cmd *TopCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	return err
+}
+
+func (cmd TopCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	interval := cmd.Interval.Duration
+	if !cmd.Interval.IsSet() {
+		interval = defaultTopInterval
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	for refresh := 0; ; refresh++ {
+		usages, warnings, err := cmd.Actor.GetInstanceUsageForSpace(cmd.Config.TargetedSpace().GUID, cmd.LogCacheClient, time.Now().Add(-topCrashWindow))
+		if err != nil {
+			cmd.UI.DisplayWarnings(warnings)
+			return err
+		}
+
+		switch {
+		case cmd.Once:
+		case cmd.Config.IsTTY():
+			fmt.Fprint(cmd.UI.GetOut(), clearScreen)
+		case refresh > 0:
+			cmd.UI.DisplayNewline()
+		}
+		cmd.displayUsages(user.Name, interval, usages)
+		cmd.UI.DisplayWarnings(warnings)
+
+		if cmd.Once {
+			return nil
+		}
+
+		select {
+		case <-interrupt:
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (cmd TopCommand) displayUsages(username string, interval time.Duration, usages []v7action.InstanceUsage) {
+	cmd.UI.DisplayTextWithFlavor("Showing app instances in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  username,
+	})
+	if !cmd.Once {
+		cmd.UI.DisplayText("Updated at {{.Time}}, refreshing every {{.Interval}}. Press Ctrl-C to quit.", map[string]interface{}{
+			"Time":     time.Now().Format("15:04:05"),
+			"Interval": interval,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	if len(usages) == 0 {
+		cmd.UI.DisplayText("No apps found")
+		return
+	}
+
+	sortInstanceUsages(usages, cmd.Sort)
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("process"),
+			cmd.UI.TranslateText("instance"),
+			cmd.UI.TranslateText("state"),
+			cmd.UI.TranslateText("cpu"),
+			cmd.UI.TranslateText("memory"),
+			cmd.UI.TranslateText("disk"),
+			cmd.UI.TranslateText("log rate"),
+			cmd.UI.TranslateText("crashes (1h)"),
+		},
+	}
+
+	for _, usage := range usages {
+		if usage.Index < 0 {
+			table = append(table, []string{
+				usage.AppName, "", "", cmd.UI.TranslateText(instanceUsageState(usage)), "", "", "", "", "",
+			})
+			continue
+		}
+
+		cpu := "-"
+		if usage.CPU.IsSet {
+			cpu = fmt.Sprintf("%.1f%%", usage.CPU.Value)
+		}
+
+		table = append(table, []string{
+			usage.AppName,
+			usage.ProcessType,
+			fmt.Sprintf("#%d", usage.Index),
+			cmd.UI.TranslateText(instanceUsageState(usage)),
+			cpu,
+			cmd.UI.TranslateText("{{.MemUsage}} of {{.MemQuota}}", map[string]interface{}{
+				"MemUsage": bytefmt.ByteSize(usage.MemoryUsage),
+				"MemQuota": bytefmt.ByteSize(usage.MemoryQuota),
+			}),
+			cmd.UI.TranslateText("{{.DiskUsage}} of {{.DiskQuota}}", map[string]interface{}{
+				"DiskUsage": bytefmt.ByteSize(usage.DiskUsage),
+				"DiskQuota": bytefmt.ByteSize(usage.DiskQuota),
+			}),
+			bytefmt.ByteSize(usage.LogRate) + "/s",
+			strconv.Itoa(usage.Crashes),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func instanceUsageState(usage v7action.InstanceUsage) string {
+	if usage.Index < 0 {
+		return strings.ToLower(string(usage.AppState))
+	}
+	return strings.ToLower(string(usage.State))
+}
+
+// sortInstanceUsages orders the usages by the column, largest first for
+// numeric columns. Ties keep the app, process and instance order.
+func sortInstanceUsages(usages []v7action.InstanceUsage, column flag.TopSortColumn) {
+	var less func(a, b v7action.InstanceUsage) bool
+	switch column {
+	case "cpu":
+		less = func(a, b v7action.InstanceUsage) bool { return a.CPU.Value > b.CPU.Value }
+	case "crashes":
+		less = func(a, b v7action.InstanceUsage) bool { return a.Crashes > b.Crashes }
+	case "disk":
+		less = func(a, b v7action.InstanceUsage) bool { return a.DiskUsage > b.DiskUsage }
+	case "log-rate":
+		less = func(a, b v7action.InstanceUsage) bool { return a.LogRate > b.LogRate }
+	case "memory":
+		less = func(a, b v7action.InstanceUsage) bool { return a.MemoryUsage > b.MemoryUsage }
+	case "state":
+		less = func(a, b v7action.InstanceUsage) bool { return instanceUsageState(a) < instanceUsageState(b) }
+	default:
+		return
+	}
+
+	sort.SliceStable(usages, func(i, j int) bool {
+		return less(usages[i], usages[j])
+	})
+}
@@ -0,0 +1,190 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("top Command", func() {
+	var (
+		cmd                v7.TopCommand
+		testUI             *ui.UI
+		fakeConfig         *commandfakes.FakeConfig
+		fakeSharedActor    *commandfakes.FakeSharedActor
+		fakeActor          *v7fakes.FakeActor
+		fakeLogCacheClient *sharedactionfakes.FakeLogCacheClient
+		binaryName         string
+		usages             []v7action.InstanceUsage
+		executeErr         error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v7.TopCommand{
+			Once: true,
+
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			LogCacheClient: fakeLogCacheClient,
+		}
+
+		usages = []v7action.InstanceUsage{
+			{
+				AppName: "app-a", AppState: constant.ApplicationStarted, ProcessType: "web", Index: 0,
+				State: constant.ProcessInstanceRunning, CPU: types.NullFloat64{IsSet: true, Value: 1.5},
+				MemoryUsage: 32 * 1024 * 1024, MemoryQuota: 256 * 1024 * 1024,
+				DiskUsage: 64 * 1024 * 1024, DiskQuota: 1024 * 1024 * 1024,
+				LogRate: 1024,
+			},
+			{
+				AppName: "app-a", AppState: constant.ApplicationStarted, ProcessType: "web", Index: 1,
+				State: constant.ProcessInstanceCrashed, Crashes: 3,
+				MemoryQuota: 256 * 1024 * 1024, DiskQuota: 1024 * 1024 * 1024,
+			},
+			{AppName: "app-b", AppState: constant.ApplicationStopped, Index: -1},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+			fakeActor.GetInstanceUsageForSpaceReturns(usages, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("gets the usage of the targeted space with an hour of crashes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetInstanceUsageForSpaceCallCount()).To(Equal(1))
+			spaceGUID, client, crashesSince := fakeActor.GetInstanceUsageForSpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(client).To(Equal(fakeLogCacheClient))
+			Expect(crashesSince).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Second))
+		})
+
+		It("displays a row for every instance", func() {
+			Expect(testUI.Out).To(Say(`Showing app instances in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`app\s+process\s+instance\s+state\s+cpu\s+memory\s+disk\s+log rate\s+crashes \(1h\)`))
+			Expect(testUI.Out).To(Say(`app-a\s+web\s+#0\s+running\s+1\.5%\s+32M of 256M\s+64M of 1G\s+1K/s\s+0`))
+			Expect(testUI.Out).To(Say(`app-a\s+web\s+#1\s+crashed\s+-\s+0B of 256M\s+0B of 1G\s+0B/s\s+3`))
+			Expect(testUI.Out).To(Say(`app-b\s+stopped`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		It("does not show the refresh banner", func() {
+			Expect(testUI.Out).ToNot(Say("refreshing every"))
+		})
+
+		When("sorting by a column", func() {
+			BeforeEach(func() {
+				cmd.Sort = flag.TopSortColumn("crashes")
+			})
+
+			It("puts the largest values first", func() {
+				Expect(testUI.Out).To(Say(`app-a\s+web\s+#1`))
+				Expect(testUI.Out).To(Say(`app-a\s+web\s+#0`))
+				Expect(testUI.Out).To(Say(`app-b`))
+			})
+		})
+
+		When("there are no apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetInstanceUsageForSpaceReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(testUI.Out).To(Say("No apps found"))
+			})
+		})
+
+		When("refreshing continuously", func() {
+			BeforeEach(func() {
+				cmd.Once = false
+				cmd.Interval = flag.PositiveDuration{Duration: 10 * time.Millisecond}
+				fakeActor.GetInstanceUsageForSpaceReturnsOnCall(0, usages, nil, nil)
+				fakeActor.GetInstanceUsageForSpaceReturnsOnCall(1, usages, nil, nil)
+				fakeActor.GetInstanceUsageForSpaceReturnsOnCall(2, nil, v7action.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("redraws the table every interval until getting the usage fails", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(fakeActor.GetInstanceUsageForSpaceCallCount()).To(Equal(3))
+
+				Expect(testUI.Out).To(Say(`refreshing every 10ms\. Press Ctrl-C to quit\.`))
+				Expect(testUI.Out).To(Say(`app-a\s+web\s+#0`))
+				Expect(testUI.Out).To(Say(`refreshing every 10ms\.`))
+				Expect(testUI.Out).To(Say(`app-a\s+web\s+#0`))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+
+			When("the output is a terminal", func() {
+				BeforeEach(func() {
+					fakeConfig.IsTTYReturns(true)
+				})
+
+				It("clears the screen before each refresh", func() {
+					Expect(testUI.Out).To(Say(`\x1b\[H\x1b\[2J`))
+					Expect(testUI.Out).To(Say(`app-a\s+web\s+#0`))
+					Expect(testUI.Out).To(Say(`\x1b\[H\x1b\[2J`))
+				})
+			})
+		})
+
+		When("getting the usage fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetInstanceUsageForSpaceReturns(nil, v7action.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("displays warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type UpdateSidecarCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppSidecarArgs `positional-args:"yes"`
+	Command         string              `long:"command" short:"c" description:"The command used to start the sidecar"`
+	ProcessTypes    []string            `long:"process" description:"App process the sidecar runs alongside, can be given multiple times; replaces the current processes"`
+	Memory          flag.Megabytes      `long:"memory" short:"m" description:"Memory reserved for the sidecar out of its process' memory, with a unit of measurement in M or G (e.g. 256M, 1G)"`
+	usage           interface{}         `usage:"CF_NAME update-sidecar APP_NAME SIDECAR_NAME [-c COMMAND] [--process PROCESS_TYPE]... [-m MEMORY]"`
+	relatedCommands interface{}         `related_commands:"create-sidecar, delete-sidecar, restart, sidecars"`
+}
+
+func (cmd UpdateSidecarCommand) Execute(args []string) error {
+	if cmd.Command == "" && len(cmd.ProcessTypes) == 0 && !cmd.Memory.IsSet {
+		return translatableerror.IncorrectUsageError{Message: "at least one of --command, --process or --memory must be provided"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating sidecar {{.SidecarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"SidecarName": cmd.RequiredArgs.SidecarName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+
+	changes := resources.Sidecar{
+		ProcessTypes: cmd.ProcessTypes,
+		MemoryInMB:   cmd.Memory.NullUint64,
+	}
+	if cmd.Command != "" {
+		changes.Command = *types.NewFilteredString(cmd.Command)
+	}
+
+	_, warnings, err := cmd.Actor.UpdateApplicationSidecar(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SidecarName, changes)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	displaySidecarRestartTip(cmd.UI, cmd.Config.BinaryName(), cmd.RequiredArgs.AppName)
+
+	return nil
+}
+
+// displaySidecarRestartTip reminds the user that running instances keep their
+// current sidecars until the app is restarted.
+func displaySidecarRestartTip(ui command.UI, binaryName string, appName string) {
+	ui.DisplayNewline()
+	ui.DisplayText("TIP: Use '{{.BinaryName}} restart {{.AppName}}' to ensure your sidecar changes take effect.", map[string]interface{}{
+		"BinaryName": binaryName,
+		"AppName":    appName,
+	})
+}
@@ -0,0 +1,118 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-sidecar Command", func() {
+	var (
+		cmd             UpdateSidecarCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = UpdateSidecarCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.SidecarName = "proxy"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.UpdateApplicationSidecarReturns(resources.Sidecar{GUID: "sidecar-guid"}, v7action.Warnings{"update-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("no flags are provided", func() {
+		It("returns an incorrect usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+				Message: "at least one of --command, --process or --memory must be provided",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("flags are provided", func() {
+		BeforeEach(func() {
+			cmd.Command = "./proxy --verbose"
+			cmd.Memory = flag.Megabytes{NullUint64: types.NullUint64{IsSet: true, Value: 128}}
+		})
+
+		It("updates only the provided fields", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.UpdateApplicationSidecarCallCount()).To(Equal(1))
+			appName, spaceGUID, sidecarName, changes := fakeActor.UpdateApplicationSidecarArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(sidecarName).To(Equal("proxy"))
+			Expect(changes).To(Equal(resources.Sidecar{
+				Command:    *types.NewFilteredString("./proxy --verbose"),
+				MemoryInMB: types.NullUint64{IsSet: true, Value: 128},
+			}))
+
+			Expect(testUI.Out).To(Say(`Updating sidecar proxy for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`TIP: Use 'faceman restart some-app'`))
+			Expect(testUI.Err).To(Say("update-warning"))
+		})
+
+		When("checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+				Expect(fakeActor.UpdateApplicationSidecarCallCount()).To(Equal(0))
+			})
+		})
+
+		When("updating the sidecar fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationSidecarReturns(resources.Sidecar{}, v7action.Warnings{"update-warning"}, errors.New("update-error"))
+			})
+
+			It("displays warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError("update-error"))
+				Expect(testUI.Err).To(Say("update-warning"))
+			})
+		})
+	})
+})
@@ -18,6 +18,8 @@ import (
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/spacebundle"
 	"github.com/SermoDigital/jose/jwt"
 )
 
@@ -36,6 +38,22 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	ApplySpacePlanStub        func(v7action.SpacePlan, v7action.SpaceNetworkingActor, v7action.SpacePushActor, func(v7action.SpaceApplyStep)) (v7action.Warnings, error)
+	applySpacePlanMutex       sync.RWMutex
+	applySpacePlanArgsForCall []struct {
+		arg1 v7action.SpacePlan
+		arg2 v7action.SpaceNetworkingActor
+		arg3 v7action.SpacePushActor
+		arg4 func(v7action.SpaceApplyStep)
+	}
+	applySpacePlanReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	applySpacePlanReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	ApplySpaceQuotaByNameStub        func(string, string, string) (v7action.Warnings, error)
 	applySpaceQuotaByNameMutex       sync.RWMutex
 	applySpaceQuotaByNameArgsForCall []struct {
@@ -205,6 +223,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	CreateApplicationSidecarStub        func(string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)
+	createApplicationSidecarMutex       sync.RWMutex
+	createApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 resources.Sidecar
+	}
+	createApplicationSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	createApplicationSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	CreateBitsPackageByApplicationStub        func(string) (resources.Package, v7action.Warnings, error)
 	createBitsPackageByApplicationMutex       sync.RWMutex
 	createBitsPackageByApplicationArgsForCall []struct {
@@ -572,6 +607,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	DeleteApplicationSidecarStub        func(string, string, string) (v7action.Warnings, error)
+	deleteApplicationSidecarMutex       sync.RWMutex
+	deleteApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	deleteApplicationSidecarReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	deleteApplicationSidecarReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	DeleteBuildpackByNameAndStackAndLifecycleStub        func(string, string, string) (v7action.Warnings, error)
 	deleteBuildpackByNameAndStackAndLifecycleMutex       sync.RWMutex
 	deleteBuildpackByNameAndStackAndLifecycleArgsForCall []struct {
@@ -909,40 +959,35 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	DownloadCurrentDropletByAppNameStub        func(string, string) ([]byte, string, v7action.Warnings, error)
-	downloadCurrentDropletByAppNameMutex       sync.RWMutex
-	downloadCurrentDropletByAppNameArgsForCall []struct {
-		arg1 string
-		arg2 string
+	DownloadDropletStub        func(resources.Droplet, io.Writer, v7action.DownloadProgressBar) (v7action.Warnings, error)
+	downloadDropletMutex       sync.RWMutex
+	downloadDropletArgsForCall []struct {
+		arg1 resources.Droplet
+		arg2 io.Writer
+		arg3 v7action.DownloadProgressBar
 	}
-	downloadCurrentDropletByAppNameReturns struct {
-		result1 []byte
-		result2 string
-		result3 v7action.Warnings
-		result4 error
+	downloadDropletReturns struct {
+		result1 v7action.Warnings
+		result2 error
 	}
-	downloadCurrentDropletByAppNameReturnsOnCall map[int]struct {
-		result1 []byte
-		result2 string
-		result3 v7action.Warnings
-		result4 error
+	downloadDropletReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
 	}
-	DownloadDropletByGUIDAndAppNameStub        func(string, string, string) ([]byte, v7action.Warnings, error)
-	downloadDropletByGUIDAndAppNameMutex       sync.RWMutex
-	downloadDropletByGUIDAndAppNameArgsForCall []struct {
-		arg1 string
-		arg2 string
-		arg3 string
+	DownloadPackageStub        func(resources.Package, io.Writer, v7action.DownloadProgressBar) (v7action.Warnings, error)
+	downloadPackageMutex       sync.RWMutex
+	downloadPackageArgsForCall []struct {
+		arg1 resources.Package
+		arg2 io.Writer
+		arg3 v7action.DownloadProgressBar
 	}
-	downloadDropletByGUIDAndAppNameReturns struct {
-		result1 []byte
-		result2 v7action.Warnings
-		result3 error
+	downloadPackageReturns struct {
+		result1 v7action.Warnings
+		result2 error
 	}
-	downloadDropletByGUIDAndAppNameReturnsOnCall map[int]struct {
-		result1 []byte
-		result2 v7action.Warnings
-		result3 error
+	downloadPackageReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
 	}
 	EnableFeatureFlagStub        func(string) (v7action.Warnings, error)
 	enableFeatureFlagMutex       sync.RWMutex
@@ -989,6 +1034,62 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	ExportSpaceStub        func(string) (v7action.SpaceExport, v7action.Warnings, error)
+	exportSpaceMutex       sync.RWMutex
+	exportSpaceArgsForCall []struct {
+		arg1 string
+	}
+	exportSpaceReturns struct {
+		result1 v7action.SpaceExport
+		result2 v7action.Warnings
+		result3 error
+	}
+	exportSpaceReturnsOnCall map[int]struct {
+		result1 v7action.SpaceExport
+		result2 v7action.Warnings
+		result3 error
+	}
+	FinishBrowserLoginStub        func(v7action.BrowserLogin) error
+	finishBrowserLoginMutex       sync.RWMutex
+	finishBrowserLoginArgsForCall []struct {
+		arg1 v7action.BrowserLogin
+	}
+	finishBrowserLoginReturns struct {
+		result1 error
+	}
+	finishBrowserLoginReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FinishDeviceLoginStub        func(v7action.DeviceLogin) error
+	finishDeviceLoginMutex       sync.RWMutex
+	finishDeviceLoginArgsForCall []struct {
+		arg1 v7action.DeviceLogin
+	}
+	finishDeviceLoginReturns struct {
+		result1 error
+	}
+	finishDeviceLoginReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetAppCrashesByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient, time.Time, int) (v7action.AppCrashes, v7action.Warnings, error)
+	getAppCrashesByNameAndSpaceMutex       sync.RWMutex
+	getAppCrashesByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 time.Time
+		arg5 int
+	}
+	getAppCrashesByNameAndSpaceReturns struct {
+		result1 v7action.AppCrashes
+		result2 v7action.Warnings
+		result3 error
+	}
+	getAppCrashesByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v7action.AppCrashes
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetAppFeatureStub        func(string, string) (resources.ApplicationFeature, v7action.Warnings, error)
 	getAppFeatureMutex       sync.RWMutex
 	getAppFeatureArgsForCall []struct {
@@ -1005,6 +1106,24 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetAppRequestsByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient, time.Time) (v7action.AppRequests, v7action.Warnings, error)
+	getAppRequestsByNameAndSpaceMutex       sync.RWMutex
+	getAppRequestsByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 time.Time
+	}
+	getAppRequestsByNameAndSpaceReturns struct {
+		result1 v7action.AppRequests
+		result2 v7action.Warnings
+		result3 error
+	}
+	getAppRequestsByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v7action.AppRequests
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetAppSummariesForSpaceStub        func(string, string, bool) ([]v7action.ApplicationSummary, v7action.Warnings, error)
 	getAppSummariesForSpaceMutex       sync.RWMutex
 	getAppSummariesForSpaceArgsForCall []struct {
@@ -1085,6 +1204,24 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationMetricsByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient, v7action.AppMetricsQuery) ([]v7action.InstanceMetrics, v7action.Warnings, error)
+	getApplicationMetricsByNameAndSpaceMutex       sync.RWMutex
+	getApplicationMetricsByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 v7action.AppMetricsQuery
+	}
+	getApplicationMetricsByNameAndSpaceReturns struct {
+		result1 []v7action.InstanceMetrics
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationMetricsByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []v7action.InstanceMetrics
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationPackagesStub        func(string, string) ([]resources.Package, v7action.Warnings, error)
 	getApplicationPackagesMutex       sync.RWMutex
 	getApplicationPackagesArgsForCall []struct {
@@ -1163,6 +1300,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationSidecarsStub        func(string, string) ([]resources.Sidecar, v7action.Warnings, error)
+	getApplicationSidecarsMutex       sync.RWMutex
+	getApplicationSidecarsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationSidecarsReturns struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationSidecarsReturnsOnCall map[int]struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationTasksStub        func(string, v7action.SortOrder) ([]resources.Task, v7action.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -1228,6 +1381,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetCurrentDropletByAppNameStub        func(string, string) (resources.Droplet, v7action.Warnings, error)
+	getCurrentDropletByAppNameMutex       sync.RWMutex
+	getCurrentDropletByAppNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getCurrentDropletByAppNameReturns struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
+	getCurrentDropletByAppNameReturnsOnCall map[int]struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetCurrentUserStub        func() (configv3.User, error)
 	getCurrentUserMutex       sync.RWMutex
 	getCurrentUserArgsForCall []struct {
@@ -1255,6 +1424,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetDeploymentStatusStub        func(resources.Application, string) (v7action.DeploymentStatus, v7action.Warnings, error)
+	getDeploymentStatusMutex       sync.RWMutex
+	getDeploymentStatusArgsForCall []struct {
+		arg1 resources.Application
+		arg2 string
+	}
+	getDeploymentStatusReturns struct {
+		result1 v7action.DeploymentStatus
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDeploymentStatusReturnsOnCall map[int]struct {
+		result1 v7action.DeploymentStatus
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetDetailedAppSummaryStub        func(string, string, bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
 	getDetailedAppSummaryMutex       sync.RWMutex
 	getDetailedAppSummaryArgsForCall []struct {
@@ -1317,6 +1502,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetDropletByGUIDAndAppNameStub        func(string, string, string) (resources.Droplet, v7action.Warnings, error)
+	getDropletByGUIDAndAppNameMutex       sync.RWMutex
+	getDropletByGUIDAndAppNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getDropletByGUIDAndAppNameReturns struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDropletByGUIDAndAppNameReturnsOnCall map[int]struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetEffectiveIsolationSegmentBySpaceStub        func(string, string) (resources.IsolationSegment, v7action.Warnings, error)
 	getEffectiveIsolationSegmentBySpaceMutex       sync.RWMutex
 	getEffectiveIsolationSegmentBySpaceArgsForCall []struct {
@@ -1381,6 +1583,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetEventsStub        func(v7action.EventsQuery) ([]v7action.Event, v7action.Warnings, error)
+	getEventsMutex       sync.RWMutex
+	getEventsArgsForCall []struct {
+		arg1 v7action.EventsQuery
+	}
+	getEventsReturns struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
+	getEventsReturnsOnCall map[int]struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetFeatureFlagByNameStub        func(string) (resources.FeatureFlag, v7action.Warnings, error)
 	getFeatureFlagByNameMutex       sync.RWMutex
 	getFeatureFlagByNameArgsForCall []struct {
@@ -1410,6 +1627,46 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetFilteredRecentLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error)
+	getFilteredRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}
+	getFilteredRecentLogsForApplicationByNameAndSpaceReturns struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}
+	getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetFilteredStreamingLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
+	getFilteredStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}
+	getFilteredStreamingLogsForApplicationByNameAndSpaceReturns struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}
+	getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}
 	GetGlobalRunningSecurityGroupsStub        func() ([]resources.SecurityGroup, v7action.Warnings, error)
 	getGlobalRunningSecurityGroupsMutex       sync.RWMutex
 	getGlobalRunningSecurityGroupsArgsForCall []struct {
@@ -1452,6 +1709,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetInstanceUsageForSpaceStub        func(string, sharedaction.LogCacheClient, time.Time) ([]v7action.InstanceUsage, v7action.Warnings, error)
+	getInstanceUsageForSpaceMutex       sync.RWMutex
+	getInstanceUsageForSpaceArgsForCall []struct {
+		arg1 string
+		arg2 sharedaction.LogCacheClient
+		arg3 time.Time
+	}
+	getInstanceUsageForSpaceReturns struct {
+		result1 []v7action.InstanceUsage
+		result2 v7action.Warnings
+		result3 error
+	}
+	getInstanceUsageForSpaceReturnsOnCall map[int]struct {
+		result1 []v7action.InstanceUsage
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetIsolationSegmentByNameStub        func(string) (resources.IsolationSegment, v7action.Warnings, error)
 	getIsolationSegmentByNameMutex       sync.RWMutex
 	getIsolationSegmentByNameArgsForCall []struct {
@@ -1689,6 +1963,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetPackageByGUIDAndAppNameStub        func(string, string, string) (resources.Package, v7action.Warnings, error)
+	getPackageByGUIDAndAppNameMutex       sync.RWMutex
+	getPackageByGUIDAndAppNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getPackageByGUIDAndAppNameReturns struct {
+		result1 resources.Package
+		result2 v7action.Warnings
+		result3 error
+	}
+	getPackageByGUIDAndAppNameReturnsOnCall map[int]struct {
+		result1 resources.Package
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetProcessByTypeAndApplicationStub        func(string, string) (resources.Process, v7action.Warnings, error)
 	getProcessByTypeAndApplicationMutex       sync.RWMutex
 	getProcessByTypeAndApplicationArgsForCall []struct {
@@ -1721,22 +2012,6 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	GetRecentEventsByApplicationNameAndSpaceStub        func(string, string) ([]v7action.Event, v7action.Warnings, error)
-	getRecentEventsByApplicationNameAndSpaceMutex       sync.RWMutex
-	getRecentEventsByApplicationNameAndSpaceArgsForCall []struct {
-		arg1 string
-		arg2 string
-	}
-	getRecentEventsByApplicationNameAndSpaceReturns struct {
-		result1 []v7action.Event
-		result2 v7action.Warnings
-		result3 error
-	}
-	getRecentEventsByApplicationNameAndSpaceReturnsOnCall map[int]struct {
-		result1 []v7action.Event
-		result2 v7action.Warnings
-		result3 error
-	}
 	GetRecentLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error)
 	getRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
@@ -1754,6 +2029,25 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRecentLogsForApplicationsInSpaceStub        func([]string, string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) ([]sharedaction.AppLogMessage, v7action.Warnings, error)
+	getRecentLogsForApplicationsInSpaceMutex       sync.RWMutex
+	getRecentLogsForApplicationsInSpaceArgsForCall []struct {
+		arg1 []string
+		arg2 string
+		arg3 string
+		arg4 sharedaction.LogCacheClient
+		arg5 sharedaction.LogFilter
+	}
+	getRecentLogsForApplicationsInSpaceReturns struct {
+		result1 []sharedaction.AppLogMessage
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRecentLogsForApplicationsInSpaceReturnsOnCall map[int]struct {
+		result1 []sharedaction.AppLogMessage
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRevisionByApplicationAndVersionStub        func(string, int) (resources.Revision, v7action.Warnings, error)
 	getRevisionByApplicationAndVersionMutex       sync.RWMutex
 	getRevisionByApplicationAndVersionArgsForCall []struct {
@@ -2431,6 +2725,29 @@ type FakeActor struct {
 		result4 v7action.Warnings
 		result5 error
 	}
+	GetStreamingLogsForApplicationsInSpaceStub        func([]string, string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) (<-chan sharedaction.AppLogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
+	getStreamingLogsForApplicationsInSpaceMutex       sync.RWMutex
+	getStreamingLogsForApplicationsInSpaceArgsForCall []struct {
+		arg1 []string
+		arg2 string
+		arg3 string
+		arg4 sharedaction.LogCacheClient
+		arg5 sharedaction.LogFilter
+	}
+	getStreamingLogsForApplicationsInSpaceReturns struct {
+		result1 <-chan sharedaction.AppLogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}
+	getStreamingLogsForApplicationsInSpaceReturnsOnCall map[int]struct {
+		result1 <-chan sharedaction.AppLogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}
 	GetTaskBySequenceIDAndApplicationStub        func(int, string) (resources.Task, v7action.Warnings, error)
 	getTaskBySequenceIDAndApplicationMutex       sync.RWMutex
 	getTaskBySequenceIDAndApplicationArgsForCall []struct {
@@ -2564,6 +2881,27 @@ type FakeActor struct {
 		result1 jwt.JWT
 		result2 error
 	}
+	PlanSpaceStub        func(string, string, string, string, manifestparser.Manifest, spacebundle.Space, v7action.SpaceNetworkingActor) (v7action.SpacePlan, v7action.Warnings, error)
+	planSpaceMutex       sync.RWMutex
+	planSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 manifestparser.Manifest
+		arg6 spacebundle.Space
+		arg7 v7action.SpaceNetworkingActor
+	}
+	planSpaceReturns struct {
+		result1 v7action.SpacePlan
+		result2 v7action.Warnings
+		result3 error
+	}
+	planSpaceReturnsOnCall map[int]struct {
+		result1 v7action.SpacePlan
+		result2 v7action.Warnings
+		result3 error
+	}
 	PollBuildStub        func(string, string) (resources.Droplet, v7action.Warnings, error)
 	pollBuildMutex       sync.RWMutex
 	pollBuildArgsForCall []struct {
@@ -3109,6 +3447,31 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	StartBrowserLoginStub        func(string) (v7action.BrowserLogin, error)
+	startBrowserLoginMutex       sync.RWMutex
+	startBrowserLoginArgsForCall []struct {
+		arg1 string
+	}
+	startBrowserLoginReturns struct {
+		result1 v7action.BrowserLogin
+		result2 error
+	}
+	startBrowserLoginReturnsOnCall map[int]struct {
+		result1 v7action.BrowserLogin
+		result2 error
+	}
+	StartDeviceLoginStub        func() (v7action.DeviceLogin, error)
+	startDeviceLoginMutex       sync.RWMutex
+	startDeviceLoginArgsForCall []struct {
+	}
+	startDeviceLoginReturns struct {
+		result1 v7action.DeviceLogin
+		result2 error
+	}
+	startDeviceLoginReturnsOnCall map[int]struct {
+		result1 v7action.DeviceLogin
+		result2 error
+	}
 	StopApplicationStub        func(string) (v7action.Warnings, error)
 	stopApplicationMutex       sync.RWMutex
 	stopApplicationArgsForCall []struct {
@@ -3286,6 +3649,24 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateApplicationSidecarStub        func(string, string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)
+	updateApplicationSidecarMutex       sync.RWMutex
+	updateApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 resources.Sidecar
+	}
+	updateApplicationSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	updateApplicationSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	UpdateBuildpackByNameAndStackAndLifecycleStub        func(string, string, string, resources.Buildpack) (resources.Buildpack, v7action.Warnings, error)
 	updateBuildpackByNameAndStackAndLifecycleMutex       sync.RWMutex
 	updateBuildpackByNameAndStackAndLifecycleArgsForCall []struct {
@@ -3698,6 +4079,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	WaitForApplicationStub        func(resources.Application, v7action.AppWaitCondition, time.Duration) (v7action.Warnings, error)
+	waitForApplicationMutex       sync.RWMutex
+	waitForApplicationArgsForCall []struct {
+		arg1 resources.Application
+		arg2 v7action.AppWaitCondition
+		arg3 time.Duration
+	}
+	waitForApplicationReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	waitForApplicationReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -3767,6 +4163,73 @@ func (fake *FakeActor) ApplyOrganizationQuotaByNameReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeActor) ApplySpacePlan(arg1 v7action.SpacePlan, arg2 v7action.SpaceNetworkingActor, arg3 v7action.SpacePushActor, arg4 func(v7action.SpaceApplyStep)) (v7action.Warnings, error) {
+	fake.applySpacePlanMutex.Lock()
+	ret, specificReturn := fake.applySpacePlanReturnsOnCall[len(fake.applySpacePlanArgsForCall)]
+	fake.applySpacePlanArgsForCall = append(fake.applySpacePlanArgsForCall, struct {
+		arg1 v7action.SpacePlan
+		arg2 v7action.SpaceNetworkingActor
+		arg3 v7action.SpacePushActor
+		arg4 func(v7action.SpaceApplyStep)
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ApplySpacePlanStub
+	fakeReturns := fake.applySpacePlanReturns
+	fake.recordInvocation("ApplySpacePlan", []interface{}{arg1, arg2, arg3, arg4})
+	fake.applySpacePlanMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) ApplySpacePlanCallCount() int {
+	fake.applySpacePlanMutex.RLock()
+	defer fake.applySpacePlanMutex.RUnlock()
+	return len(fake.applySpacePlanArgsForCall)
+}
+
+func (fake *FakeActor) ApplySpacePlanCalls(stub func(v7action.SpacePlan, v7action.SpaceNetworkingActor, v7action.SpacePushActor, func(v7action.SpaceApplyStep)) (v7action.Warnings, error)) {
+	fake.applySpacePlanMutex.Lock()
+	defer fake.applySpacePlanMutex.Unlock()
+	fake.ApplySpacePlanStub = stub
+}
+
+func (fake *FakeActor) ApplySpacePlanArgsForCall(i int) (v7action.SpacePlan, v7action.SpaceNetworkingActor, v7action.SpacePushActor, func(v7action.SpaceApplyStep)) {
+	fake.applySpacePlanMutex.RLock()
+	defer fake.applySpacePlanMutex.RUnlock()
+	argsForCall := fake.applySpacePlanArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) ApplySpacePlanReturns(result1 v7action.Warnings, result2 error) {
+	fake.applySpacePlanMutex.Lock()
+	defer fake.applySpacePlanMutex.Unlock()
+	fake.ApplySpacePlanStub = nil
+	fake.applySpacePlanReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ApplySpacePlanReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.applySpacePlanMutex.Lock()
+	defer fake.applySpacePlanMutex.Unlock()
+	fake.ApplySpacePlanStub = nil
+	if fake.applySpacePlanReturnsOnCall == nil {
+		fake.applySpacePlanReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.applySpacePlanReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) ApplySpaceQuotaByName(arg1 string, arg2 string, arg3 string) (v7action.Warnings, error) {
 	fake.applySpaceQuotaByNameMutex.Lock()
 	ret, specificReturn := fake.applySpaceQuotaByNameReturnsOnCall[len(fake.applySpaceQuotaByNameArgsForCall)]
@@ -4526,6 +4989,75 @@ func (fake *FakeActor) CreateApplicationInSpaceReturnsOnCall(i int, result1 reso
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) CreateApplicationSidecar(arg1 string, arg2 string, arg3 resources.Sidecar) (resources.Sidecar, v7action.Warnings, error) {
+	fake.createApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.createApplicationSidecarReturnsOnCall[len(fake.createApplicationSidecarArgsForCall)]
+	fake.createApplicationSidecarArgsForCall = append(fake.createApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 resources.Sidecar
+	}{arg1, arg2, arg3})
+	stub := fake.CreateApplicationSidecarStub
+	fakeReturns := fake.createApplicationSidecarReturns
+	fake.recordInvocation("CreateApplicationSidecar", []interface{}{arg1, arg2, arg3})
+	fake.createApplicationSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) CreateApplicationSidecarCallCount() int {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	return len(fake.createApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeActor) CreateApplicationSidecarCalls(stub func(string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = stub
+}
+
+func (fake *FakeActor) CreateApplicationSidecarArgsForCall(i int) (string, string, resources.Sidecar) {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.createApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) CreateApplicationSidecarReturns(result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	fake.createApplicationSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) CreateApplicationSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	if fake.createApplicationSidecarReturnsOnCall == nil {
+		fake.createApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) CreateBitsPackageByApplication(arg1 string) (resources.Package, v7action.Warnings, error) {
 	fake.createBitsPackageByApplicationMutex.Lock()
 	ret, specificReturn := fake.createBitsPackageByApplicationReturnsOnCall[len(fake.createBitsPackageByApplicationArgsForCall)]
@@ -6130,6 +6662,72 @@ func (fake *FakeActor) DeleteApplicationByNameAndSpaceReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
+func (fake *FakeActor) DeleteApplicationSidecar(arg1 string, arg2 string, arg3 string) (v7action.Warnings, error) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationSidecarReturnsOnCall[len(fake.deleteApplicationSidecarArgsForCall)]
+	fake.deleteApplicationSidecarArgsForCall = append(fake.deleteApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteApplicationSidecarStub
+	fakeReturns := fake.deleteApplicationSidecarReturns
+	fake.recordInvocation("DeleteApplicationSidecar", []interface{}{arg1, arg2, arg3})
+	fake.deleteApplicationSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarCallCount() int {
+	fake.deleteApplicationSidecarMutex.RLock()
+	defer fake.deleteApplicationSidecarMutex.RUnlock()
+	return len(fake.deleteApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarCalls(stub func(string, string, string) (v7action.Warnings, error)) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	defer fake.deleteApplicationSidecarMutex.Unlock()
+	fake.DeleteApplicationSidecarStub = stub
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarArgsForCall(i int) (string, string, string) {
+	fake.deleteApplicationSidecarMutex.RLock()
+	defer fake.deleteApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.deleteApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarReturns(result1 v7action.Warnings, result2 error) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	defer fake.deleteApplicationSidecarMutex.Unlock()
+	fake.DeleteApplicationSidecarStub = nil
+	fake.deleteApplicationSidecarReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	defer fake.deleteApplicationSidecarMutex.Unlock()
+	fake.DeleteApplicationSidecarStub = nil
+	if fake.deleteApplicationSidecarReturnsOnCall == nil {
+		fake.deleteApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationSidecarReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) DeleteBuildpackByNameAndStackAndLifecycle(arg1 string, arg2 string, arg3 string) (v7action.Warnings, error) {
 	fake.deleteBuildpackByNameAndStackAndLifecycleMutex.Lock()
 	ret, specificReturn := fake.deleteBuildpackByNameAndStackAndLifecycleReturnsOnCall[len(fake.deleteBuildpackByNameAndStackAndLifecycleArgsForCall)]
@@ -7651,144 +8249,136 @@ func (fake *FakeActor) DisableServiceAccessReturnsOnCall(i int, result1 v7action
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) DownloadCurrentDropletByAppName(arg1 string, arg2 string) ([]byte, string, v7action.Warnings, error) {
-	fake.downloadCurrentDropletByAppNameMutex.Lock()
-	ret, specificReturn := fake.downloadCurrentDropletByAppNameReturnsOnCall[len(fake.downloadCurrentDropletByAppNameArgsForCall)]
-	fake.downloadCurrentDropletByAppNameArgsForCall = append(fake.downloadCurrentDropletByAppNameArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.DownloadCurrentDropletByAppNameStub
-	fakeReturns := fake.downloadCurrentDropletByAppNameReturns
-	fake.recordInvocation("DownloadCurrentDropletByAppName", []interface{}{arg1, arg2})
-	fake.downloadCurrentDropletByAppNameMutex.Unlock()
+func (fake *FakeActor) DownloadDroplet(arg1 resources.Droplet, arg2 io.Writer, arg3 v7action.DownloadProgressBar) (v7action.Warnings, error) {
+	fake.downloadDropletMutex.Lock()
+	ret, specificReturn := fake.downloadDropletReturnsOnCall[len(fake.downloadDropletArgsForCall)]
+	fake.downloadDropletArgsForCall = append(fake.downloadDropletArgsForCall, struct {
+		arg1 resources.Droplet
+		arg2 io.Writer
+		arg3 v7action.DownloadProgressBar
+	}{arg1, arg2, arg3})
+	stub := fake.DownloadDropletStub
+	fakeReturns := fake.downloadDropletReturns
+	fake.recordInvocation("DownloadDroplet", []interface{}{arg1, arg2, arg3})
+	fake.downloadDropletMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3, ret.result4
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeActor) DownloadCurrentDropletByAppNameCallCount() int {
-	fake.downloadCurrentDropletByAppNameMutex.RLock()
-	defer fake.downloadCurrentDropletByAppNameMutex.RUnlock()
-	return len(fake.downloadCurrentDropletByAppNameArgsForCall)
+func (fake *FakeActor) DownloadDropletCallCount() int {
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	return len(fake.downloadDropletArgsForCall)
 }
 
-func (fake *FakeActor) DownloadCurrentDropletByAppNameCalls(stub func(string, string) ([]byte, string, v7action.Warnings, error)) {
-	fake.downloadCurrentDropletByAppNameMutex.Lock()
-	defer fake.downloadCurrentDropletByAppNameMutex.Unlock()
-	fake.DownloadCurrentDropletByAppNameStub = stub
+func (fake *FakeActor) DownloadDropletCalls(stub func(resources.Droplet, io.Writer, v7action.DownloadProgressBar) (v7action.Warnings, error)) {
+	fake.downloadDropletMutex.Lock()
+	defer fake.downloadDropletMutex.Unlock()
+	fake.DownloadDropletStub = stub
 }
 
-func (fake *FakeActor) DownloadCurrentDropletByAppNameArgsForCall(i int) (string, string) {
-	fake.downloadCurrentDropletByAppNameMutex.RLock()
-	defer fake.downloadCurrentDropletByAppNameMutex.RUnlock()
-	argsForCall := fake.downloadCurrentDropletByAppNameArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeActor) DownloadDropletArgsForCall(i int) (resources.Droplet, io.Writer, v7action.DownloadProgressBar) {
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	argsForCall := fake.downloadDropletArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeActor) DownloadCurrentDropletByAppNameReturns(result1 []byte, result2 string, result3 v7action.Warnings, result4 error) {
-	fake.downloadCurrentDropletByAppNameMutex.Lock()
-	defer fake.downloadCurrentDropletByAppNameMutex.Unlock()
-	fake.DownloadCurrentDropletByAppNameStub = nil
-	fake.downloadCurrentDropletByAppNameReturns = struct {
-		result1 []byte
-		result2 string
-		result3 v7action.Warnings
-		result4 error
-	}{result1, result2, result3, result4}
+func (fake *FakeActor) DownloadDropletReturns(result1 v7action.Warnings, result2 error) {
+	fake.downloadDropletMutex.Lock()
+	defer fake.downloadDropletMutex.Unlock()
+	fake.DownloadDropletStub = nil
+	fake.downloadDropletReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeActor) DownloadCurrentDropletByAppNameReturnsOnCall(i int, result1 []byte, result2 string, result3 v7action.Warnings, result4 error) {
-	fake.downloadCurrentDropletByAppNameMutex.Lock()
-	defer fake.downloadCurrentDropletByAppNameMutex.Unlock()
-	fake.DownloadCurrentDropletByAppNameStub = nil
-	if fake.downloadCurrentDropletByAppNameReturnsOnCall == nil {
-		fake.downloadCurrentDropletByAppNameReturnsOnCall = make(map[int]struct {
-			result1 []byte
-			result2 string
-			result3 v7action.Warnings
-			result4 error
+func (fake *FakeActor) DownloadDropletReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.downloadDropletMutex.Lock()
+	defer fake.downloadDropletMutex.Unlock()
+	fake.DownloadDropletStub = nil
+	if fake.downloadDropletReturnsOnCall == nil {
+		fake.downloadDropletReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
 		})
 	}
-	fake.downloadCurrentDropletByAppNameReturnsOnCall[i] = struct {
-		result1 []byte
-		result2 string
-		result3 v7action.Warnings
-		result4 error
-	}{result1, result2, result3, result4}
+	fake.downloadDropletReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeActor) DownloadDropletByGUIDAndAppName(arg1 string, arg2 string, arg3 string) ([]byte, v7action.Warnings, error) {
-	fake.downloadDropletByGUIDAndAppNameMutex.Lock()
-	ret, specificReturn := fake.downloadDropletByGUIDAndAppNameReturnsOnCall[len(fake.downloadDropletByGUIDAndAppNameArgsForCall)]
-	fake.downloadDropletByGUIDAndAppNameArgsForCall = append(fake.downloadDropletByGUIDAndAppNameArgsForCall, struct {
-		arg1 string
-		arg2 string
-		arg3 string
+func (fake *FakeActor) DownloadPackage(arg1 resources.Package, arg2 io.Writer, arg3 v7action.DownloadProgressBar) (v7action.Warnings, error) {
+	fake.downloadPackageMutex.Lock()
+	ret, specificReturn := fake.downloadPackageReturnsOnCall[len(fake.downloadPackageArgsForCall)]
+	fake.downloadPackageArgsForCall = append(fake.downloadPackageArgsForCall, struct {
+		arg1 resources.Package
+		arg2 io.Writer
+		arg3 v7action.DownloadProgressBar
 	}{arg1, arg2, arg3})
-	stub := fake.DownloadDropletByGUIDAndAppNameStub
-	fakeReturns := fake.downloadDropletByGUIDAndAppNameReturns
-	fake.recordInvocation("DownloadDropletByGUIDAndAppName", []interface{}{arg1, arg2, arg3})
-	fake.downloadDropletByGUIDAndAppNameMutex.Unlock()
+	stub := fake.DownloadPackageStub
+	fakeReturns := fake.downloadPackageReturns
+	fake.recordInvocation("DownloadPackage", []interface{}{arg1, arg2, arg3})
+	fake.downloadPackageMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeActor) DownloadDropletByGUIDAndAppNameCallCount() int {
-	fake.downloadDropletByGUIDAndAppNameMutex.RLock()
-	defer fake.downloadDropletByGUIDAndAppNameMutex.RUnlock()
-	return len(fake.downloadDropletByGUIDAndAppNameArgsForCall)
+func (fake *FakeActor) DownloadPackageCallCount() int {
+	fake.downloadPackageMutex.RLock()
+	defer fake.downloadPackageMutex.RUnlock()
+	return len(fake.downloadPackageArgsForCall)
 }
 
-func (fake *FakeActor) DownloadDropletByGUIDAndAppNameCalls(stub func(string, string, string) ([]byte, v7action.Warnings, error)) {
-	fake.downloadDropletByGUIDAndAppNameMutex.Lock()
-	defer fake.downloadDropletByGUIDAndAppNameMutex.Unlock()
-	fake.DownloadDropletByGUIDAndAppNameStub = stub
+func (fake *FakeActor) DownloadPackageCalls(stub func(resources.Package, io.Writer, v7action.DownloadProgressBar) (v7action.Warnings, error)) {
+	fake.downloadPackageMutex.Lock()
+	defer fake.downloadPackageMutex.Unlock()
+	fake.DownloadPackageStub = stub
 }
 
-func (fake *FakeActor) DownloadDropletByGUIDAndAppNameArgsForCall(i int) (string, string, string) {
-	fake.downloadDropletByGUIDAndAppNameMutex.RLock()
-	defer fake.downloadDropletByGUIDAndAppNameMutex.RUnlock()
-	argsForCall := fake.downloadDropletByGUIDAndAppNameArgsForCall[i]
+func (fake *FakeActor) DownloadPackageArgsForCall(i int) (resources.Package, io.Writer, v7action.DownloadProgressBar) {
+	fake.downloadPackageMutex.RLock()
+	defer fake.downloadPackageMutex.RUnlock()
+	argsForCall := fake.downloadPackageArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeActor) DownloadDropletByGUIDAndAppNameReturns(result1 []byte, result2 v7action.Warnings, result3 error) {
-	fake.downloadDropletByGUIDAndAppNameMutex.Lock()
-	defer fake.downloadDropletByGUIDAndAppNameMutex.Unlock()
-	fake.DownloadDropletByGUIDAndAppNameStub = nil
-	fake.downloadDropletByGUIDAndAppNameReturns = struct {
-		result1 []byte
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeActor) DownloadPackageReturns(result1 v7action.Warnings, result2 error) {
+	fake.downloadPackageMutex.Lock()
+	defer fake.downloadPackageMutex.Unlock()
+	fake.DownloadPackageStub = nil
+	fake.downloadPackageReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeActor) DownloadDropletByGUIDAndAppNameReturnsOnCall(i int, result1 []byte, result2 v7action.Warnings, result3 error) {
-	fake.downloadDropletByGUIDAndAppNameMutex.Lock()
-	defer fake.downloadDropletByGUIDAndAppNameMutex.Unlock()
-	fake.DownloadDropletByGUIDAndAppNameStub = nil
-	if fake.downloadDropletByGUIDAndAppNameReturnsOnCall == nil {
-		fake.downloadDropletByGUIDAndAppNameReturnsOnCall = make(map[int]struct {
-			result1 []byte
-			result2 v7action.Warnings
-			result3 error
+func (fake *FakeActor) DownloadPackageReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.downloadPackageMutex.Lock()
+	defer fake.downloadPackageMutex.Unlock()
+	fake.DownloadPackageStub = nil
+	if fake.downloadPackageReturnsOnCall == nil {
+		fake.downloadPackageReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
 		})
 	}
-	fake.downloadDropletByGUIDAndAppNameReturnsOnCall[i] = struct {
-		result1 []byte
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.downloadPackageReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeActor) EnableFeatureFlag(arg1 string) (v7action.Warnings, error) {
@@ -7990,19 +8580,18 @@ func (fake *FakeActor) EntitleIsolationSegmentToOrganizationByNameReturnsOnCall(
 	}{result1, result2}
 }
 
-func (fake *FakeActor) GetAppFeature(arg1 string, arg2 string) (resources.ApplicationFeature, v7action.Warnings, error) {
-	fake.getAppFeatureMutex.Lock()
-	ret, specificReturn := fake.getAppFeatureReturnsOnCall[len(fake.getAppFeatureArgsForCall)]
-	fake.getAppFeatureArgsForCall = append(fake.getAppFeatureArgsForCall, struct {
+func (fake *FakeActor) ExportSpace(arg1 string) (v7action.SpaceExport, v7action.Warnings, error) {
+	fake.exportSpaceMutex.Lock()
+	ret, specificReturn := fake.exportSpaceReturnsOnCall[len(fake.exportSpaceArgsForCall)]
+	fake.exportSpaceArgsForCall = append(fake.exportSpaceArgsForCall, struct {
 		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.GetAppFeatureStub
-	fakeReturns := fake.getAppFeatureReturns
-	fake.recordInvocation("GetAppFeature", []interface{}{arg1, arg2})
-	fake.getAppFeatureMutex.Unlock()
+	}{arg1})
+	stub := fake.ExportSpaceStub
+	fakeReturns := fake.exportSpaceReturns
+	fake.recordInvocation("ExportSpace", []interface{}{arg1})
+	fake.exportSpaceMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -8010,101 +8599,432 @@ func (fake *FakeActor) GetAppFeature(arg1 string, arg2 string) (resources.Applic
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) GetAppFeatureCallCount() int {
-	fake.getAppFeatureMutex.RLock()
-	defer fake.getAppFeatureMutex.RUnlock()
-	return len(fake.getAppFeatureArgsForCall)
+func (fake *FakeActor) ExportSpaceCallCount() int {
+	fake.exportSpaceMutex.RLock()
+	defer fake.exportSpaceMutex.RUnlock()
+	return len(fake.exportSpaceArgsForCall)
 }
 
-func (fake *FakeActor) GetAppFeatureCalls(stub func(string, string) (resources.ApplicationFeature, v7action.Warnings, error)) {
-	fake.getAppFeatureMutex.Lock()
-	defer fake.getAppFeatureMutex.Unlock()
-	fake.GetAppFeatureStub = stub
+func (fake *FakeActor) ExportSpaceCalls(stub func(string) (v7action.SpaceExport, v7action.Warnings, error)) {
+	fake.exportSpaceMutex.Lock()
+	defer fake.exportSpaceMutex.Unlock()
+	fake.ExportSpaceStub = stub
 }
 
-func (fake *FakeActor) GetAppFeatureArgsForCall(i int) (string, string) {
-	fake.getAppFeatureMutex.RLock()
-	defer fake.getAppFeatureMutex.RUnlock()
-	argsForCall := fake.getAppFeatureArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeActor) ExportSpaceArgsForCall(i int) string {
+	fake.exportSpaceMutex.RLock()
+	defer fake.exportSpaceMutex.RUnlock()
+	argsForCall := fake.exportSpaceArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeActor) GetAppFeatureReturns(result1 resources.ApplicationFeature, result2 v7action.Warnings, result3 error) {
-	fake.getAppFeatureMutex.Lock()
-	defer fake.getAppFeatureMutex.Unlock()
-	fake.GetAppFeatureStub = nil
-	fake.getAppFeatureReturns = struct {
-		result1 resources.ApplicationFeature
+func (fake *FakeActor) ExportSpaceReturns(result1 v7action.SpaceExport, result2 v7action.Warnings, result3 error) {
+	fake.exportSpaceMutex.Lock()
+	defer fake.exportSpaceMutex.Unlock()
+	fake.ExportSpaceStub = nil
+	fake.exportSpaceReturns = struct {
+		result1 v7action.SpaceExport
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetAppFeatureReturnsOnCall(i int, result1 resources.ApplicationFeature, result2 v7action.Warnings, result3 error) {
-	fake.getAppFeatureMutex.Lock()
-	defer fake.getAppFeatureMutex.Unlock()
-	fake.GetAppFeatureStub = nil
-	if fake.getAppFeatureReturnsOnCall == nil {
-		fake.getAppFeatureReturnsOnCall = make(map[int]struct {
-			result1 resources.ApplicationFeature
+func (fake *FakeActor) ExportSpaceReturnsOnCall(i int, result1 v7action.SpaceExport, result2 v7action.Warnings, result3 error) {
+	fake.exportSpaceMutex.Lock()
+	defer fake.exportSpaceMutex.Unlock()
+	fake.ExportSpaceStub = nil
+	if fake.exportSpaceReturnsOnCall == nil {
+		fake.exportSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.SpaceExport
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.getAppFeatureReturnsOnCall[i] = struct {
-		result1 resources.ApplicationFeature
+	fake.exportSpaceReturnsOnCall[i] = struct {
+		result1 v7action.SpaceExport
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetAppSummariesForSpace(arg1 string, arg2 string, arg3 bool) ([]v7action.ApplicationSummary, v7action.Warnings, error) {
-	fake.getAppSummariesForSpaceMutex.Lock()
-	ret, specificReturn := fake.getAppSummariesForSpaceReturnsOnCall[len(fake.getAppSummariesForSpaceArgsForCall)]
-	fake.getAppSummariesForSpaceArgsForCall = append(fake.getAppSummariesForSpaceArgsForCall, struct {
-		arg1 string
-		arg2 string
-		arg3 bool
-	}{arg1, arg2, arg3})
-	stub := fake.GetAppSummariesForSpaceStub
-	fakeReturns := fake.getAppSummariesForSpaceReturns
-	fake.recordInvocation("GetAppSummariesForSpace", []interface{}{arg1, arg2, arg3})
-	fake.getAppSummariesForSpaceMutex.Unlock()
+func (fake *FakeActor) FinishBrowserLogin(arg1 v7action.BrowserLogin) error {
+	fake.finishBrowserLoginMutex.Lock()
+	ret, specificReturn := fake.finishBrowserLoginReturnsOnCall[len(fake.finishBrowserLoginArgsForCall)]
+	fake.finishBrowserLoginArgsForCall = append(fake.finishBrowserLoginArgsForCall, struct {
+		arg1 v7action.BrowserLogin
+	}{arg1})
+	stub := fake.FinishBrowserLoginStub
+	fakeReturns := fake.finishBrowserLoginReturns
+	fake.recordInvocation("FinishBrowserLogin", []interface{}{arg1})
+	fake.finishBrowserLoginMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	return fakeReturns.result1
 }
 
-func (fake *FakeActor) GetAppSummariesForSpaceCallCount() int {
-	fake.getAppSummariesForSpaceMutex.RLock()
-	defer fake.getAppSummariesForSpaceMutex.RUnlock()
-	return len(fake.getAppSummariesForSpaceArgsForCall)
+func (fake *FakeActor) FinishBrowserLoginCallCount() int {
+	fake.finishBrowserLoginMutex.RLock()
+	defer fake.finishBrowserLoginMutex.RUnlock()
+	return len(fake.finishBrowserLoginArgsForCall)
 }
 
-func (fake *FakeActor) GetAppSummariesForSpaceCalls(stub func(string, string, bool) ([]v7action.ApplicationSummary, v7action.Warnings, error)) {
-	fake.getAppSummariesForSpaceMutex.Lock()
-	defer fake.getAppSummariesForSpaceMutex.Unlock()
-	fake.GetAppSummariesForSpaceStub = stub
+func (fake *FakeActor) FinishBrowserLoginCalls(stub func(v7action.BrowserLogin) error) {
+	fake.finishBrowserLoginMutex.Lock()
+	defer fake.finishBrowserLoginMutex.Unlock()
+	fake.FinishBrowserLoginStub = stub
 }
 
-func (fake *FakeActor) GetAppSummariesForSpaceArgsForCall(i int) (string, string, bool) {
-	fake.getAppSummariesForSpaceMutex.RLock()
-	defer fake.getAppSummariesForSpaceMutex.RUnlock()
-	argsForCall := fake.getAppSummariesForSpaceArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeActor) FinishBrowserLoginArgsForCall(i int) v7action.BrowserLogin {
+	fake.finishBrowserLoginMutex.RLock()
+	defer fake.finishBrowserLoginMutex.RUnlock()
+	argsForCall := fake.finishBrowserLoginArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeActor) GetAppSummariesForSpaceReturns(result1 []v7action.ApplicationSummary, result2 v7action.Warnings, result3 error) {
-	fake.getAppSummariesForSpaceMutex.Lock()
-	defer fake.getAppSummariesForSpaceMutex.Unlock()
-	fake.GetAppSummariesForSpaceStub = nil
-	fake.getAppSummariesForSpaceReturns = struct {
-		result1 []v7action.ApplicationSummary
-		result2 v7action.Warnings
+func (fake *FakeActor) FinishBrowserLoginReturns(result1 error) {
+	fake.finishBrowserLoginMutex.Lock()
+	defer fake.finishBrowserLoginMutex.Unlock()
+	fake.FinishBrowserLoginStub = nil
+	fake.finishBrowserLoginReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) FinishBrowserLoginReturnsOnCall(i int, result1 error) {
+	fake.finishBrowserLoginMutex.Lock()
+	defer fake.finishBrowserLoginMutex.Unlock()
+	fake.FinishBrowserLoginStub = nil
+	if fake.finishBrowserLoginReturnsOnCall == nil {
+		fake.finishBrowserLoginReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.finishBrowserLoginReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) FinishDeviceLogin(arg1 v7action.DeviceLogin) error {
+	fake.finishDeviceLoginMutex.Lock()
+	ret, specificReturn := fake.finishDeviceLoginReturnsOnCall[len(fake.finishDeviceLoginArgsForCall)]
+	fake.finishDeviceLoginArgsForCall = append(fake.finishDeviceLoginArgsForCall, struct {
+		arg1 v7action.DeviceLogin
+	}{arg1})
+	stub := fake.FinishDeviceLoginStub
+	fakeReturns := fake.finishDeviceLoginReturns
+	fake.recordInvocation("FinishDeviceLogin", []interface{}{arg1})
+	fake.finishDeviceLoginMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeActor) FinishDeviceLoginCallCount() int {
+	fake.finishDeviceLoginMutex.RLock()
+	defer fake.finishDeviceLoginMutex.RUnlock()
+	return len(fake.finishDeviceLoginArgsForCall)
+}
+
+func (fake *FakeActor) FinishDeviceLoginCalls(stub func(v7action.DeviceLogin) error) {
+	fake.finishDeviceLoginMutex.Lock()
+	defer fake.finishDeviceLoginMutex.Unlock()
+	fake.FinishDeviceLoginStub = stub
+}
+
+func (fake *FakeActor) FinishDeviceLoginArgsForCall(i int) v7action.DeviceLogin {
+	fake.finishDeviceLoginMutex.RLock()
+	defer fake.finishDeviceLoginMutex.RUnlock()
+	argsForCall := fake.finishDeviceLoginArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) FinishDeviceLoginReturns(result1 error) {
+	fake.finishDeviceLoginMutex.Lock()
+	defer fake.finishDeviceLoginMutex.Unlock()
+	fake.FinishDeviceLoginStub = nil
+	fake.finishDeviceLoginReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) FinishDeviceLoginReturnsOnCall(i int, result1 error) {
+	fake.finishDeviceLoginMutex.Lock()
+	defer fake.finishDeviceLoginMutex.Unlock()
+	fake.FinishDeviceLoginStub = nil
+	if fake.finishDeviceLoginReturnsOnCall == nil {
+		fake.finishDeviceLoginReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.finishDeviceLoginReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) GetAppCrashesByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient, arg4 time.Time, arg5 int) (v7action.AppCrashes, v7action.Warnings, error) {
+	fake.getAppCrashesByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getAppCrashesByNameAndSpaceReturnsOnCall[len(fake.getAppCrashesByNameAndSpaceArgsForCall)]
+	fake.getAppCrashesByNameAndSpaceArgsForCall = append(fake.getAppCrashesByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 time.Time
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.GetAppCrashesByNameAndSpaceStub
+	fakeReturns := fake.getAppCrashesByNameAndSpaceReturns
+	fake.recordInvocation("GetAppCrashesByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.getAppCrashesByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAppCrashesByNameAndSpaceCallCount() int {
+	fake.getAppCrashesByNameAndSpaceMutex.RLock()
+	defer fake.getAppCrashesByNameAndSpaceMutex.RUnlock()
+	return len(fake.getAppCrashesByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetAppCrashesByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient, time.Time, int) (v7action.AppCrashes, v7action.Warnings, error)) {
+	fake.getAppCrashesByNameAndSpaceMutex.Lock()
+	defer fake.getAppCrashesByNameAndSpaceMutex.Unlock()
+	fake.GetAppCrashesByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetAppCrashesByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient, time.Time, int) {
+	fake.getAppCrashesByNameAndSpaceMutex.RLock()
+	defer fake.getAppCrashesByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getAppCrashesByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeActor) GetAppCrashesByNameAndSpaceReturns(result1 v7action.AppCrashes, result2 v7action.Warnings, result3 error) {
+	fake.getAppCrashesByNameAndSpaceMutex.Lock()
+	defer fake.getAppCrashesByNameAndSpaceMutex.Unlock()
+	fake.GetAppCrashesByNameAndSpaceStub = nil
+	fake.getAppCrashesByNameAndSpaceReturns = struct {
+		result1 v7action.AppCrashes
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppCrashesByNameAndSpaceReturnsOnCall(i int, result1 v7action.AppCrashes, result2 v7action.Warnings, result3 error) {
+	fake.getAppCrashesByNameAndSpaceMutex.Lock()
+	defer fake.getAppCrashesByNameAndSpaceMutex.Unlock()
+	fake.GetAppCrashesByNameAndSpaceStub = nil
+	if fake.getAppCrashesByNameAndSpaceReturnsOnCall == nil {
+		fake.getAppCrashesByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.AppCrashes
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAppCrashesByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.AppCrashes
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppFeature(arg1 string, arg2 string) (resources.ApplicationFeature, v7action.Warnings, error) {
+	fake.getAppFeatureMutex.Lock()
+	ret, specificReturn := fake.getAppFeatureReturnsOnCall[len(fake.getAppFeatureArgsForCall)]
+	fake.getAppFeatureArgsForCall = append(fake.getAppFeatureArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAppFeatureStub
+	fakeReturns := fake.getAppFeatureReturns
+	fake.recordInvocation("GetAppFeature", []interface{}{arg1, arg2})
+	fake.getAppFeatureMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAppFeatureCallCount() int {
+	fake.getAppFeatureMutex.RLock()
+	defer fake.getAppFeatureMutex.RUnlock()
+	return len(fake.getAppFeatureArgsForCall)
+}
+
+func (fake *FakeActor) GetAppFeatureCalls(stub func(string, string) (resources.ApplicationFeature, v7action.Warnings, error)) {
+	fake.getAppFeatureMutex.Lock()
+	defer fake.getAppFeatureMutex.Unlock()
+	fake.GetAppFeatureStub = stub
+}
+
+func (fake *FakeActor) GetAppFeatureArgsForCall(i int) (string, string) {
+	fake.getAppFeatureMutex.RLock()
+	defer fake.getAppFeatureMutex.RUnlock()
+	argsForCall := fake.getAppFeatureArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetAppFeatureReturns(result1 resources.ApplicationFeature, result2 v7action.Warnings, result3 error) {
+	fake.getAppFeatureMutex.Lock()
+	defer fake.getAppFeatureMutex.Unlock()
+	fake.GetAppFeatureStub = nil
+	fake.getAppFeatureReturns = struct {
+		result1 resources.ApplicationFeature
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppFeatureReturnsOnCall(i int, result1 resources.ApplicationFeature, result2 v7action.Warnings, result3 error) {
+	fake.getAppFeatureMutex.Lock()
+	defer fake.getAppFeatureMutex.Unlock()
+	fake.GetAppFeatureStub = nil
+	if fake.getAppFeatureReturnsOnCall == nil {
+		fake.getAppFeatureReturnsOnCall = make(map[int]struct {
+			result1 resources.ApplicationFeature
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAppFeatureReturnsOnCall[i] = struct {
+		result1 resources.ApplicationFeature
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppRequestsByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient, arg4 time.Time) (v7action.AppRequests, v7action.Warnings, error) {
+	fake.getAppRequestsByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getAppRequestsByNameAndSpaceReturnsOnCall[len(fake.getAppRequestsByNameAndSpaceArgsForCall)]
+	fake.getAppRequestsByNameAndSpaceArgsForCall = append(fake.getAppRequestsByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetAppRequestsByNameAndSpaceStub
+	fakeReturns := fake.getAppRequestsByNameAndSpaceReturns
+	fake.recordInvocation("GetAppRequestsByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getAppRequestsByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAppRequestsByNameAndSpaceCallCount() int {
+	fake.getAppRequestsByNameAndSpaceMutex.RLock()
+	defer fake.getAppRequestsByNameAndSpaceMutex.RUnlock()
+	return len(fake.getAppRequestsByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetAppRequestsByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient, time.Time) (v7action.AppRequests, v7action.Warnings, error)) {
+	fake.getAppRequestsByNameAndSpaceMutex.Lock()
+	defer fake.getAppRequestsByNameAndSpaceMutex.Unlock()
+	fake.GetAppRequestsByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetAppRequestsByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient, time.Time) {
+	fake.getAppRequestsByNameAndSpaceMutex.RLock()
+	defer fake.getAppRequestsByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getAppRequestsByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetAppRequestsByNameAndSpaceReturns(result1 v7action.AppRequests, result2 v7action.Warnings, result3 error) {
+	fake.getAppRequestsByNameAndSpaceMutex.Lock()
+	defer fake.getAppRequestsByNameAndSpaceMutex.Unlock()
+	fake.GetAppRequestsByNameAndSpaceStub = nil
+	fake.getAppRequestsByNameAndSpaceReturns = struct {
+		result1 v7action.AppRequests
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppRequestsByNameAndSpaceReturnsOnCall(i int, result1 v7action.AppRequests, result2 v7action.Warnings, result3 error) {
+	fake.getAppRequestsByNameAndSpaceMutex.Lock()
+	defer fake.getAppRequestsByNameAndSpaceMutex.Unlock()
+	fake.GetAppRequestsByNameAndSpaceStub = nil
+	if fake.getAppRequestsByNameAndSpaceReturnsOnCall == nil {
+		fake.getAppRequestsByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.AppRequests
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAppRequestsByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.AppRequests
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppSummariesForSpace(arg1 string, arg2 string, arg3 bool) ([]v7action.ApplicationSummary, v7action.Warnings, error) {
+	fake.getAppSummariesForSpaceMutex.Lock()
+	ret, specificReturn := fake.getAppSummariesForSpaceReturnsOnCall[len(fake.getAppSummariesForSpaceArgsForCall)]
+	fake.getAppSummariesForSpaceArgsForCall = append(fake.getAppSummariesForSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.GetAppSummariesForSpaceStub
+	fakeReturns := fake.getAppSummariesForSpaceReturns
+	fake.recordInvocation("GetAppSummariesForSpace", []interface{}{arg1, arg2, arg3})
+	fake.getAppSummariesForSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAppSummariesForSpaceCallCount() int {
+	fake.getAppSummariesForSpaceMutex.RLock()
+	defer fake.getAppSummariesForSpaceMutex.RUnlock()
+	return len(fake.getAppSummariesForSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetAppSummariesForSpaceCalls(stub func(string, string, bool) ([]v7action.ApplicationSummary, v7action.Warnings, error)) {
+	fake.getAppSummariesForSpaceMutex.Lock()
+	defer fake.getAppSummariesForSpaceMutex.Unlock()
+	fake.GetAppSummariesForSpaceStub = stub
+}
+
+func (fake *FakeActor) GetAppSummariesForSpaceArgsForCall(i int) (string, string, bool) {
+	fake.getAppSummariesForSpaceMutex.RLock()
+	defer fake.getAppSummariesForSpaceMutex.RUnlock()
+	argsForCall := fake.getAppSummariesForSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetAppSummariesForSpaceReturns(result1 []v7action.ApplicationSummary, result2 v7action.Warnings, result3 error) {
+	fake.getAppSummariesForSpaceMutex.Lock()
+	defer fake.getAppSummariesForSpaceMutex.Unlock()
+	fake.GetAppSummariesForSpaceStub = nil
+	fake.getAppSummariesForSpaceReturns = struct {
+		result1 []v7action.ApplicationSummary
+		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
@@ -8398,6 +9318,76 @@ func (fake *FakeActor) GetApplicationMapForRouteReturnsOnCall(i int, result1 map
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationMetricsByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient, arg4 v7action.AppMetricsQuery) ([]v7action.InstanceMetrics, v7action.Warnings, error) {
+	fake.getApplicationMetricsByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationMetricsByNameAndSpaceReturnsOnCall[len(fake.getApplicationMetricsByNameAndSpaceArgsForCall)]
+	fake.getApplicationMetricsByNameAndSpaceArgsForCall = append(fake.getApplicationMetricsByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 v7action.AppMetricsQuery
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetApplicationMetricsByNameAndSpaceStub
+	fakeReturns := fake.getApplicationMetricsByNameAndSpaceReturns
+	fake.recordInvocation("GetApplicationMetricsByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getApplicationMetricsByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationMetricsByNameAndSpaceCallCount() int {
+	fake.getApplicationMetricsByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationMetricsByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationMetricsByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationMetricsByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient, v7action.AppMetricsQuery) ([]v7action.InstanceMetrics, v7action.Warnings, error)) {
+	fake.getApplicationMetricsByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationMetricsByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationMetricsByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetApplicationMetricsByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient, v7action.AppMetricsQuery) {
+	fake.getApplicationMetricsByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationMetricsByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationMetricsByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetApplicationMetricsByNameAndSpaceReturns(result1 []v7action.InstanceMetrics, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationMetricsByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationMetricsByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationMetricsByNameAndSpaceStub = nil
+	fake.getApplicationMetricsByNameAndSpaceReturns = struct {
+		result1 []v7action.InstanceMetrics
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationMetricsByNameAndSpaceReturnsOnCall(i int, result1 []v7action.InstanceMetrics, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationMetricsByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationMetricsByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationMetricsByNameAndSpaceStub = nil
+	if fake.getApplicationMetricsByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationMetricsByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []v7action.InstanceMetrics
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationMetricsByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []v7action.InstanceMetrics
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationPackages(arg1 string, arg2 string) ([]resources.Package, v7action.Warnings, error) {
 	fake.getApplicationPackagesMutex.Lock()
 	ret, specificReturn := fake.getApplicationPackagesReturnsOnCall[len(fake.getApplicationPackagesArgsForCall)]
@@ -8736,6 +9726,74 @@ func (fake *FakeActor) GetApplicationRoutesReturnsOnCall(i int, result1 []resour
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationSidecars(arg1 string, arg2 string) ([]resources.Sidecar, v7action.Warnings, error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	ret, specificReturn := fake.getApplicationSidecarsReturnsOnCall[len(fake.getApplicationSidecarsArgsForCall)]
+	fake.getApplicationSidecarsArgsForCall = append(fake.getApplicationSidecarsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetApplicationSidecarsStub
+	fakeReturns := fake.getApplicationSidecarsReturns
+	fake.recordInvocation("GetApplicationSidecars", []interface{}{arg1, arg2})
+	fake.getApplicationSidecarsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationSidecarsCallCount() int {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	return len(fake.getApplicationSidecarsArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationSidecarsCalls(stub func(string, string) ([]resources.Sidecar, v7action.Warnings, error)) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = stub
+}
+
+func (fake *FakeActor) GetApplicationSidecarsArgsForCall(i int) (string, string) {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	argsForCall := fake.getApplicationSidecarsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetApplicationSidecarsReturns(result1 []resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	fake.getApplicationSidecarsReturns = struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationSidecarsReturnsOnCall(i int, result1 []resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	if fake.getApplicationSidecarsReturnsOnCall == nil {
+		fake.getApplicationSidecarsReturnsOnCall = make(map[int]struct {
+			result1 []resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSidecarsReturnsOnCall[i] = struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationTasks(arg1 string, arg2 v7action.SortOrder) ([]resources.Task, v7action.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
@@ -9014,6 +10072,74 @@ func (fake *FakeActor) GetBuildpacksReturnsOnCall(i int, result1 []resources.Bui
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetCurrentDropletByAppName(arg1 string, arg2 string) (resources.Droplet, v7action.Warnings, error) {
+	fake.getCurrentDropletByAppNameMutex.Lock()
+	ret, specificReturn := fake.getCurrentDropletByAppNameReturnsOnCall[len(fake.getCurrentDropletByAppNameArgsForCall)]
+	fake.getCurrentDropletByAppNameArgsForCall = append(fake.getCurrentDropletByAppNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetCurrentDropletByAppNameStub
+	fakeReturns := fake.getCurrentDropletByAppNameReturns
+	fake.recordInvocation("GetCurrentDropletByAppName", []interface{}{arg1, arg2})
+	fake.getCurrentDropletByAppNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetCurrentDropletByAppNameCallCount() int {
+	fake.getCurrentDropletByAppNameMutex.RLock()
+	defer fake.getCurrentDropletByAppNameMutex.RUnlock()
+	return len(fake.getCurrentDropletByAppNameArgsForCall)
+}
+
+func (fake *FakeActor) GetCurrentDropletByAppNameCalls(stub func(string, string) (resources.Droplet, v7action.Warnings, error)) {
+	fake.getCurrentDropletByAppNameMutex.Lock()
+	defer fake.getCurrentDropletByAppNameMutex.Unlock()
+	fake.GetCurrentDropletByAppNameStub = stub
+}
+
+func (fake *FakeActor) GetCurrentDropletByAppNameArgsForCall(i int) (string, string) {
+	fake.getCurrentDropletByAppNameMutex.RLock()
+	defer fake.getCurrentDropletByAppNameMutex.RUnlock()
+	argsForCall := fake.getCurrentDropletByAppNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetCurrentDropletByAppNameReturns(result1 resources.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getCurrentDropletByAppNameMutex.Lock()
+	defer fake.getCurrentDropletByAppNameMutex.Unlock()
+	fake.GetCurrentDropletByAppNameStub = nil
+	fake.getCurrentDropletByAppNameReturns = struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetCurrentDropletByAppNameReturnsOnCall(i int, result1 resources.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getCurrentDropletByAppNameMutex.Lock()
+	defer fake.getCurrentDropletByAppNameMutex.Unlock()
+	fake.GetCurrentDropletByAppNameStub = nil
+	if fake.getCurrentDropletByAppNameReturnsOnCall == nil {
+		fake.getCurrentDropletByAppNameReturnsOnCall = make(map[int]struct {
+			result1 resources.Droplet
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getCurrentDropletByAppNameReturnsOnCall[i] = struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetCurrentUser() (configv3.User, error) {
 	fake.getCurrentUserMutex.Lock()
 	ret, specificReturn := fake.getCurrentUserReturnsOnCall[len(fake.getCurrentUserArgsForCall)]
@@ -9137,6 +10263,74 @@ func (fake *FakeActor) GetDefaultDomainReturnsOnCall(i int, result1 resources.Do
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetDeploymentStatus(arg1 resources.Application, arg2 string) (v7action.DeploymentStatus, v7action.Warnings, error) {
+	fake.getDeploymentStatusMutex.Lock()
+	ret, specificReturn := fake.getDeploymentStatusReturnsOnCall[len(fake.getDeploymentStatusArgsForCall)]
+	fake.getDeploymentStatusArgsForCall = append(fake.getDeploymentStatusArgsForCall, struct {
+		arg1 resources.Application
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetDeploymentStatusStub
+	fakeReturns := fake.getDeploymentStatusReturns
+	fake.recordInvocation("GetDeploymentStatus", []interface{}{arg1, arg2})
+	fake.getDeploymentStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetDeploymentStatusCallCount() int {
+	fake.getDeploymentStatusMutex.RLock()
+	defer fake.getDeploymentStatusMutex.RUnlock()
+	return len(fake.getDeploymentStatusArgsForCall)
+}
+
+func (fake *FakeActor) GetDeploymentStatusCalls(stub func(resources.Application, string) (v7action.DeploymentStatus, v7action.Warnings, error)) {
+	fake.getDeploymentStatusMutex.Lock()
+	defer fake.getDeploymentStatusMutex.Unlock()
+	fake.GetDeploymentStatusStub = stub
+}
+
+func (fake *FakeActor) GetDeploymentStatusArgsForCall(i int) (resources.Application, string) {
+	fake.getDeploymentStatusMutex.RLock()
+	defer fake.getDeploymentStatusMutex.RUnlock()
+	argsForCall := fake.getDeploymentStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetDeploymentStatusReturns(result1 v7action.DeploymentStatus, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentStatusMutex.Lock()
+	defer fake.getDeploymentStatusMutex.Unlock()
+	fake.GetDeploymentStatusStub = nil
+	fake.getDeploymentStatusReturns = struct {
+		result1 v7action.DeploymentStatus
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDeploymentStatusReturnsOnCall(i int, result1 v7action.DeploymentStatus, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentStatusMutex.Lock()
+	defer fake.getDeploymentStatusMutex.Unlock()
+	fake.GetDeploymentStatusStub = nil
+	if fake.getDeploymentStatusReturnsOnCall == nil {
+		fake.getDeploymentStatusReturnsOnCall = make(map[int]struct {
+			result1 v7action.DeploymentStatus
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentStatusReturnsOnCall[i] = struct {
+		result1 v7action.DeploymentStatus
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetDetailedAppSummary(arg1 string, arg2 string, arg3 bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error) {
 	fake.getDetailedAppSummaryMutex.Lock()
 	ret, specificReturn := fake.getDetailedAppSummaryReturnsOnCall[len(fake.getDetailedAppSummaryArgsForCall)]
@@ -9378,30 +10572,99 @@ func (fake *FakeActor) GetDomainLabelsArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
-func (fake *FakeActor) GetDomainLabelsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
-	fake.getDomainLabelsMutex.Lock()
-	defer fake.getDomainLabelsMutex.Unlock()
-	fake.GetDomainLabelsStub = nil
-	fake.getDomainLabelsReturns = struct {
-		result1 map[string]types.NullString
+func (fake *FakeActor) GetDomainLabelsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getDomainLabelsMutex.Lock()
+	defer fake.getDomainLabelsMutex.Unlock()
+	fake.GetDomainLabelsStub = nil
+	fake.getDomainLabelsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDomainLabelsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getDomainLabelsMutex.Lock()
+	defer fake.getDomainLabelsMutex.Unlock()
+	fake.GetDomainLabelsStub = nil
+	if fake.getDomainLabelsReturnsOnCall == nil {
+		fake.getDomainLabelsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDomainLabelsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDropletByGUIDAndAppName(arg1 string, arg2 string, arg3 string) (resources.Droplet, v7action.Warnings, error) {
+	fake.getDropletByGUIDAndAppNameMutex.Lock()
+	ret, specificReturn := fake.getDropletByGUIDAndAppNameReturnsOnCall[len(fake.getDropletByGUIDAndAppNameArgsForCall)]
+	fake.getDropletByGUIDAndAppNameArgsForCall = append(fake.getDropletByGUIDAndAppNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetDropletByGUIDAndAppNameStub
+	fakeReturns := fake.getDropletByGUIDAndAppNameReturns
+	fake.recordInvocation("GetDropletByGUIDAndAppName", []interface{}{arg1, arg2, arg3})
+	fake.getDropletByGUIDAndAppNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetDropletByGUIDAndAppNameCallCount() int {
+	fake.getDropletByGUIDAndAppNameMutex.RLock()
+	defer fake.getDropletByGUIDAndAppNameMutex.RUnlock()
+	return len(fake.getDropletByGUIDAndAppNameArgsForCall)
+}
+
+func (fake *FakeActor) GetDropletByGUIDAndAppNameCalls(stub func(string, string, string) (resources.Droplet, v7action.Warnings, error)) {
+	fake.getDropletByGUIDAndAppNameMutex.Lock()
+	defer fake.getDropletByGUIDAndAppNameMutex.Unlock()
+	fake.GetDropletByGUIDAndAppNameStub = stub
+}
+
+func (fake *FakeActor) GetDropletByGUIDAndAppNameArgsForCall(i int) (string, string, string) {
+	fake.getDropletByGUIDAndAppNameMutex.RLock()
+	defer fake.getDropletByGUIDAndAppNameMutex.RUnlock()
+	argsForCall := fake.getDropletByGUIDAndAppNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetDropletByGUIDAndAppNameReturns(result1 resources.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getDropletByGUIDAndAppNameMutex.Lock()
+	defer fake.getDropletByGUIDAndAppNameMutex.Unlock()
+	fake.GetDropletByGUIDAndAppNameStub = nil
+	fake.getDropletByGUIDAndAppNameReturns = struct {
+		result1 resources.Droplet
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetDomainLabelsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
-	fake.getDomainLabelsMutex.Lock()
-	defer fake.getDomainLabelsMutex.Unlock()
-	fake.GetDomainLabelsStub = nil
-	if fake.getDomainLabelsReturnsOnCall == nil {
-		fake.getDomainLabelsReturnsOnCall = make(map[int]struct {
-			result1 map[string]types.NullString
+func (fake *FakeActor) GetDropletByGUIDAndAppNameReturnsOnCall(i int, result1 resources.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getDropletByGUIDAndAppNameMutex.Lock()
+	defer fake.getDropletByGUIDAndAppNameMutex.Unlock()
+	fake.GetDropletByGUIDAndAppNameStub = nil
+	if fake.getDropletByGUIDAndAppNameReturnsOnCall == nil {
+		fake.getDropletByGUIDAndAppNameReturnsOnCall = make(map[int]struct {
+			result1 resources.Droplet
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.getDomainLabelsReturnsOnCall[i] = struct {
-		result1 map[string]types.NullString
+	fake.getDropletByGUIDAndAppNameReturnsOnCall[i] = struct {
+		result1 resources.Droplet
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -9680,6 +10943,73 @@ func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceReturnsOn
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetEvents(arg1 v7action.EventsQuery) ([]v7action.Event, v7action.Warnings, error) {
+	fake.getEventsMutex.Lock()
+	ret, specificReturn := fake.getEventsReturnsOnCall[len(fake.getEventsArgsForCall)]
+	fake.getEventsArgsForCall = append(fake.getEventsArgsForCall, struct {
+		arg1 v7action.EventsQuery
+	}{arg1})
+	stub := fake.GetEventsStub
+	fakeReturns := fake.getEventsReturns
+	fake.recordInvocation("GetEvents", []interface{}{arg1})
+	fake.getEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetEventsCallCount() int {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return len(fake.getEventsArgsForCall)
+}
+
+func (fake *FakeActor) GetEventsCalls(stub func(v7action.EventsQuery) ([]v7action.Event, v7action.Warnings, error)) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = stub
+}
+
+func (fake *FakeActor) GetEventsArgsForCall(i int) v7action.EventsQuery {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	argsForCall := fake.getEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetEventsReturns(result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	fake.getEventsReturns = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetEventsReturnsOnCall(i int, result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	if fake.getEventsReturnsOnCall == nil {
+		fake.getEventsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Event
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getEventsReturnsOnCall[i] = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetFeatureFlagByName(arg1 string) (resources.FeatureFlag, v7action.Warnings, error) {
 	fake.getFeatureFlagByNameMutex.Lock()
 	ret, specificReturn := fake.getFeatureFlagByNameReturnsOnCall[len(fake.getFeatureFlagByNameArgsForCall)]
@@ -9806,6 +11136,152 @@ func (fake *FakeActor) GetFeatureFlagsReturnsOnCall(i int, result1 []resources.F
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient, arg4 sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall)]
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub
+	fakeReturns := fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturns
+	fake.recordInvocation("GetFilteredRecentLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceCallCount() int {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error)) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(result1 []sharedaction.LogMessage, result2 v7action.Warnings, result3 error) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub = nil
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturns = struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 []sharedaction.LogMessage, result2 v7action.Warnings, result3 error) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub = nil
+	if fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.LogMessage
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient, arg4 sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub
+	fakeReturns := fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturns
+	fake.recordInvocation("GetFilteredStreamingLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4, fakeReturns.result5
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount() int {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceReturns(result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = nil
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturns = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = nil
+	if fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan sharedaction.LogMessage
+			result2 <-chan error
+			result3 context.CancelFunc
+			result4 v7action.Warnings
+			result5 error
+		})
+	}
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}{result1, result2, result3, result4, result5}
+}
+
 func (fake *FakeActor) GetGlobalRunningSecurityGroups() ([]resources.SecurityGroup, v7action.Warnings, error) {
 	fake.getGlobalRunningSecurityGroupsMutex.Lock()
 	ret, specificReturn := fake.getGlobalRunningSecurityGroupsReturnsOnCall[len(fake.getGlobalRunningSecurityGroupsArgsForCall)]
@@ -9983,6 +11459,75 @@ func (fake *FakeActor) GetInfoResponseReturnsOnCall(i int, result1 v7action.Info
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetInstanceUsageForSpace(arg1 string, arg2 sharedaction.LogCacheClient, arg3 time.Time) ([]v7action.InstanceUsage, v7action.Warnings, error) {
+	fake.getInstanceUsageForSpaceMutex.Lock()
+	ret, specificReturn := fake.getInstanceUsageForSpaceReturnsOnCall[len(fake.getInstanceUsageForSpaceArgsForCall)]
+	fake.getInstanceUsageForSpaceArgsForCall = append(fake.getInstanceUsageForSpaceArgsForCall, struct {
+		arg1 string
+		arg2 sharedaction.LogCacheClient
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.GetInstanceUsageForSpaceStub
+	fakeReturns := fake.getInstanceUsageForSpaceReturns
+	fake.recordInvocation("GetInstanceUsageForSpace", []interface{}{arg1, arg2, arg3})
+	fake.getInstanceUsageForSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetInstanceUsageForSpaceCallCount() int {
+	fake.getInstanceUsageForSpaceMutex.RLock()
+	defer fake.getInstanceUsageForSpaceMutex.RUnlock()
+	return len(fake.getInstanceUsageForSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetInstanceUsageForSpaceCalls(stub func(string, sharedaction.LogCacheClient, time.Time) ([]v7action.InstanceUsage, v7action.Warnings, error)) {
+	fake.getInstanceUsageForSpaceMutex.Lock()
+	defer fake.getInstanceUsageForSpaceMutex.Unlock()
+	fake.GetInstanceUsageForSpaceStub = stub
+}
+
+func (fake *FakeActor) GetInstanceUsageForSpaceArgsForCall(i int) (string, sharedaction.LogCacheClient, time.Time) {
+	fake.getInstanceUsageForSpaceMutex.RLock()
+	defer fake.getInstanceUsageForSpaceMutex.RUnlock()
+	argsForCall := fake.getInstanceUsageForSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetInstanceUsageForSpaceReturns(result1 []v7action.InstanceUsage, result2 v7action.Warnings, result3 error) {
+	fake.getInstanceUsageForSpaceMutex.Lock()
+	defer fake.getInstanceUsageForSpaceMutex.Unlock()
+	fake.GetInstanceUsageForSpaceStub = nil
+	fake.getInstanceUsageForSpaceReturns = struct {
+		result1 []v7action.InstanceUsage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetInstanceUsageForSpaceReturnsOnCall(i int, result1 []v7action.InstanceUsage, result2 v7action.Warnings, result3 error) {
+	fake.getInstanceUsageForSpaceMutex.Lock()
+	defer fake.getInstanceUsageForSpaceMutex.Unlock()
+	fake.GetInstanceUsageForSpaceStub = nil
+	if fake.getInstanceUsageForSpaceReturnsOnCall == nil {
+		fake.getInstanceUsageForSpaceReturnsOnCall = make(map[int]struct {
+			result1 []v7action.InstanceUsage
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getInstanceUsageForSpaceReturnsOnCall[i] = struct {
+		result1 []v7action.InstanceUsage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetIsolationSegmentByName(arg1 string) (resources.IsolationSegment, v7action.Warnings, error) {
 	fake.getIsolationSegmentByNameMutex.Lock()
 	ret, specificReturn := fake.getIsolationSegmentByNameReturnsOnCall[len(fake.getIsolationSegmentByNameArgsForCall)]
@@ -11030,6 +12575,75 @@ func (fake *FakeActor) GetOrganizationsReturnsOnCall(i int, result1 []resources.
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetPackageByGUIDAndAppName(arg1 string, arg2 string, arg3 string) (resources.Package, v7action.Warnings, error) {
+	fake.getPackageByGUIDAndAppNameMutex.Lock()
+	ret, specificReturn := fake.getPackageByGUIDAndAppNameReturnsOnCall[len(fake.getPackageByGUIDAndAppNameArgsForCall)]
+	fake.getPackageByGUIDAndAppNameArgsForCall = append(fake.getPackageByGUIDAndAppNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetPackageByGUIDAndAppNameStub
+	fakeReturns := fake.getPackageByGUIDAndAppNameReturns
+	fake.recordInvocation("GetPackageByGUIDAndAppName", []interface{}{arg1, arg2, arg3})
+	fake.getPackageByGUIDAndAppNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetPackageByGUIDAndAppNameCallCount() int {
+	fake.getPackageByGUIDAndAppNameMutex.RLock()
+	defer fake.getPackageByGUIDAndAppNameMutex.RUnlock()
+	return len(fake.getPackageByGUIDAndAppNameArgsForCall)
+}
+
+func (fake *FakeActor) GetPackageByGUIDAndAppNameCalls(stub func(string, string, string) (resources.Package, v7action.Warnings, error)) {
+	fake.getPackageByGUIDAndAppNameMutex.Lock()
+	defer fake.getPackageByGUIDAndAppNameMutex.Unlock()
+	fake.GetPackageByGUIDAndAppNameStub = stub
+}
+
+func (fake *FakeActor) GetPackageByGUIDAndAppNameArgsForCall(i int) (string, string, string) {
+	fake.getPackageByGUIDAndAppNameMutex.RLock()
+	defer fake.getPackageByGUIDAndAppNameMutex.RUnlock()
+	argsForCall := fake.getPackageByGUIDAndAppNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetPackageByGUIDAndAppNameReturns(result1 resources.Package, result2 v7action.Warnings, result3 error) {
+	fake.getPackageByGUIDAndAppNameMutex.Lock()
+	defer fake.getPackageByGUIDAndAppNameMutex.Unlock()
+	fake.GetPackageByGUIDAndAppNameStub = nil
+	fake.getPackageByGUIDAndAppNameReturns = struct {
+		result1 resources.Package
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetPackageByGUIDAndAppNameReturnsOnCall(i int, result1 resources.Package, result2 v7action.Warnings, result3 error) {
+	fake.getPackageByGUIDAndAppNameMutex.Lock()
+	defer fake.getPackageByGUIDAndAppNameMutex.Unlock()
+	fake.GetPackageByGUIDAndAppNameStub = nil
+	if fake.getPackageByGUIDAndAppNameReturnsOnCall == nil {
+		fake.getPackageByGUIDAndAppNameReturnsOnCall = make(map[int]struct {
+			result1 resources.Package
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getPackageByGUIDAndAppNameReturnsOnCall[i] = struct {
+		result1 resources.Package
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetProcessByTypeAndApplication(arg1 string, arg2 string) (resources.Process, v7action.Warnings, error) {
 	fake.getProcessByTypeAndApplicationMutex.Lock()
 	ret, specificReturn := fake.getProcessByTypeAndApplicationReturnsOnCall[len(fake.getProcessByTypeAndApplicationArgsForCall)]
@@ -11130,105 +12744,37 @@ func (fake *FakeActor) GetRawApplicationManifestByNameAndSpaceCalls(stub func(st
 	fake.GetRawApplicationManifestByNameAndSpaceStub = stub
 }
 
-func (fake *FakeActor) GetRawApplicationManifestByNameAndSpaceArgsForCall(i int) (string, string) {
-	fake.getRawApplicationManifestByNameAndSpaceMutex.RLock()
-	defer fake.getRawApplicationManifestByNameAndSpaceMutex.RUnlock()
-	argsForCall := fake.getRawApplicationManifestByNameAndSpaceArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
-func (fake *FakeActor) GetRawApplicationManifestByNameAndSpaceReturns(result1 []byte, result2 v7action.Warnings, result3 error) {
-	fake.getRawApplicationManifestByNameAndSpaceMutex.Lock()
-	defer fake.getRawApplicationManifestByNameAndSpaceMutex.Unlock()
-	fake.GetRawApplicationManifestByNameAndSpaceStub = nil
-	fake.getRawApplicationManifestByNameAndSpaceReturns = struct {
-		result1 []byte
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeActor) GetRawApplicationManifestByNameAndSpaceReturnsOnCall(i int, result1 []byte, result2 v7action.Warnings, result3 error) {
-	fake.getRawApplicationManifestByNameAndSpaceMutex.Lock()
-	defer fake.getRawApplicationManifestByNameAndSpaceMutex.Unlock()
-	fake.GetRawApplicationManifestByNameAndSpaceStub = nil
-	if fake.getRawApplicationManifestByNameAndSpaceReturnsOnCall == nil {
-		fake.getRawApplicationManifestByNameAndSpaceReturnsOnCall = make(map[int]struct {
-			result1 []byte
-			result2 v7action.Warnings
-			result3 error
-		})
-	}
-	fake.getRawApplicationManifestByNameAndSpaceReturnsOnCall[i] = struct {
-		result1 []byte
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpace(arg1 string, arg2 string) ([]v7action.Event, v7action.Warnings, error) {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.Lock()
-	ret, specificReturn := fake.getRecentEventsByApplicationNameAndSpaceReturnsOnCall[len(fake.getRecentEventsByApplicationNameAndSpaceArgsForCall)]
-	fake.getRecentEventsByApplicationNameAndSpaceArgsForCall = append(fake.getRecentEventsByApplicationNameAndSpaceArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.GetRecentEventsByApplicationNameAndSpaceStub
-	fakeReturns := fake.getRecentEventsByApplicationNameAndSpaceReturns
-	fake.recordInvocation("GetRecentEventsByApplicationNameAndSpace", []interface{}{arg1, arg2})
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.Unlock()
-	if stub != nil {
-		return stub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
-	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
-}
-
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceCallCount() int {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.RLock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.RUnlock()
-	return len(fake.getRecentEventsByApplicationNameAndSpaceArgsForCall)
-}
-
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceCalls(stub func(string, string) ([]v7action.Event, v7action.Warnings, error)) {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.Lock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.Unlock()
-	fake.GetRecentEventsByApplicationNameAndSpaceStub = stub
-}
-
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceArgsForCall(i int) (string, string) {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.RLock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.RUnlock()
-	argsForCall := fake.getRecentEventsByApplicationNameAndSpaceArgsForCall[i]
+func (fake *FakeActor) GetRawApplicationManifestByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getRawApplicationManifestByNameAndSpaceMutex.RLock()
+	defer fake.getRawApplicationManifestByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getRawApplicationManifestByNameAndSpaceArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceReturns(result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.Lock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.Unlock()
-	fake.GetRecentEventsByApplicationNameAndSpaceStub = nil
-	fake.getRecentEventsByApplicationNameAndSpaceReturns = struct {
-		result1 []v7action.Event
+func (fake *FakeActor) GetRawApplicationManifestByNameAndSpaceReturns(result1 []byte, result2 v7action.Warnings, result3 error) {
+	fake.getRawApplicationManifestByNameAndSpaceMutex.Lock()
+	defer fake.getRawApplicationManifestByNameAndSpaceMutex.Unlock()
+	fake.GetRawApplicationManifestByNameAndSpaceStub = nil
+	fake.getRawApplicationManifestByNameAndSpaceReturns = struct {
+		result1 []byte
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceReturnsOnCall(i int, result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.Lock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.Unlock()
-	fake.GetRecentEventsByApplicationNameAndSpaceStub = nil
-	if fake.getRecentEventsByApplicationNameAndSpaceReturnsOnCall == nil {
-		fake.getRecentEventsByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
-			result1 []v7action.Event
+func (fake *FakeActor) GetRawApplicationManifestByNameAndSpaceReturnsOnCall(i int, result1 []byte, result2 v7action.Warnings, result3 error) {
+	fake.getRawApplicationManifestByNameAndSpaceMutex.Lock()
+	defer fake.getRawApplicationManifestByNameAndSpaceMutex.Unlock()
+	fake.GetRawApplicationManifestByNameAndSpaceStub = nil
+	if fake.getRawApplicationManifestByNameAndSpaceReturnsOnCall == nil {
+		fake.getRawApplicationManifestByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []byte
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.getRecentEventsByApplicationNameAndSpaceReturnsOnCall[i] = struct {
-		result1 []v7action.Event
+	fake.getRawApplicationManifestByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []byte
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -11303,6 +12849,82 @@ func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceReturnsOnCall(i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetRecentLogsForApplicationsInSpace(arg1 []string, arg2 string, arg3 string, arg4 sharedaction.LogCacheClient, arg5 sharedaction.LogFilter) ([]sharedaction.AppLogMessage, v7action.Warnings, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getRecentLogsForApplicationsInSpaceMutex.Lock()
+	ret, specificReturn := fake.getRecentLogsForApplicationsInSpaceReturnsOnCall[len(fake.getRecentLogsForApplicationsInSpaceArgsForCall)]
+	fake.getRecentLogsForApplicationsInSpaceArgsForCall = append(fake.getRecentLogsForApplicationsInSpaceArgsForCall, struct {
+		arg1 []string
+		arg2 string
+		arg3 string
+		arg4 sharedaction.LogCacheClient
+		arg5 sharedaction.LogFilter
+	}{arg1Copy, arg2, arg3, arg4, arg5})
+	stub := fake.GetRecentLogsForApplicationsInSpaceStub
+	fakeReturns := fake.getRecentLogsForApplicationsInSpaceReturns
+	fake.recordInvocation("GetRecentLogsForApplicationsInSpace", []interface{}{arg1Copy, arg2, arg3, arg4, arg5})
+	fake.getRecentLogsForApplicationsInSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRecentLogsForApplicationsInSpaceCallCount() int {
+	fake.getRecentLogsForApplicationsInSpaceMutex.RLock()
+	defer fake.getRecentLogsForApplicationsInSpaceMutex.RUnlock()
+	return len(fake.getRecentLogsForApplicationsInSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetRecentLogsForApplicationsInSpaceCalls(stub func([]string, string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) ([]sharedaction.AppLogMessage, v7action.Warnings, error)) {
+	fake.getRecentLogsForApplicationsInSpaceMutex.Lock()
+	defer fake.getRecentLogsForApplicationsInSpaceMutex.Unlock()
+	fake.GetRecentLogsForApplicationsInSpaceStub = stub
+}
+
+func (fake *FakeActor) GetRecentLogsForApplicationsInSpaceArgsForCall(i int) ([]string, string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) {
+	fake.getRecentLogsForApplicationsInSpaceMutex.RLock()
+	defer fake.getRecentLogsForApplicationsInSpaceMutex.RUnlock()
+	argsForCall := fake.getRecentLogsForApplicationsInSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeActor) GetRecentLogsForApplicationsInSpaceReturns(result1 []sharedaction.AppLogMessage, result2 v7action.Warnings, result3 error) {
+	fake.getRecentLogsForApplicationsInSpaceMutex.Lock()
+	defer fake.getRecentLogsForApplicationsInSpaceMutex.Unlock()
+	fake.GetRecentLogsForApplicationsInSpaceStub = nil
+	fake.getRecentLogsForApplicationsInSpaceReturns = struct {
+		result1 []sharedaction.AppLogMessage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRecentLogsForApplicationsInSpaceReturnsOnCall(i int, result1 []sharedaction.AppLogMessage, result2 v7action.Warnings, result3 error) {
+	fake.getRecentLogsForApplicationsInSpaceMutex.Lock()
+	defer fake.getRecentLogsForApplicationsInSpaceMutex.Unlock()
+	fake.GetRecentLogsForApplicationsInSpaceStub = nil
+	if fake.getRecentLogsForApplicationsInSpaceReturnsOnCall == nil {
+		fake.getRecentLogsForApplicationsInSpaceReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.AppLogMessage
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRecentLogsForApplicationsInSpaceReturnsOnCall[i] = struct {
+		result1 []sharedaction.AppLogMessage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetRevisionByApplicationAndVersion(arg1 string, arg2 int) (resources.Revision, v7action.Warnings, error) {
 	fake.getRevisionByApplicationAndVersionMutex.Lock()
 	ret, specificReturn := fake.getRevisionByApplicationAndVersionReturnsOnCall[len(fake.getRevisionByApplicationAndVersionArgsForCall)]
@@ -14185,6 +15807,88 @@ func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceReturnsOnCall
 	}{result1, result2, result3, result4, result5}
 }
 
+func (fake *FakeActor) GetStreamingLogsForApplicationsInSpace(arg1 []string, arg2 string, arg3 string, arg4 sharedaction.LogCacheClient, arg5 sharedaction.LogFilter) (<-chan sharedaction.AppLogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getStreamingLogsForApplicationsInSpaceMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForApplicationsInSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationsInSpaceArgsForCall)]
+	fake.getStreamingLogsForApplicationsInSpaceArgsForCall = append(fake.getStreamingLogsForApplicationsInSpaceArgsForCall, struct {
+		arg1 []string
+		arg2 string
+		arg3 string
+		arg4 sharedaction.LogCacheClient
+		arg5 sharedaction.LogFilter
+	}{arg1Copy, arg2, arg3, arg4, arg5})
+	stub := fake.GetStreamingLogsForApplicationsInSpaceStub
+	fakeReturns := fake.getStreamingLogsForApplicationsInSpaceReturns
+	fake.recordInvocation("GetStreamingLogsForApplicationsInSpace", []interface{}{arg1Copy, arg2, arg3, arg4, arg5})
+	fake.getStreamingLogsForApplicationsInSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4, fakeReturns.result5
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationsInSpaceCallCount() int {
+	fake.getStreamingLogsForApplicationsInSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsInSpaceMutex.RUnlock()
+	return len(fake.getStreamingLogsForApplicationsInSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationsInSpaceCalls(stub func([]string, string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) (<-chan sharedaction.AppLogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)) {
+	fake.getStreamingLogsForApplicationsInSpaceMutex.Lock()
+	defer fake.getStreamingLogsForApplicationsInSpaceMutex.Unlock()
+	fake.GetStreamingLogsForApplicationsInSpaceStub = stub
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationsInSpaceArgsForCall(i int) ([]string, string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) {
+	fake.getStreamingLogsForApplicationsInSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsInSpaceMutex.RUnlock()
+	argsForCall := fake.getStreamingLogsForApplicationsInSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationsInSpaceReturns(result1 <-chan sharedaction.AppLogMessage, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
+	fake.getStreamingLogsForApplicationsInSpaceMutex.Lock()
+	defer fake.getStreamingLogsForApplicationsInSpaceMutex.Unlock()
+	fake.GetStreamingLogsForApplicationsInSpaceStub = nil
+	fake.getStreamingLogsForApplicationsInSpaceReturns = struct {
+		result1 <-chan sharedaction.AppLogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationsInSpaceReturnsOnCall(i int, result1 <-chan sharedaction.AppLogMessage, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
+	fake.getStreamingLogsForApplicationsInSpaceMutex.Lock()
+	defer fake.getStreamingLogsForApplicationsInSpaceMutex.Unlock()
+	fake.GetStreamingLogsForApplicationsInSpaceStub = nil
+	if fake.getStreamingLogsForApplicationsInSpaceReturnsOnCall == nil {
+		fake.getStreamingLogsForApplicationsInSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan sharedaction.AppLogMessage
+			result2 <-chan error
+			result3 context.CancelFunc
+			result4 v7action.Warnings
+			result5 error
+		})
+	}
+	fake.getStreamingLogsForApplicationsInSpaceReturnsOnCall[i] = struct {
+		result1 <-chan sharedaction.AppLogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}{result1, result2, result3, result4, result5}
+}
+
 func (fake *FakeActor) GetTaskBySequenceIDAndApplication(arg1 int, arg2 string) (resources.Task, v7action.Warnings, error) {
 	fake.getTaskBySequenceIDAndApplicationMutex.Lock()
 	ret, specificReturn := fake.getTaskBySequenceIDAndApplicationReturnsOnCall[len(fake.getTaskBySequenceIDAndApplicationArgsForCall)]
@@ -14779,6 +16483,79 @@ func (fake *FakeActor) ParseAccessTokenReturnsOnCall(i int, result1 jwt.JWT, res
 	}{result1, result2}
 }
 
+func (fake *FakeActor) PlanSpace(arg1 string, arg2 string, arg3 string, arg4 string, arg5 manifestparser.Manifest, arg6 spacebundle.Space, arg7 v7action.SpaceNetworkingActor) (v7action.SpacePlan, v7action.Warnings, error) {
+	fake.planSpaceMutex.Lock()
+	ret, specificReturn := fake.planSpaceReturnsOnCall[len(fake.planSpaceArgsForCall)]
+	fake.planSpaceArgsForCall = append(fake.planSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 manifestparser.Manifest
+		arg6 spacebundle.Space
+		arg7 v7action.SpaceNetworkingActor
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	stub := fake.PlanSpaceStub
+	fakeReturns := fake.planSpaceReturns
+	fake.recordInvocation("PlanSpace", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.planSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) PlanSpaceCallCount() int {
+	fake.planSpaceMutex.RLock()
+	defer fake.planSpaceMutex.RUnlock()
+	return len(fake.planSpaceArgsForCall)
+}
+
+func (fake *FakeActor) PlanSpaceCalls(stub func(string, string, string, string, manifestparser.Manifest, spacebundle.Space, v7action.SpaceNetworkingActor) (v7action.SpacePlan, v7action.Warnings, error)) {
+	fake.planSpaceMutex.Lock()
+	defer fake.planSpaceMutex.Unlock()
+	fake.PlanSpaceStub = stub
+}
+
+func (fake *FakeActor) PlanSpaceArgsForCall(i int) (string, string, string, string, manifestparser.Manifest, spacebundle.Space, v7action.SpaceNetworkingActor) {
+	fake.planSpaceMutex.RLock()
+	defer fake.planSpaceMutex.RUnlock()
+	argsForCall := fake.planSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeActor) PlanSpaceReturns(result1 v7action.SpacePlan, result2 v7action.Warnings, result3 error) {
+	fake.planSpaceMutex.Lock()
+	defer fake.planSpaceMutex.Unlock()
+	fake.PlanSpaceStub = nil
+	fake.planSpaceReturns = struct {
+		result1 v7action.SpacePlan
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) PlanSpaceReturnsOnCall(i int, result1 v7action.SpacePlan, result2 v7action.Warnings, result3 error) {
+	fake.planSpaceMutex.Lock()
+	defer fake.planSpaceMutex.Unlock()
+	fake.PlanSpaceStub = nil
+	if fake.planSpaceReturnsOnCall == nil {
+		fake.planSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.SpacePlan
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.planSpaceReturnsOnCall[i] = struct {
+		result1 v7action.SpacePlan
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) PollBuild(arg1 string, arg2 string) (resources.Droplet, v7action.Warnings, error) {
 	fake.pollBuildMutex.Lock()
 	ret, specificReturn := fake.pollBuildReturnsOnCall[len(fake.pollBuildArgsForCall)]
@@ -17223,6 +19000,126 @@ func (fake *FakeActor) StartApplicationReturnsOnCall(i int, result1 v7action.War
 	}{result1, result2}
 }
 
+func (fake *FakeActor) StartBrowserLogin(arg1 string) (v7action.BrowserLogin, error) {
+	fake.startBrowserLoginMutex.Lock()
+	ret, specificReturn := fake.startBrowserLoginReturnsOnCall[len(fake.startBrowserLoginArgsForCall)]
+	fake.startBrowserLoginArgsForCall = append(fake.startBrowserLoginArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.StartBrowserLoginStub
+	fakeReturns := fake.startBrowserLoginReturns
+	fake.recordInvocation("StartBrowserLogin", []interface{}{arg1})
+	fake.startBrowserLoginMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) StartBrowserLoginCallCount() int {
+	fake.startBrowserLoginMutex.RLock()
+	defer fake.startBrowserLoginMutex.RUnlock()
+	return len(fake.startBrowserLoginArgsForCall)
+}
+
+func (fake *FakeActor) StartBrowserLoginCalls(stub func(string) (v7action.BrowserLogin, error)) {
+	fake.startBrowserLoginMutex.Lock()
+	defer fake.startBrowserLoginMutex.Unlock()
+	fake.StartBrowserLoginStub = stub
+}
+
+func (fake *FakeActor) StartBrowserLoginArgsForCall(i int) string {
+	fake.startBrowserLoginMutex.RLock()
+	defer fake.startBrowserLoginMutex.RUnlock()
+	argsForCall := fake.startBrowserLoginArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) StartBrowserLoginReturns(result1 v7action.BrowserLogin, result2 error) {
+	fake.startBrowserLoginMutex.Lock()
+	defer fake.startBrowserLoginMutex.Unlock()
+	fake.StartBrowserLoginStub = nil
+	fake.startBrowserLoginReturns = struct {
+		result1 v7action.BrowserLogin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) StartBrowserLoginReturnsOnCall(i int, result1 v7action.BrowserLogin, result2 error) {
+	fake.startBrowserLoginMutex.Lock()
+	defer fake.startBrowserLoginMutex.Unlock()
+	fake.StartBrowserLoginStub = nil
+	if fake.startBrowserLoginReturnsOnCall == nil {
+		fake.startBrowserLoginReturnsOnCall = make(map[int]struct {
+			result1 v7action.BrowserLogin
+			result2 error
+		})
+	}
+	fake.startBrowserLoginReturnsOnCall[i] = struct {
+		result1 v7action.BrowserLogin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) StartDeviceLogin() (v7action.DeviceLogin, error) {
+	fake.startDeviceLoginMutex.Lock()
+	ret, specificReturn := fake.startDeviceLoginReturnsOnCall[len(fake.startDeviceLoginArgsForCall)]
+	fake.startDeviceLoginArgsForCall = append(fake.startDeviceLoginArgsForCall, struct {
+	}{})
+	stub := fake.StartDeviceLoginStub
+	fakeReturns := fake.startDeviceLoginReturns
+	fake.recordInvocation("StartDeviceLogin", []interface{}{})
+	fake.startDeviceLoginMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) StartDeviceLoginCallCount() int {
+	fake.startDeviceLoginMutex.RLock()
+	defer fake.startDeviceLoginMutex.RUnlock()
+	return len(fake.startDeviceLoginArgsForCall)
+}
+
+func (fake *FakeActor) StartDeviceLoginCalls(stub func() (v7action.DeviceLogin, error)) {
+	fake.startDeviceLoginMutex.Lock()
+	defer fake.startDeviceLoginMutex.Unlock()
+	fake.StartDeviceLoginStub = stub
+}
+
+func (fake *FakeActor) StartDeviceLoginReturns(result1 v7action.DeviceLogin, result2 error) {
+	fake.startDeviceLoginMutex.Lock()
+	defer fake.startDeviceLoginMutex.Unlock()
+	fake.StartDeviceLoginStub = nil
+	fake.startDeviceLoginReturns = struct {
+		result1 v7action.DeviceLogin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) StartDeviceLoginReturnsOnCall(i int, result1 v7action.DeviceLogin, result2 error) {
+	fake.startDeviceLoginMutex.Lock()
+	defer fake.startDeviceLoginMutex.Unlock()
+	fake.StartDeviceLoginStub = nil
+	if fake.startDeviceLoginReturnsOnCall == nil {
+		fake.startDeviceLoginReturnsOnCall = make(map[int]struct {
+			result1 v7action.DeviceLogin
+			result2 error
+		})
+	}
+	fake.startDeviceLoginReturnsOnCall[i] = struct {
+		result1 v7action.DeviceLogin
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) StopApplication(arg1 string) (v7action.Warnings, error) {
 	fake.stopApplicationMutex.Lock()
 	ret, specificReturn := fake.stopApplicationReturnsOnCall[len(fake.stopApplicationArgsForCall)]
@@ -18014,6 +19911,76 @@ func (fake *FakeActor) UpdateApplicationLabelsByApplicationNameReturnsOnCall(i i
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateApplicationSidecar(arg1 string, arg2 string, arg3 string, arg4 resources.Sidecar) (resources.Sidecar, v7action.Warnings, error) {
+	fake.updateApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.updateApplicationSidecarReturnsOnCall[len(fake.updateApplicationSidecarArgsForCall)]
+	fake.updateApplicationSidecarArgsForCall = append(fake.updateApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 resources.Sidecar
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateApplicationSidecarStub
+	fakeReturns := fake.updateApplicationSidecarReturns
+	fake.recordInvocation("UpdateApplicationSidecar", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateApplicationSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarCallCount() int {
+	fake.updateApplicationSidecarMutex.RLock()
+	defer fake.updateApplicationSidecarMutex.RUnlock()
+	return len(fake.updateApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarCalls(stub func(string, string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)) {
+	fake.updateApplicationSidecarMutex.Lock()
+	defer fake.updateApplicationSidecarMutex.Unlock()
+	fake.UpdateApplicationSidecarStub = stub
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarArgsForCall(i int) (string, string, string, resources.Sidecar) {
+	fake.updateApplicationSidecarMutex.RLock()
+	defer fake.updateApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.updateApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarReturns(result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.updateApplicationSidecarMutex.Lock()
+	defer fake.updateApplicationSidecarMutex.Unlock()
+	fake.UpdateApplicationSidecarStub = nil
+	fake.updateApplicationSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.updateApplicationSidecarMutex.Lock()
+	defer fake.updateApplicationSidecarMutex.Unlock()
+	fake.UpdateApplicationSidecarStub = nil
+	if fake.updateApplicationSidecarReturnsOnCall == nil {
+		fake.updateApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.updateApplicationSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) UpdateBuildpackByNameAndStackAndLifecycle(arg1 string, arg2 string, arg3 string, arg4 resources.Buildpack) (resources.Buildpack, v7action.Warnings, error) {
 	fake.updateBuildpackByNameAndStackAndLifecycleMutex.Lock()
 	ret, specificReturn := fake.updateBuildpackByNameAndStackAndLifecycleReturnsOnCall[len(fake.updateBuildpackByNameAndStackAndLifecycleArgsForCall)]
@@ -19813,11 +21780,79 @@ func (fake *FakeActor) UploadDropletReturnsOnCall(i int, result1 v7action.Warnin
 	}{result1, result2}
 }
 
+func (fake *FakeActor) WaitForApplication(arg1 resources.Application, arg2 v7action.AppWaitCondition, arg3 time.Duration) (v7action.Warnings, error) {
+	fake.waitForApplicationMutex.Lock()
+	ret, specificReturn := fake.waitForApplicationReturnsOnCall[len(fake.waitForApplicationArgsForCall)]
+	fake.waitForApplicationArgsForCall = append(fake.waitForApplicationArgsForCall, struct {
+		arg1 resources.Application
+		arg2 v7action.AppWaitCondition
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
+	stub := fake.WaitForApplicationStub
+	fakeReturns := fake.waitForApplicationReturns
+	fake.recordInvocation("WaitForApplication", []interface{}{arg1, arg2, arg3})
+	fake.waitForApplicationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) WaitForApplicationCallCount() int {
+	fake.waitForApplicationMutex.RLock()
+	defer fake.waitForApplicationMutex.RUnlock()
+	return len(fake.waitForApplicationArgsForCall)
+}
+
+func (fake *FakeActor) WaitForApplicationCalls(stub func(resources.Application, v7action.AppWaitCondition, time.Duration) (v7action.Warnings, error)) {
+	fake.waitForApplicationMutex.Lock()
+	defer fake.waitForApplicationMutex.Unlock()
+	fake.WaitForApplicationStub = stub
+}
+
+func (fake *FakeActor) WaitForApplicationArgsForCall(i int) (resources.Application, v7action.AppWaitCondition, time.Duration) {
+	fake.waitForApplicationMutex.RLock()
+	defer fake.waitForApplicationMutex.RUnlock()
+	argsForCall := fake.waitForApplicationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) WaitForApplicationReturns(result1 v7action.Warnings, result2 error) {
+	fake.waitForApplicationMutex.Lock()
+	defer fake.waitForApplicationMutex.Unlock()
+	fake.WaitForApplicationStub = nil
+	fake.waitForApplicationReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) WaitForApplicationReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.waitForApplicationMutex.Lock()
+	defer fake.waitForApplicationMutex.Unlock()
+	fake.WaitForApplicationStub = nil
+	if fake.waitForApplicationReturnsOnCall == nil {
+		fake.waitForApplicationReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.waitForApplicationReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.applyOrganizationQuotaByNameMutex.RLock()
 	defer fake.applyOrganizationQuotaByNameMutex.RUnlock()
+	fake.applySpacePlanMutex.RLock()
+	defer fake.applySpacePlanMutex.RUnlock()
 	fake.applySpaceQuotaByNameMutex.RLock()
 	defer fake.applySpaceQuotaByNameMutex.RUnlock()
 	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RLock()
@@ -19842,6 +21877,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.createApplicationDropletMutex.RUnlock()
 	fake.createApplicationInSpaceMutex.RLock()
 	defer fake.createApplicationInSpaceMutex.RUnlock()
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
 	fake.createBitsPackageByApplicationMutex.RLock()
 	defer fake.createBitsPackageByApplicationMutex.RUnlock()
 	fake.createBuildpackMutex.RLock()
@@ -19890,6 +21927,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
 	fake.deleteApplicationByNameAndSpaceMutex.RLock()
 	defer fake.deleteApplicationByNameAndSpaceMutex.RUnlock()
+	fake.deleteApplicationSidecarMutex.RLock()
+	defer fake.deleteApplicationSidecarMutex.RUnlock()
 	fake.deleteBuildpackByNameAndStackAndLifecycleMutex.RLock()
 	defer fake.deleteBuildpackByNameAndStackAndLifecycleMutex.RUnlock()
 	fake.deleteDomainMutex.RLock()
@@ -19936,18 +21975,28 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.disableFeatureFlagMutex.RUnlock()
 	fake.disableServiceAccessMutex.RLock()
 	defer fake.disableServiceAccessMutex.RUnlock()
-	fake.downloadCurrentDropletByAppNameMutex.RLock()
-	defer fake.downloadCurrentDropletByAppNameMutex.RUnlock()
-	fake.downloadDropletByGUIDAndAppNameMutex.RLock()
-	defer fake.downloadDropletByGUIDAndAppNameMutex.RUnlock()
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	fake.downloadPackageMutex.RLock()
+	defer fake.downloadPackageMutex.RUnlock()
 	fake.enableFeatureFlagMutex.RLock()
 	defer fake.enableFeatureFlagMutex.RUnlock()
 	fake.enableServiceAccessMutex.RLock()
 	defer fake.enableServiceAccessMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationByNameMutex.RLock()
 	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.RUnlock()
+	fake.exportSpaceMutex.RLock()
+	defer fake.exportSpaceMutex.RUnlock()
+	fake.finishBrowserLoginMutex.RLock()
+	defer fake.finishBrowserLoginMutex.RUnlock()
+	fake.finishDeviceLoginMutex.RLock()
+	defer fake.finishDeviceLoginMutex.RUnlock()
+	fake.getAppCrashesByNameAndSpaceMutex.RLock()
+	defer fake.getAppCrashesByNameAndSpaceMutex.RUnlock()
 	fake.getAppFeatureMutex.RLock()
 	defer fake.getAppFeatureMutex.RUnlock()
+	fake.getAppRequestsByNameAndSpaceMutex.RLock()
+	defer fake.getAppRequestsByNameAndSpaceMutex.RUnlock()
 	fake.getAppSummariesForSpaceMutex.RLock()
 	defer fake.getAppSummariesForSpaceMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
@@ -19958,6 +22007,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationLabelsMutex.RUnlock()
 	fake.getApplicationMapForRouteMutex.RLock()
 	defer fake.getApplicationMapForRouteMutex.RUnlock()
+	fake.getApplicationMetricsByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationMetricsByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationPackagesMutex.RLock()
 	defer fake.getApplicationPackagesMutex.RUnlock()
 	fake.getApplicationProcessHealthChecksByNameAndSpaceMutex.RLock()
@@ -19968,6 +22019,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationRevisionsDeployedMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
 	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsByNamesAndSpaceMutex.RLock()
@@ -19976,10 +22029,14 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getBuildpackLabelsMutex.RUnlock()
 	fake.getBuildpacksMutex.RLock()
 	defer fake.getBuildpacksMutex.RUnlock()
+	fake.getCurrentDropletByAppNameMutex.RLock()
+	defer fake.getCurrentDropletByAppNameMutex.RUnlock()
 	fake.getCurrentUserMutex.RLock()
 	defer fake.getCurrentUserMutex.RUnlock()
 	fake.getDefaultDomainMutex.RLock()
 	defer fake.getDefaultDomainMutex.RUnlock()
+	fake.getDeploymentStatusMutex.RLock()
+	defer fake.getDeploymentStatusMutex.RUnlock()
 	fake.getDetailedAppSummaryMutex.RLock()
 	defer fake.getDetailedAppSummaryMutex.RUnlock()
 	fake.getDomainMutex.RLock()
@@ -19988,6 +22045,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getDomainByNameMutex.RUnlock()
 	fake.getDomainLabelsMutex.RLock()
 	defer fake.getDomainLabelsMutex.RUnlock()
+	fake.getDropletByGUIDAndAppNameMutex.RLock()
+	defer fake.getDropletByGUIDAndAppNameMutex.RUnlock()
 	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
 	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
 	fake.getEnvironmentVariableGroupMutex.RLock()
@@ -19996,16 +22055,24 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getEnvironmentVariableGroupByRevisionMutex.RUnlock()
 	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
 	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
 	fake.getFeatureFlagByNameMutex.RLock()
 	defer fake.getFeatureFlagByNameMutex.RUnlock()
 	fake.getFeatureFlagsMutex.RLock()
 	defer fake.getFeatureFlagsMutex.RUnlock()
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getGlobalRunningSecurityGroupsMutex.RLock()
 	defer fake.getGlobalRunningSecurityGroupsMutex.RUnlock()
 	fake.getGlobalStagingSecurityGroupsMutex.RLock()
 	defer fake.getGlobalStagingSecurityGroupsMutex.RUnlock()
 	fake.getInfoResponseMutex.RLock()
 	defer fake.getInfoResponseMutex.RUnlock()
+	fake.getInstanceUsageForSpaceMutex.RLock()
+	defer fake.getInstanceUsageForSpaceMutex.RUnlock()
 	fake.getIsolationSegmentByNameMutex.RLock()
 	defer fake.getIsolationSegmentByNameMutex.RUnlock()
 	fake.getIsolationSegmentSummariesMutex.RLock()
@@ -20038,14 +22105,16 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getOrganizationSummaryByNameMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
+	fake.getPackageByGUIDAndAppNameMutex.RLock()
+	defer fake.getPackageByGUIDAndAppNameMutex.RUnlock()
 	fake.getProcessByTypeAndApplicationMutex.RLock()
 	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
 	fake.getRawApplicationManifestByNameAndSpaceMutex.RLock()
 	defer fake.getRawApplicationManifestByNameAndSpaceMutex.RUnlock()
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.RLock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.RUnlock()
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getRecentLogsForApplicationsInSpaceMutex.RLock()
+	defer fake.getRecentLogsForApplicationsInSpaceMutex.RUnlock()
 	fake.getRevisionByApplicationAndVersionMutex.RLock()
 	defer fake.getRevisionByApplicationAndVersionMutex.RUnlock()
 	fake.getRevisionsByApplicationNameAndSpaceMutex.RLock()
@@ -20132,6 +22201,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getStacksMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsForApplicationsInSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsInSpaceMutex.RUnlock()
 	fake.getTaskBySequenceIDAndApplicationMutex.RLock()
 	defer fake.getTaskBySequenceIDAndApplicationMutex.RUnlock()
 	fake.getUAAAPIVersionMutex.RLock()
@@ -20150,6 +22221,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.moveRouteMutex.RUnlock()
 	fake.parseAccessTokenMutex.RLock()
 	defer fake.parseAccessTokenMutex.RUnlock()
+	fake.planSpaceMutex.RLock()
+	defer fake.planSpaceMutex.RUnlock()
 	fake.pollBuildMutex.RLock()
 	defer fake.pollBuildMutex.RUnlock()
 	fake.pollPackageMutex.RLock()
@@ -20224,6 +22297,10 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.stagePackageMutex.RUnlock()
 	fake.startApplicationMutex.RLock()
 	defer fake.startApplicationMutex.RUnlock()
+	fake.startBrowserLoginMutex.RLock()
+	defer fake.startBrowserLoginMutex.RUnlock()
+	fake.startDeviceLoginMutex.RLock()
+	defer fake.startDeviceLoginMutex.RUnlock()
 	fake.stopApplicationMutex.RLock()
 	defer fake.stopApplicationMutex.RUnlock()
 	fake.terminateTaskMutex.RLock()
@@ -20248,6 +22325,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.updateApplicationMutex.RUnlock()
 	fake.updateApplicationLabelsByApplicationNameMutex.RLock()
 	defer fake.updateApplicationLabelsByApplicationNameMutex.RUnlock()
+	fake.updateApplicationSidecarMutex.RLock()
+	defer fake.updateApplicationSidecarMutex.RUnlock()
 	fake.updateBuildpackByNameAndStackAndLifecycleMutex.RLock()
 	defer fake.updateBuildpackByNameAndStackAndLifecycleMutex.RUnlock()
 	fake.updateBuildpackLabelsByBuildpackNameAndStackAndLifecycleMutex.RLock()
@@ -20302,6 +22381,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.uploadBuildpackMutex.RUnlock()
 	fake.uploadDropletMutex.RLock()
 	defer fake.uploadDropletMutex.RUnlock()
+	fake.waitForApplicationMutex.RLock()
+	defer fake.waitForApplicationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
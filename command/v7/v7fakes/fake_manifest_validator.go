@@ -0,0 +1,132 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+type FakeManifestValidator struct {
+	ValidateManifestStub        func(string, []string, []template.VarKV) ([]manifestparser.ManifestIssue, error)
+	validateManifestMutex       sync.RWMutex
+	validateManifestArgsForCall []struct {
+		arg1 string
+		arg2 []string
+		arg3 []template.VarKV
+	}
+	validateManifestReturns struct {
+		result1 []manifestparser.ManifestIssue
+		result2 error
+	}
+	validateManifestReturnsOnCall map[int]struct {
+		result1 []manifestparser.ManifestIssue
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeManifestValidator) ValidateManifest(arg1 string, arg2 []string, arg3 []template.VarKV) ([]manifestparser.ManifestIssue, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	var arg3Copy []template.VarKV
+	if arg3 != nil {
+		arg3Copy = make([]template.VarKV, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.validateManifestMutex.Lock()
+	ret, specificReturn := fake.validateManifestReturnsOnCall[len(fake.validateManifestArgsForCall)]
+	fake.validateManifestArgsForCall = append(fake.validateManifestArgsForCall, struct {
+		arg1 string
+		arg2 []string
+		arg3 []template.VarKV
+	}{arg1, arg2Copy, arg3Copy})
+	stub := fake.ValidateManifestStub
+	fakeReturns := fake.validateManifestReturns
+	fake.recordInvocation("ValidateManifest", []interface{}{arg1, arg2Copy, arg3Copy})
+	fake.validateManifestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManifestValidator) ValidateManifestCallCount() int {
+	fake.validateManifestMutex.RLock()
+	defer fake.validateManifestMutex.RUnlock()
+	return len(fake.validateManifestArgsForCall)
+}
+
+func (fake *FakeManifestValidator) ValidateManifestCalls(stub func(string, []string, []template.VarKV) ([]manifestparser.ManifestIssue, error)) {
+	fake.validateManifestMutex.Lock()
+	defer fake.validateManifestMutex.Unlock()
+	fake.ValidateManifestStub = stub
+}
+
+func (fake *FakeManifestValidator) ValidateManifestArgsForCall(i int) (string, []string, []template.VarKV) {
+	fake.validateManifestMutex.RLock()
+	defer fake.validateManifestMutex.RUnlock()
+	argsForCall := fake.validateManifestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeManifestValidator) ValidateManifestReturns(result1 []manifestparser.ManifestIssue, result2 error) {
+	fake.validateManifestMutex.Lock()
+	defer fake.validateManifestMutex.Unlock()
+	fake.ValidateManifestStub = nil
+	fake.validateManifestReturns = struct {
+		result1 []manifestparser.ManifestIssue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManifestValidator) ValidateManifestReturnsOnCall(i int, result1 []manifestparser.ManifestIssue, result2 error) {
+	fake.validateManifestMutex.Lock()
+	defer fake.validateManifestMutex.Unlock()
+	fake.ValidateManifestStub = nil
+	if fake.validateManifestReturnsOnCall == nil {
+		fake.validateManifestReturnsOnCall = make(map[int]struct {
+			result1 []manifestparser.ManifestIssue
+			result2 error
+		})
+	}
+	fake.validateManifestReturnsOnCall[i] = struct {
+		result1 []manifestparser.ManifestIssue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManifestValidator) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.validateManifestMutex.RLock()
+	defer fake.validateManifestMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeManifestValidator) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.ManifestValidator = new(FakeManifestValidator)
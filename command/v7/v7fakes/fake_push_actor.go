@@ -4,6 +4,7 @@ package v7fakes
 import (
 	"sync"
 
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7pushaction"
 	v7 "code.cloudfoundry.org/cli/command/v7"
@@ -23,6 +24,24 @@ type FakePushActor struct {
 	actualizeReturnsOnCall map[int]struct {
 		result1 <-chan *v7pushaction.PushEvent
 	}
+	CreateDryRunPushPlansStub        func(string, string, manifestparser.Manifest, v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)
+	createDryRunPushPlansMutex       sync.RWMutex
+	createDryRunPushPlansArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 manifestparser.Manifest
+		arg4 v7pushaction.FlagOverrides
+	}
+	createDryRunPushPlansReturns struct {
+		result1 []v7pushaction.PushPlan
+		result2 v7action.Warnings
+		result3 error
+	}
+	createDryRunPushPlansReturnsOnCall map[int]struct {
+		result1 []v7pushaction.PushPlan
+		result2 v7action.Warnings
+		result3 error
+	}
 	CreatePushPlansStub        func(string, string, manifestparser.Manifest, v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)
 	createPushPlansMutex       sync.RWMutex
 	createPushPlansArgsForCall []struct {
@@ -69,6 +88,23 @@ type FakePushActor struct {
 		result1 manifestparser.Manifest
 		result2 error
 	}
+	MatchPushPlanResourcesStub        func(v7pushaction.PushPlan) ([]sharedaction.V3Resource, []sharedaction.V3Resource, v7pushaction.Warnings, error)
+	matchPushPlanResourcesMutex       sync.RWMutex
+	matchPushPlanResourcesArgsForCall []struct {
+		arg1 v7pushaction.PushPlan
+	}
+	matchPushPlanResourcesReturns struct {
+		result1 []sharedaction.V3Resource
+		result2 []sharedaction.V3Resource
+		result3 v7pushaction.Warnings
+		result4 error
+	}
+	matchPushPlanResourcesReturnsOnCall map[int]struct {
+		result1 []sharedaction.V3Resource
+		result2 []sharedaction.V3Resource
+		result3 v7pushaction.Warnings
+		result4 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -135,6 +171,76 @@ func (fake *FakePushActor) ActualizeReturnsOnCall(i int, result1 <-chan *v7pusha
 	}{result1}
 }
 
+func (fake *FakePushActor) CreateDryRunPushPlans(arg1 string, arg2 string, arg3 manifestparser.Manifest, arg4 v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error) {
+	fake.createDryRunPushPlansMutex.Lock()
+	ret, specificReturn := fake.createDryRunPushPlansReturnsOnCall[len(fake.createDryRunPushPlansArgsForCall)]
+	fake.createDryRunPushPlansArgsForCall = append(fake.createDryRunPushPlansArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 manifestparser.Manifest
+		arg4 v7pushaction.FlagOverrides
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateDryRunPushPlansStub
+	fakeReturns := fake.createDryRunPushPlansReturns
+	fake.recordInvocation("CreateDryRunPushPlans", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createDryRunPushPlansMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePushActor) CreateDryRunPushPlansCallCount() int {
+	fake.createDryRunPushPlansMutex.RLock()
+	defer fake.createDryRunPushPlansMutex.RUnlock()
+	return len(fake.createDryRunPushPlansArgsForCall)
+}
+
+func (fake *FakePushActor) CreateDryRunPushPlansCalls(stub func(string, string, manifestparser.Manifest, v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)) {
+	fake.createDryRunPushPlansMutex.Lock()
+	defer fake.createDryRunPushPlansMutex.Unlock()
+	fake.CreateDryRunPushPlansStub = stub
+}
+
+func (fake *FakePushActor) CreateDryRunPushPlansArgsForCall(i int) (string, string, manifestparser.Manifest, v7pushaction.FlagOverrides) {
+	fake.createDryRunPushPlansMutex.RLock()
+	defer fake.createDryRunPushPlansMutex.RUnlock()
+	argsForCall := fake.createDryRunPushPlansArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePushActor) CreateDryRunPushPlansReturns(result1 []v7pushaction.PushPlan, result2 v7action.Warnings, result3 error) {
+	fake.createDryRunPushPlansMutex.Lock()
+	defer fake.createDryRunPushPlansMutex.Unlock()
+	fake.CreateDryRunPushPlansStub = nil
+	fake.createDryRunPushPlansReturns = struct {
+		result1 []v7pushaction.PushPlan
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) CreateDryRunPushPlansReturnsOnCall(i int, result1 []v7pushaction.PushPlan, result2 v7action.Warnings, result3 error) {
+	fake.createDryRunPushPlansMutex.Lock()
+	defer fake.createDryRunPushPlansMutex.Unlock()
+	fake.CreateDryRunPushPlansStub = nil
+	if fake.createDryRunPushPlansReturnsOnCall == nil {
+		fake.createDryRunPushPlansReturnsOnCall = make(map[int]struct {
+			result1 []v7pushaction.PushPlan
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.createDryRunPushPlansReturnsOnCall[i] = struct {
+		result1 []v7pushaction.PushPlan
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePushActor) CreatePushPlans(arg1 string, arg2 string, arg3 manifestparser.Manifest, arg4 v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error) {
 	fake.createPushPlansMutex.Lock()
 	ret, specificReturn := fake.createPushPlansReturnsOnCall[len(fake.createPushPlansArgsForCall)]
@@ -335,17 +441,91 @@ func (fake *FakePushActor) HandleFlagOverridesReturnsOnCall(i int, result1 manif
 	}{result1, result2}
 }
 
+func (fake *FakePushActor) MatchPushPlanResources(arg1 v7pushaction.PushPlan) ([]sharedaction.V3Resource, []sharedaction.V3Resource, v7pushaction.Warnings, error) {
+	fake.matchPushPlanResourcesMutex.Lock()
+	ret, specificReturn := fake.matchPushPlanResourcesReturnsOnCall[len(fake.matchPushPlanResourcesArgsForCall)]
+	fake.matchPushPlanResourcesArgsForCall = append(fake.matchPushPlanResourcesArgsForCall, struct {
+		arg1 v7pushaction.PushPlan
+	}{arg1})
+	stub := fake.MatchPushPlanResourcesStub
+	fakeReturns := fake.matchPushPlanResourcesReturns
+	fake.recordInvocation("MatchPushPlanResources", []interface{}{arg1})
+	fake.matchPushPlanResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakePushActor) MatchPushPlanResourcesCallCount() int {
+	fake.matchPushPlanResourcesMutex.RLock()
+	defer fake.matchPushPlanResourcesMutex.RUnlock()
+	return len(fake.matchPushPlanResourcesArgsForCall)
+}
+
+func (fake *FakePushActor) MatchPushPlanResourcesCalls(stub func(v7pushaction.PushPlan) ([]sharedaction.V3Resource, []sharedaction.V3Resource, v7pushaction.Warnings, error)) {
+	fake.matchPushPlanResourcesMutex.Lock()
+	defer fake.matchPushPlanResourcesMutex.Unlock()
+	fake.MatchPushPlanResourcesStub = stub
+}
+
+func (fake *FakePushActor) MatchPushPlanResourcesArgsForCall(i int) v7pushaction.PushPlan {
+	fake.matchPushPlanResourcesMutex.RLock()
+	defer fake.matchPushPlanResourcesMutex.RUnlock()
+	argsForCall := fake.matchPushPlanResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePushActor) MatchPushPlanResourcesReturns(result1 []sharedaction.V3Resource, result2 []sharedaction.V3Resource, result3 v7pushaction.Warnings, result4 error) {
+	fake.matchPushPlanResourcesMutex.Lock()
+	defer fake.matchPushPlanResourcesMutex.Unlock()
+	fake.MatchPushPlanResourcesStub = nil
+	fake.matchPushPlanResourcesReturns = struct {
+		result1 []sharedaction.V3Resource
+		result2 []sharedaction.V3Resource
+		result3 v7pushaction.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakePushActor) MatchPushPlanResourcesReturnsOnCall(i int, result1 []sharedaction.V3Resource, result2 []sharedaction.V3Resource, result3 v7pushaction.Warnings, result4 error) {
+	fake.matchPushPlanResourcesMutex.Lock()
+	defer fake.matchPushPlanResourcesMutex.Unlock()
+	fake.MatchPushPlanResourcesStub = nil
+	if fake.matchPushPlanResourcesReturnsOnCall == nil {
+		fake.matchPushPlanResourcesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.V3Resource
+			result2 []sharedaction.V3Resource
+			result3 v7pushaction.Warnings
+			result4 error
+		})
+	}
+	fake.matchPushPlanResourcesReturnsOnCall[i] = struct {
+		result1 []sharedaction.V3Resource
+		result2 []sharedaction.V3Resource
+		result3 v7pushaction.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakePushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.actualizeMutex.RLock()
 	defer fake.actualizeMutex.RUnlock()
+	fake.createDryRunPushPlansMutex.RLock()
+	defer fake.createDryRunPushPlansMutex.RUnlock()
 	fake.createPushPlansMutex.RLock()
 	defer fake.createPushPlansMutex.RUnlock()
 	fake.handleDeploymentScaleFlagOverridesMutex.RLock()
 	defer fake.handleDeploymentScaleFlagOverridesMutex.RUnlock()
 	fake.handleFlagOverridesMutex.RLock()
 	defer fake.handleFlagOverridesMutex.RUnlock()
+	fake.matchPushPlanResourcesMutex.RLock()
+	defer fake.matchPushPlanResourcesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
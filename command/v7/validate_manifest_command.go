@@ -0,0 +1,118 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ManifestValidator
+
+type ManifestValidator interface {
+	ValidateManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]manifestparser.ManifestIssue, error)
+}
+
+type ValidateManifestCommand struct {
+	UI     command.UI
+	Config command.Config
+
+	RequiredArgs     flag.OptionalManifestPath     `positional-args:"yes"`
+	Vars             []template.VarKV              `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	usage            interface{}                   `usage:"CF_NAME validate-manifest [PATH] [--vars-file VARS_FILE_PATH]... [--var KEY=VALUE]...\n\n   Checks a manifest for problems without contacting Cloud Foundry. PATH defaults to the current directory."`
+	relatedCommands  interface{}                   `related_commands:"apply-manifest, create-app-manifest, push"`
+
+	ManifestLocator   ManifestLocator
+	ManifestValidator ManifestValidator
+	CWD               string
+}
+
+func (cmd *ValidateManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.ManifestLocator = manifestparser.NewLocator()
+	cmd.ManifestValidator = manifestparser.ManifestParser{}
+
+	currentDir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	cmd.CWD = currentDir
+
+	return nil
+}
+
+func (cmd ValidateManifestCommand) Execute(args []string) error {
+	readPath := cmd.CWD
+	if cmd.RequiredArgs.PathToManifest != "" {
+		readPath = cmd.RequiredArgs.PathToManifest
+	}
+
+	pathToManifest, exists, err := cmd.ManifestLocator.Path(readPath)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: readPath}
+	}
+
+	cmd.UI.DisplayText("Validating manifest {{.ManifestPath}}...", map[string]interface{}{
+		"ManifestPath": pathToManifest,
+	})
+
+	var pathsToVarsFiles []string
+	for _, varFilePath := range cmd.PathsToVarsFiles {
+		pathsToVarsFiles = append(pathsToVarsFiles, string(varFilePath))
+	}
+
+	issues, err := cmd.ManifestValidator.ValidateManifest(pathToManifest, pathsToVarsFiles, cmd.Vars)
+	if err != nil {
+		return err
+	}
+
+	if len(issues) == 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	for _, issue := range issues {
+		cmd.displayIssue(pathToManifest, issue)
+	}
+	cmd.UI.DisplayNewline()
+
+	return translatableerror.InvalidManifestError{
+		PathToManifest: pathToManifest,
+		IssueCount:     len(issues),
+	}
+}
+
+// displayIssue prints an issue as FILE:LINE: FIELD: MESSAGE so that editors
+// and pre-commit hooks can jump to it; unknown parts are left out.
+func (cmd ValidateManifestCommand) displayIssue(pathToManifest string, issue manifestparser.ManifestIssue) {
+	location := pathToManifest
+	if issue.Line > 0 {
+		location = fmt.Sprintf("%s:%d", pathToManifest, issue.Line)
+	}
+
+	if issue.Field == "" {
+		cmd.UI.DisplayText("{{.Location}}: {{.Message}}", map[string]interface{}{
+			"Location": location,
+			"Message":  issue.Message,
+		})
+		return
+	}
+
+	cmd.UI.DisplayText("{{.Location}}: {{.Field}}: {{.Message}}", map[string]interface{}{
+		"Location": location,
+		"Field":    issue.Field,
+		"Message":  issue.Message,
+	})
+}
@@ -0,0 +1,123 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("validate-manifest Command", func() {
+	var (
+		cmd           ValidateManifestCommand
+		testUI        *ui.UI
+		fakeConfig    *commandfakes.FakeConfig
+		fakeLocator   *v7fakes.FakeManifestLocator
+		fakeValidator *v7fakes.FakeManifestValidator
+		executeErr    error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeLocator = new(v7fakes.FakeManifestLocator)
+		fakeValidator = new(v7fakes.FakeManifestValidator)
+
+		cmd = ValidateManifestCommand{
+			UI:                testUI,
+			Config:            fakeConfig,
+			ManifestLocator:   fakeLocator,
+			ManifestValidator: fakeValidator,
+			CWD:               "fake-directory",
+		}
+
+		fakeLocator.PathReturns("some-dir/manifest.yml", true, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("looks for the manifest in the current directory", func() {
+		Expect(fakeLocator.PathCallCount()).To(Equal(1))
+		Expect(fakeLocator.PathArgsForCall(0)).To(Equal("fake-directory"))
+	})
+
+	When("a path is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.PathToManifest = "some-dir"
+			cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"vars.yml"}
+			cmd.Vars = []template.VarKV{{Name: "memory", Value: "1G"}}
+		})
+
+		It("validates the manifest at that path with the vars", func() {
+			Expect(fakeLocator.PathArgsForCall(0)).To(Equal("some-dir"))
+
+			Expect(fakeValidator.ValidateManifestCallCount()).To(Equal(1))
+			path, varsFiles, vars := fakeValidator.ValidateManifestArgsForCall(0)
+			Expect(path).To(Equal("some-dir/manifest.yml"))
+			Expect(varsFiles).To(Equal([]string{"vars.yml"}))
+			Expect(vars).To(Equal([]template.VarKV{{Name: "memory", Value: "1G"}}))
+		})
+	})
+
+	When("the manifest cannot be found", func() {
+		BeforeEach(func() {
+			fakeLocator.PathReturns("", false, nil)
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: "fake-directory"}))
+			Expect(fakeValidator.ValidateManifestCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the manifest is valid", func() {
+		It("displays OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Validating manifest some-dir/manifest\.yml\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("the manifest has issues", func() {
+		BeforeEach(func() {
+			fakeValidator.ValidateManifestReturns([]manifestparser.ManifestIssue{
+				{Line: 4, Field: "applications[0].memroy", Message: `unknown key "memroy", did you mean "memory"?`},
+				{Line: 7, Message: "found a tab character that violates indentation"},
+				{Field: "applications", Message: "Manifest must have at least one application."},
+			}, nil)
+		})
+
+		It("displays each issue and returns an error", func() {
+			Expect(testUI.Out).To(Say(`some-dir/manifest\.yml:4: applications\[0\]\.memroy: unknown key "memroy", did you mean "memory"\?`))
+			Expect(testUI.Out).To(Say(`some-dir/manifest\.yml:7: found a tab character that violates indentation`))
+			Expect(testUI.Out).To(Say(`some-dir/manifest\.yml: applications: Manifest must have at least one application\.`))
+			Expect(testUI.Out).NotTo(Say("OK"))
+
+			Expect(executeErr).To(MatchError(translatableerror.InvalidManifestError{
+				PathToManifest: "some-dir/manifest.yml",
+				IssueCount:     3,
+			}))
+		})
+	})
+
+	When("validating fails", func() {
+		BeforeEach(func() {
+			fakeValidator.ValidateManifestReturns(nil, errors.New("interpolation-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("interpolation-error"))
+		})
+	})
+})
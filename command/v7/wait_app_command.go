@@ -0,0 +1,82 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type WaitAppCommand struct {
+	BaseCommand
+
+	RequiredArgs        flag.AppName          `positional-args:"yes"`
+	ProcessType         string                `long:"process" default:"web" description:"App process to wait for"`
+	Instances           flag.PositiveInteger  `long:"instances" short:"i" description:"Number of instances that must be running (Default: all instances of the process)"`
+	State               string                `long:"state" choice:"running" choice:"stopped" default:"running" description:"State the instances must reach"`
+	DeploymentStatus    string                `long:"deployment-status" choice:"deploying" choice:"paused" choice:"deployed" choice:"canceled" choice:"superseded" description:"Also wait for the latest deployment of the app to have this status"`
+	Timeout             flag.PositiveDuration `long:"timeout" short:"t" description:"Time to wait before giving up, such as 90s or 10m (Default: CF_STARTUP_TIMEOUT)"`
+	usage               interface{}           `usage:"CF_NAME wait-app APP_NAME [--process PROCESS] [--instances N] [--state (running | stopped)] [--deployment-status STATUS] [--timeout DURATION]\n\nEXAMPLES:\n   CF_NAME wait-app my-app\n   CF_NAME wait-app my-app --process worker --instances 2 --timeout 2m\n   CF_NAME wait-app my-app --state stopped\n   CF_NAME wait-app my-app --deployment-status deployed"`
+	relatedCommands     interface{}           `related_commands:"app, push, restart, start"`
+	envCFStartupTimeout interface{}           `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+}
+
+func (cmd WaitAppCommand) Execute(args []string) error {
+	if cmd.State == "stopped" && cmd.Instances.Value != 0 {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--instances", "--state stopped"},
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	condition := v7action.AppWaitCondition{
+		ProcessType:      cmd.ProcessType,
+		State:            constant.ProcessInstanceRunning,
+		Instances:        int(cmd.Instances.Value),
+		DeploymentStatus: constant.DeploymentStatusReason(strings.ToUpper(cmd.DeploymentStatus)),
+	}
+	if cmd.State == "stopped" {
+		condition.State = constant.ProcessInstanceDown
+	}
+
+	timeout := cmd.Timeout.Duration
+	if !cmd.Timeout.IsSet() {
+		timeout = cmd.Config.StartupTimeout()
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Waiting for process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} to be {{.State}} as {{.Username}}...", map[string]interface{}{
+		"ProcessType": cmd.ProcessType,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"State":       cmd.State,
+		"Username":    user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	warnings, err = cmd.Actor.WaitForApplication(app, condition, timeout)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return mapErr(cmd.Config, app.Name, err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,211 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("wait-app Command", func() {
+	var (
+		cmd             v7.WaitAppCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.StartupTimeoutReturns(5 * time.Minute)
+
+		cmd = v7.WaitAppCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			ProcessType:  "web",
+			State:        "running",
+
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("--instances is combined with --state stopped", func() {
+		BeforeEach(func() {
+			cmd.Instances = flag.PositiveInteger{Value: 2}
+			cmd.State = "stopped"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--instances", "--state stopped"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is logged in and targeted", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				resources.Application{Name: "some-app", GUID: "some-app-guid"},
+				v7action.Warnings{"app-warning"},
+				nil,
+			)
+			fakeActor.WaitForApplicationReturns(v7action.Warnings{"wait-warning"}, nil)
+		})
+
+		It("waits for every instance of the process to be running", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Waiting for process web of app some-app in org some-org / space some-space to be running as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("wait-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			app, condition, timeout := fakeActor.WaitForApplicationArgsForCall(0)
+			Expect(app.GUID).To(Equal("some-app-guid"))
+			Expect(condition).To(Equal(v7action.AppWaitCondition{
+				ProcessType: "web",
+				State:       constant.ProcessInstanceRunning,
+			}))
+			Expect(timeout).To(Equal(5 * time.Minute))
+		})
+
+		When("the flags are provided", func() {
+			BeforeEach(func() {
+				cmd.ProcessType = "worker"
+				cmd.Instances = flag.PositiveInteger{Value: 2}
+				cmd.DeploymentStatus = "deployed"
+				cmd.Timeout = flag.PositiveDuration{Duration: 90 * time.Second}
+			})
+
+			It("waits for the requested condition", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, condition, timeout := fakeActor.WaitForApplicationArgsForCall(0)
+				Expect(condition).To(Equal(v7action.AppWaitCondition{
+					ProcessType:      "worker",
+					State:            constant.ProcessInstanceRunning,
+					Instances:        2,
+					DeploymentStatus: constant.DeploymentStatusReasonDeployed,
+				}))
+				Expect(timeout).To(Equal(90 * time.Second))
+			})
+		})
+
+		When("waiting for the app to stop", func() {
+			BeforeEach(func() {
+				cmd.State = "stopped"
+			})
+
+			It("waits for the instances to be down", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`to be stopped as steve\.\.\.`))
+
+				_, condition, _ := fakeActor.WaitForApplicationArgsForCall(0)
+				Expect(condition.State).To(Equal(constant.ProcessInstanceDown))
+			})
+		})
+
+		When("getting the app fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{}, v7action.Warnings{"app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(testUI.Err).To(Say("app-warning"))
+				Expect(fakeActor.WaitForApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the wait times out", func() {
+			BeforeEach(func() {
+				fakeActor.WaitForApplicationReturns(
+					v7action.Warnings{"wait-warning"},
+					actionerror.AppWaitTimeoutError{Name: "some-app", Reasons: []string{"instance #0 is crashed"}},
+				)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.AppWaitTimeoutError{Name: "some-app", Reasons: []string{"instance #0 is crashed"}}))
+				Expect(testUI.Err).To(Say("wait-warning"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+
+		When("every instance crashes", func() {
+			BeforeEach(func() {
+				fakeActor.WaitForApplicationReturns(v7action.Warnings{"wait-warning"}, actionerror.AllInstancesCrashedError{})
+			})
+
+			It("returns an ApplicationUnableToStartError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationUnableToStartError{
+					AppName:    "some-app",
+					BinaryName: binaryName,
+				}))
+				Expect(testUI.Err).To(Say("wait-warning"))
+			})
+		})
+
+		When("the wait fails", func() {
+			BeforeEach(func() {
+				fakeActor.WaitForApplicationReturns(nil, errors.New("wait-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("wait-error"))
+			})
+		})
+	})
+})
@@ -30,10 +30,12 @@ var _ = Describe("auth command", func() {
 			Eventually(session).Should(Say("cf auth CLIENT_ID CLIENT_SECRET --client-credentials\n"))
 			Eventually(session).Should(Say("cf auth CLIENT_ID CLIENT_SECRET --assertion ID-TOKEN\n"))
 			Eventually(session).Should(Say("cf auth CLIENT_ID --client-credentials --assertion ACCESS-TOKEN\n"))
+			Eventually(session).Should(Say("cf auth CLIENT_ID CLIENT_SECRET --assertion-file ID-TOKEN-FILE\n"))
 
 			Eventually(session).Should(Say("ENVIRONMENT VARIABLES:"))
 			Eventually(session).Should(Say(`CF_USERNAME=user\s+Authenticating user. Overridden if USERNAME argument is provided.`))
 			Eventually(session).Should(Say(`CF_PASSWORD=password\s+Password associated with user. Overridden if PASSWORD argument is provided.`))
+			Eventually(session).Should(Say(`CF_ASSERTION_FILE=file\s+File containing the assertion \(user\). Overridden if --assertion or --assertion-file is provided.`))
 
 			Eventually(session).Should(Say("WARNING:"))
 			Eventually(session).Should(Say("Providing your password as a command line option is highly discouraged"))
@@ -48,6 +50,7 @@ var _ = Describe("auth command", func() {
 			Eventually(session).Should(Say("--client-credentials\\s+Use \\(non-user\\) service account \\(also called client credentials\\)\n"))
 			Eventually(session).Should(Say("--origin\\s+Indicates the identity provider to be used for authentication\n"))
 			Eventually(session).Should(Say("--assertion\\s+Token based authentication with assertion \\(user\\) or in combination with client-credentials \\(non-user\\)\n"))
+			Eventually(session).Should(Say("--assertion-file\\s+Token based authentication with the assertion \\(user\\) in the file, read again whenever the token is refreshed\n"))
 
 			Eventually(session).Should(Say("SEE ALSO:"))
 			Eventually(session).Should(Say("api, login, target"))
@@ -1,9 +1,11 @@
 package isolated
 
 import (
+	"os"
 	"strings"
 
 	"code.cloudfoundry.org/cli/integration/helpers"
+	"code.cloudfoundry.org/cli/util/configv3"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -137,4 +139,27 @@ var _ = Describe("config command", func() {
 		Entry("false", "false"),
 		Entry("path", "some/path"),
 	)
+
+	When("the credentials cannot be read from the credential store", func() {
+		BeforeEach(func() {
+			Expect(os.Setenv("CF_CREDENTIALS_PASSPHRASE", "some-passphrase")).To(Succeed())
+			helpers.SetConfig(func(config *configv3.Config) {
+				Expect(config.SetCredentialStore(configv3.CredentialStoreFile)).To(Succeed())
+				config.SetAccessToken("bearer some-token")
+			})
+			Expect(os.Unsetenv("CF_CREDENTIALS_PASSPHRASE")).To(Succeed())
+		})
+
+		It("warns and can switch back to keeping the credentials in config.json", func() {
+			session := helpers.CF("config", "--credential-store", "none")
+			Eventually(session.Err).Should(Say("Unable to read cf-cli://default/AccessToken from the credential store: CF_CREDENTIALS_PASSPHRASE must be set to use the file credential store"))
+			Eventually(session.Err).Should(Say("You are logged out until the credentials can be read."))
+			Eventually(session).Should(Say("OK"))
+			Eventually(session).Should(Exit(0))
+
+			config := helpers.GetConfig()
+			Expect(config.CredentialStore()).To(Equal(configv3.CredentialStoreNone))
+			Expect(config.AccessToken()).To(BeEmpty())
+		})
+	})
 })
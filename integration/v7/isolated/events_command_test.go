@@ -37,6 +37,9 @@ var _ = Describe("events command", func() {
 				Eventually(session).Should(Say("events - Show recent app events"))
 				Eventually(session).Should(Say("USAGE:"))
 				Eventually(session).Should(Say("cf events APP_NAME"))
+				Eventually(session).Should(Say(`cf events \(--space \| --org \| --foundation\) \[FILTERS\]`))
+				Eventually(session).Should(Say("--actor"))
+				Eventually(session).Should(Say("--all"))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("app, logs, map-route, unmap-route"))
 
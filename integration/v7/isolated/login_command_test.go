@@ -31,7 +31,7 @@ var _ = Describe("login command", func() {
 				Expect(session).Should(Say("login - Log user in"))
 
 				Expect(session).Should(Say("USAGE:\n"))
-				Expect(session).Should(Say(`cf login \[-a API_URL\] \[-u USERNAME\] \[-p PASSWORD\] \[-o ORG\] \[-s SPACE\] \[--sso | --sso-passcode PASSCODE\] \[--origin ORIGIN\]`))
+				Expect(session).Should(Say(`cf login \[-a API_URL\] \[-u USERNAME\] \[-p PASSWORD\] \[-o ORG\] \[-s SPACE\] \[--sso | --sso-passcode PASSCODE | --browser | --device\] \[--origin ORIGIN\]`))
 
 				Expect(session).Should(Say("WARNING:\n"))
 				Expect(session).Should(Say("Providing your password as a command line option is highly discouraged\n"))
@@ -43,6 +43,8 @@ var _ = Describe("login command", func() {
 				Expect(session).Should(Say(regexp.QuoteMeta("cf login -u name@example.com -p \"my password\" (use quotes for passwords with a space)")))
 				Expect(session).Should(Say(regexp.QuoteMeta("cf login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)")))
 				Expect(session).Should(Say(regexp.QuoteMeta("cf login --sso (cf will provide a url to obtain a one-time passcode to login)")))
+				Expect(session).Should(Say(regexp.QuoteMeta("cf login --browser (cf will open a browser to login)")))
+				Expect(session).Should(Say(regexp.QuoteMeta("cf login --device (cf will provide a url and a code to login from another device)")))
 				Expect(session).Should(Say(regexp.QuoteMeta("cf login --origin ldap")))
 
 				Expect(session).Should(Say("ALIAS:\n"))
@@ -50,6 +52,8 @@ var _ = Describe("login command", func() {
 
 				Expect(session).Should(Say("OPTIONS:\n"))
 				Expect(session).Should(Say(`-a\s+API endpoint \(e.g. https://api\.example\.com\)`))
+				Expect(session).Should(Say(`--browser\s+Open a browser to login`))
+				Expect(session).Should(Say(`--device\s+Login with a user code entered in a browser on another device`))
 				Expect(session).Should(Say(`-o\s+Org`))
 				Expect(session).Should(Say(`-p\s+Password`))
 				Expect(session).Should(Say(`-s\s+Space`))
@@ -9,6 +9,7 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/command_parser"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/panichandler"
@@ -45,6 +46,7 @@ func main() {
 
 	if unknownCommandError, ok := err.(command_parser.UnknownCommandError); ok {
 		plugin, commandIsPlugin := plugin_util.IsPluginCommand(os.Args[1:])
+		pluginCommand, pluginCommandWithProfile := plugin_util.IsPluginCommandWithProfile(os.Args[1:])
 
 		switch {
 		case commandIsPlugin:
@@ -53,6 +55,10 @@ func main() {
 				exitCode = 1
 			}
 
+		case pluginCommandWithProfile:
+			commandUI.DisplayError(translatableerror.PluginProfileError{Command: pluginCommand})
+			exitCode = 1
+
 		case common.ShouldFallbackToLegacy:
 			cmd.Main(os.Getenv("CF_TRACE"), os.Args)
 			//NOT REACHED, legacy main will exit the process
@@ -0,0 +1,9 @@
+package resources
+
+// BitsChecksum is the hashing algorithm and hash of a droplet's or package's bits.
+type BitsChecksum struct {
+	// Type is the hashing algorithm, either sha256 or sha1.
+	Type string `json:"type"`
+	// Value is the hex encoded hash.
+	Value string `json:"value"`
+}
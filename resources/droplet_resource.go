@@ -14,6 +14,8 @@ type Droplet struct {
 	AppGUID string `json:"app_guid"`
 	//Buildpacks are the detected buildpacks from the staging process.
 	Buildpacks []DropletBuildpack `json:"buildpacks,omitempty"`
+	// Checksum is the hash of the droplet bits.
+	Checksum BitsChecksum `json:"checksum,omitempty"`
 	// CreatedAt is the timestamp that the Cloud Controller created the droplet.
 	CreatedAt string `json:"created_at"`
 	// GUID is the unique droplet identifier.
@@ -96,6 +98,7 @@ func (d *Droplet) UnmarshalJSON(data []byte) error {
 	var alias struct {
 		GUID          string                `json:"guid,omitempty"`
 		Buildpacks    []DropletBuildpack    `json:"buildpacks,omitempty"`
+		Checksum      BitsChecksum          `json:"checksum,omitempty"`
 		CreatedAt     string                `json:"created_at,omitempty"`
 		Image         string                `json:"image,omitempty"`
 		Stack         string                `json:"stack,omitempty"`
@@ -116,6 +119,7 @@ func (d *Droplet) UnmarshalJSON(data []byte) error {
 
 	d.GUID = alias.GUID
 	d.Buildpacks = alias.Buildpacks
+	d.Checksum = alias.Checksum
 	d.CreatedAt = alias.CreatedAt
 	d.Image = alias.Image
 	d.Stack = alias.Stack
@@ -9,6 +9,9 @@ import (
 
 // Package represents a Cloud Controller V3 Package.
 type Package struct {
+	// Checksum is the hash of the package's bits.
+	Checksum BitsChecksum
+
 	// CreatedAt is the time with zone when the object was created.
 	CreatedAt string
 
@@ -81,9 +84,10 @@ func (p *Package) UnmarshalJSON(data []byte) error {
 		State         constant.PackageState `json:"state,omitempty"`
 		Type          constant.PackageType  `json:"type,omitempty"`
 		Data          struct {
-			Image    string `json:"image"`
-			Username string `json:"username"`
-			Password string `json:"password"`
+			Image    string       `json:"image"`
+			Username string       `json:"username"`
+			Password string       `json:"password"`
+			Checksum BitsChecksum `json:"checksum"`
 		} `json:"data"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccPackage)
@@ -100,6 +104,7 @@ func (p *Package) UnmarshalJSON(data []byte) error {
 	p.DockerImage = ccPackage.Data.Image
 	p.DockerUsername = ccPackage.Data.Username
 	p.DockerPassword = ccPackage.Data.Password
+	p.Checksum = ccPackage.Data.Checksum
 
 	return nil
 }
@@ -1,9 +1,40 @@
 package resources
 
-import "code.cloudfoundry.org/cli/types"
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/types"
+)
 
 type Sidecar struct {
 	GUID    string               `json:"guid"`
 	Name    string               `json:"name"`
 	Command types.FilteredString `json:"command"`
+	// ProcessTypes are the types of the app's processes the sidecar runs alongside.
+	ProcessTypes []string `json:"process_types"`
+	// MemoryInMB is the memory reserved for the sidecar out of its process' memory.
+	MemoryInMB types.NullUint64 `json:"memory_in_mb"`
+	// Origin is either "user" for sidecars created by the user or "buildpack"
+	// for sidecars added during staging.
+	Origin string `json:"origin"`
+}
+
+// MarshalJSON converts a Sidecar into a Cloud Controller Sidecar, omitting
+// the fields that are not set.
+func (s Sidecar) MarshalJSON() ([]byte, error) {
+	var ccSidecar struct {
+		Name         string   `json:"name,omitempty"`
+		Command      string   `json:"command,omitempty"`
+		ProcessTypes []string `json:"process_types,omitempty"`
+		MemoryInMB   *uint64  `json:"memory_in_mb,omitempty"`
+	}
+
+	ccSidecar.Name = s.Name
+	ccSidecar.Command = s.Command.Value
+	ccSidecar.ProcessTypes = s.ProcessTypes
+	if s.MemoryInMB.IsSet {
+		ccSidecar.MemoryInMB = &s.MemoryInMB.Value
+	}
+
+	return json.Marshal(ccSidecar)
 }
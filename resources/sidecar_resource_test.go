@@ -0,0 +1,62 @@
+package resources_test
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sidecar", func() {
+	Describe("MarshalJSON", func() {
+		It("only includes the fields that are set", func() {
+			sidecar := resources.Sidecar{
+				GUID:    "some-sidecar-guid",
+				Name:    "some-sidecar",
+				Command: types.FilteredString{IsSet: true, Value: "start-sidecar"},
+				Origin:  "user",
+			}
+
+			marshalled, err := json.Marshal(sidecar)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(marshalled).To(MatchJSON(`{"name": "some-sidecar", "command": "start-sidecar"}`))
+		})
+
+		It("includes the process types and memory when set", func() {
+			sidecar := resources.Sidecar{
+				ProcessTypes: []string{"web", "worker"},
+				MemoryInMB:   types.NullUint64{IsSet: true, Value: 256},
+			}
+
+			marshalled, err := json.Marshal(sidecar)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(marshalled).To(MatchJSON(`{"process_types": ["web", "worker"], "memory_in_mb": 256}`))
+		})
+	})
+
+	Describe("UnmarshalJSON", func() {
+		It("reads all fields", func() {
+			var sidecar resources.Sidecar
+			err := json.Unmarshal([]byte(`{
+				"guid": "some-sidecar-guid",
+				"name": "some-sidecar",
+				"command": "start-sidecar",
+				"process_types": ["web"],
+				"memory_in_mb": 128,
+				"origin": "buildpack"
+			}`), &sidecar)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sidecar).To(Equal(resources.Sidecar{
+				GUID:         "some-sidecar-guid",
+				Name:         "some-sidecar",
+				Command:      types.FilteredString{IsSet: true, Value: "start-sidecar"},
+				ProcessTypes: []string{"web"},
+				MemoryInMB:   types.NullUint64{IsSet: true, Value: 128},
+				Origin:       "buildpack",
+			}))
+		})
+	})
+})
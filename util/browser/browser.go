@@ -0,0 +1,22 @@
+// Package browser opens URLs in the default web browser of the user.
+package browser
+
+import (
+	"os/exec"
+	"runtime"
+)
+
+// Open starts the default web browser on url. It does not wait for the
+// browser to exit.
+func Open(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
@@ -56,6 +56,7 @@ func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) err
 	cfConfig := p.Config
 	cfConfig.Flags = configv3.FlagOverride{
 		Verbose: common.Commands.VerboseOrVersion,
+		Output:  common.Commands.Output.Format,
 	}
 	defer p.UI.FlushDeferred()
 
@@ -64,6 +65,23 @@ func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) err
 		return p.handleError(err)
 	}
 
+	if cfConfig.OutputFormat() != configv3.OutputFormatDefault && !supportsOutputFlag(cmd) {
+		return p.handleError(translatableerror.OutputFlagNotSupportedError{})
+	}
+
+	if credentialErr := cfConfig.CredentialError(); credentialErr != nil {
+		p.UI.DisplayWarning("{{.Error}}\nYou are logged out until the credentials can be read.", map[string]interface{}{
+			"Error": credentialErr.Error(),
+		})
+	}
+
+	if common.Commands.ProfileName != "" {
+		err = cfConfig.SelectProfile(common.Commands.ProfileName)
+		if err != nil {
+			return p.handleError(err)
+		}
+	}
+
 	err = cfConfig.CreatePluginHome()
 	if err != nil {
 		return p.handleError(err)
@@ -239,6 +257,17 @@ func (p *CommandParser) parse(args []string, commandList interface{}) (int, erro
 	return 1, nil
 }
 
+// supportsOutputFlag reports whether the command can honour the global
+// --output flag. Help is always allowed since --help on a command that
+// supports --output is displayed by the help command.
+func supportsOutputFlag(cmd flags.Commander) bool {
+	switch cmd.(type) {
+	case command.StructuredOutputCommander, *common.HelpCommand:
+		return true
+	}
+	return false
+}
+
 func containsHelpFlag(args []string) bool {
 	for _, arg := range args {
 		if arg == "-h" || arg == "--help" || arg == "--h" {
@@ -2,13 +2,16 @@ package command_parser_test
 
 import (
 	"io"
+	"os"
 
 	"code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/util/command_parser"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
 )
 
 var _ = Describe("Command 'Parser'", func() {
@@ -78,4 +81,103 @@ var _ = Describe("Command 'Parser'", func() {
 		})
 
 	})
+
+	Describe("the output flag", func() {
+		var (
+			parser command_parser.CommandParser
+			errOut *Buffer
+		)
+
+		BeforeEach(func() {
+			var err error
+			errOut = NewBuffer()
+			pluginUI, err = ui.NewPluginUI(v3Config, io.Discard, errOut)
+			Expect(err).ToNot(HaveOccurred())
+
+			parser, err = command_parser.NewCommandParser(v3Config)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			// The command-table is a singleton, so the flag must not leak
+			// into other tests.
+			common.Commands.Output = flag.OutputFormat{}
+		})
+
+		It("rejects the flag for commands without structured output", func() {
+			exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"--output", "json", "target"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exitCode).To(Equal(1))
+			Expect(errOut).To(Say("Flag '--output' is not supported by this command."))
+		})
+
+		It("still displays help", func() {
+			exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"--output", "json", "help", "apps"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exitCode).To(Equal(0))
+			Expect(errOut).ToNot(Say("Flag '--output' is not supported by this command."))
+		})
+
+		It("accepts the flag for commands with structured output", func() {
+			_, err := parser.ParseCommandFromArgs(pluginUI, []string{"--output", "json", "orgs"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errOut).ToNot(Say("Flag '--output' is not supported by this command."))
+			Expect(parser.Config.Flags).To(Equal(configv3.FlagOverride{Output: "json"}))
+		})
+	})
+
+	Describe("a credential store that cannot be read", func() {
+		var (
+			homeDir string
+			out     *Buffer
+			errOut  *Buffer
+		)
+
+		BeforeEach(func() {
+			var err error
+			homeDir, err = os.MkdirTemp("", "cli-command-parser")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.Setenv("CF_HOME", homeDir)).To(Succeed())
+
+			Expect(os.Setenv("CF_CREDENTIALS_PASSPHRASE", "some-passphrase")).To(Succeed())
+			v3Config, err = configv3.LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(v3Config.SetCredentialStore(configv3.CredentialStoreFile)).To(Succeed())
+			v3Config.SetAccessToken("bearer some-token")
+			Expect(v3Config.WriteConfig()).To(Succeed())
+			Expect(os.Unsetenv("CF_CREDENTIALS_PASSPHRASE")).To(Succeed())
+
+			v3Config, err = configv3.LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+
+			out = NewBuffer()
+			errOut = NewBuffer()
+			pluginUI, err = ui.NewPluginUI(v3Config, out, errOut)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_HOME")).To(Succeed())
+			Expect(os.RemoveAll(homeDir)).To(Succeed())
+		})
+
+		It("warns and still runs config --credential-store none", func() {
+			parser, err := command_parser.NewCommandParser(v3Config)
+			Expect(err).ToNot(HaveOccurred())
+
+			exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"config", "--credential-store", "none"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exitCode).To(Equal(0))
+
+			Expect(errOut).To(Say("Unable to read cf-cli://default/AccessToken from the credential store: CF_CREDENTIALS_PASSPHRASE must be set to use the file credential store"))
+			Expect(errOut).To(Say("You are logged out until the credentials can be read."))
+			Expect(out).To(Say("OK"))
+
+			config, err := configv3.LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.CredentialStore()).To(Equal(configv3.CredentialStoreNone))
+			Expect(config.CredentialError()).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(BeEmpty())
+		})
+	})
 })
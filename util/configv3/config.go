@@ -32,6 +32,22 @@ type Config struct {
 
 	pluginsConfig PluginsConfig
 
+	// profileName is the profile selected by the global --profile flag. It
+	// is empty when the command runs against the active profile.
+	profileName string
+
+	// credentialStore keeps the tokens when config.json only holds their
+	// keys. It is nil when the tokens are kept in config.json.
+	credentialStore CredentialStore
+
+	// credentialCache records the credentials known to be in
+	// credentialStore.
+	credentialCache CredentialCache
+
+	// credentialError is the error met reading the credentials from
+	// credentialStore when the config was loaded.
+	credentialError error
+
 	UserConfig
 }
 
@@ -0,0 +1,331 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package configv3fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+type FakeCredentialStore struct {
+	CheckStub        func() error
+	checkMutex       sync.RWMutex
+	checkArgsForCall []struct {
+	}
+	checkReturns struct {
+		result1 error
+	}
+	checkReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EraseStub        func(string) error
+	eraseMutex       sync.RWMutex
+	eraseArgsForCall []struct {
+		arg1 string
+	}
+	eraseReturns struct {
+		result1 error
+	}
+	eraseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetStub        func(string) (string, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+	}
+	getReturns struct {
+		result1 string
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	StoreStub        func(string, string) error
+	storeMutex       sync.RWMutex
+	storeArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	storeReturns struct {
+		result1 error
+	}
+	storeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCredentialStore) Check() error {
+	fake.checkMutex.Lock()
+	ret, specificReturn := fake.checkReturnsOnCall[len(fake.checkArgsForCall)]
+	fake.checkArgsForCall = append(fake.checkArgsForCall, struct {
+	}{})
+	stub := fake.CheckStub
+	fakeReturns := fake.checkReturns
+	fake.recordInvocation("Check", []interface{}{})
+	fake.checkMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCredentialStore) CheckCallCount() int {
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	return len(fake.checkArgsForCall)
+}
+
+func (fake *FakeCredentialStore) CheckCalls(stub func() error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = stub
+}
+
+func (fake *FakeCredentialStore) CheckReturns(result1 error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = nil
+	fake.checkReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCredentialStore) CheckReturnsOnCall(i int, result1 error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = nil
+	if fake.checkReturnsOnCall == nil {
+		fake.checkReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.checkReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCredentialStore) Erase(arg1 string) error {
+	fake.eraseMutex.Lock()
+	ret, specificReturn := fake.eraseReturnsOnCall[len(fake.eraseArgsForCall)]
+	fake.eraseArgsForCall = append(fake.eraseArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.EraseStub
+	fakeReturns := fake.eraseReturns
+	fake.recordInvocation("Erase", []interface{}{arg1})
+	fake.eraseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCredentialStore) EraseCallCount() int {
+	fake.eraseMutex.RLock()
+	defer fake.eraseMutex.RUnlock()
+	return len(fake.eraseArgsForCall)
+}
+
+func (fake *FakeCredentialStore) EraseCalls(stub func(string) error) {
+	fake.eraseMutex.Lock()
+	defer fake.eraseMutex.Unlock()
+	fake.EraseStub = stub
+}
+
+func (fake *FakeCredentialStore) EraseArgsForCall(i int) string {
+	fake.eraseMutex.RLock()
+	defer fake.eraseMutex.RUnlock()
+	argsForCall := fake.eraseArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCredentialStore) EraseReturns(result1 error) {
+	fake.eraseMutex.Lock()
+	defer fake.eraseMutex.Unlock()
+	fake.EraseStub = nil
+	fake.eraseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCredentialStore) EraseReturnsOnCall(i int, result1 error) {
+	fake.eraseMutex.Lock()
+	defer fake.eraseMutex.Unlock()
+	fake.EraseStub = nil
+	if fake.eraseReturnsOnCall == nil {
+		fake.eraseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.eraseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCredentialStore) Get(arg1 string) (string, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCredentialStore) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeCredentialStore) GetCalls(stub func(string) (string, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeCredentialStore) GetArgsForCall(i int) string {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCredentialStore) GetReturns(result1 string, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCredentialStore) GetReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCredentialStore) Store(arg1 string, arg2 string) error {
+	fake.storeMutex.Lock()
+	ret, specificReturn := fake.storeReturnsOnCall[len(fake.storeArgsForCall)]
+	fake.storeArgsForCall = append(fake.storeArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.StoreStub
+	fakeReturns := fake.storeReturns
+	fake.recordInvocation("Store", []interface{}{arg1, arg2})
+	fake.storeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCredentialStore) StoreCallCount() int {
+	fake.storeMutex.RLock()
+	defer fake.storeMutex.RUnlock()
+	return len(fake.storeArgsForCall)
+}
+
+func (fake *FakeCredentialStore) StoreCalls(stub func(string, string) error) {
+	fake.storeMutex.Lock()
+	defer fake.storeMutex.Unlock()
+	fake.StoreStub = stub
+}
+
+func (fake *FakeCredentialStore) StoreArgsForCall(i int) (string, string) {
+	fake.storeMutex.RLock()
+	defer fake.storeMutex.RUnlock()
+	argsForCall := fake.storeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCredentialStore) StoreReturns(result1 error) {
+	fake.storeMutex.Lock()
+	defer fake.storeMutex.Unlock()
+	fake.StoreStub = nil
+	fake.storeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCredentialStore) StoreReturnsOnCall(i int, result1 error) {
+	fake.storeMutex.Lock()
+	defer fake.storeMutex.Unlock()
+	fake.StoreStub = nil
+	if fake.storeReturnsOnCall == nil {
+		fake.storeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.storeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCredentialStore) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	fake.eraseMutex.RLock()
+	defer fake.eraseMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.storeMutex.RLock()
+	defer fake.storeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCredentialStore) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ configv3.CredentialStore = new(FakeCredentialStore)
@@ -0,0 +1,304 @@
+package configv3
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	// CredentialStoreNone keeps the credentials in config.json.
+	CredentialStoreNone = "none"
+
+	// CredentialStoreFile keeps the credentials in a local file encrypted
+	// with the passphrase in $CF_CREDENTIALS_PASSPHRASE.
+	CredentialStoreFile = "file"
+
+	// credentialHelperPrefix is prepended to the name of any other credential
+	// store to get the credential helper program to run.
+	credentialHelperPrefix = "cf-credential-"
+
+	// credentialKeyScheme starts the keys of the credentials. Because tokens
+	// never start with it, config.json stores the key in place of the
+	// credential.
+	credentialKeyScheme = "cf-cli://"
+)
+
+// ErrCredentialNotFound is returned by a CredentialStore that has no
+// credential for the key.
+var ErrCredentialNotFound = errors.New("credential not found")
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . CredentialStore
+
+// CredentialStore keeps the tokens and client secrets of the profiles outside
+// of config.json.
+type CredentialStore interface {
+	Get(key string) (string, error)
+	Store(key string, secret string) error
+	Erase(key string) error
+
+	// Check returns an error when the store cannot be used.
+	Check() error
+}
+
+// NewCredentialStore returns the credential store with the given name: the
+// encrypted file store for CredentialStoreFile, or otherwise the
+// cf-credential-<name> helper program found on the PATH.
+func NewCredentialStore(name string, passphrase string) CredentialStore {
+	if name == CredentialStoreFile {
+		return NewFileCredentialStore(filepath.Join(configDirectory(), "credentials.json"), passphrase)
+	}
+	return NewHelperCredentialStore(credentialHelperPrefix + name)
+}
+
+// CredentialFields points to the fields of a profile that are kept in the
+// credential store.
+type CredentialFields struct {
+	AccessToken          *string
+	RefreshToken         *string
+	UAAOAuthClientSecret *string
+}
+
+func (fields CredentialFields) byName() map[string]*string {
+	return map[string]*string{
+		"AccessToken":          fields.AccessToken,
+		"RefreshToken":         fields.RefreshToken,
+		"UAAOAuthClientSecret": fields.UAAOAuthClientSecret,
+	}
+}
+
+// CredentialCache records what is known about the credentials in a
+// credential store, by key.
+type CredentialCache struct {
+	// Secrets are the credentials read from or written to the store.
+	Secrets map[string]string
+
+	// Unreadable are the keys of the credentials that could not be read from
+	// the store.
+	Unreadable map[string]bool
+}
+
+// NewCredentialCache returns an empty CredentialCache.
+func NewCredentialCache() CredentialCache {
+	return CredentialCache{
+		Secrets:    map[string]string{},
+		Unreadable: map[string]bool{},
+	}
+}
+
+// Resolve replaces the keys found in the fields with the credentials they
+// refer to. Fields holding a credential in clear text, as written before a
+// credential store was configured, are left as they are. Every credential
+// read is recorded in cache. Credentials that cannot be read are left empty
+// and the first error is returned once all fields have been resolved.
+func (fields CredentialFields) Resolve(store CredentialStore, cache CredentialCache) error {
+	var resolveErr error
+	byName := fields.byName()
+	for _, name := range []string{"AccessToken", "RefreshToken", "UAAOAuthClientSecret"} {
+		field := byName[name]
+		key := *field
+		if !strings.HasPrefix(key, credentialKeyScheme) {
+			continue
+		}
+
+		*field = ""
+		secret, err := store.Get(key)
+		if err != nil && err != ErrCredentialNotFound {
+			cache.Unreadable[key] = true
+			if resolveErr == nil {
+				resolveErr = fmt.Errorf("Unable to read %s from the credential store: %s", key, err)
+			}
+			continue
+		}
+		*field = secret
+		cache.Secrets[key] = secret
+	}
+	return resolveErr
+}
+
+// Save moves the credentials of the profile into the store and replaces them
+// with their keys. Credentials that have not changed since they were recorded
+// in cache are not written again, and emptied credentials are erased. Empty
+// credentials that could not be read keep their key, so that they are read
+// again once the store is available.
+func (fields CredentialFields) Save(store CredentialStore, profile string, cache CredentialCache) error {
+	for name, field := range fields.byName() {
+		key := credentialKeyScheme + profile + "/" + name
+		secret := *field
+
+		if secret == "" {
+			if cache.Unreadable[key] {
+				*field = key
+				continue
+			}
+			if _, ok := cache.Secrets[key]; ok {
+				err := store.Erase(key)
+				if err != nil && err != ErrCredentialNotFound {
+					return fmt.Errorf("Unable to erase %s from the credential store: %s", key, err)
+				}
+				delete(cache.Secrets, key)
+			}
+			continue
+		}
+
+		if previous, ok := cache.Secrets[key]; !ok || previous != secret {
+			err := store.Store(key, secret)
+			if err != nil {
+				return fmt.Errorf("Unable to save %s to the credential store: %s", key, err)
+			}
+			cache.Secrets[key] = secret
+			delete(cache.Unreadable, key)
+		}
+		*field = key
+	}
+	return nil
+}
+
+func (c *JSONConfig) credentialFields() CredentialFields {
+	return CredentialFields{
+		AccessToken:          &c.AccessToken,
+		RefreshToken:         &c.RefreshToken,
+		UAAOAuthClientSecret: &c.UAAOAuthClientSecret,
+	}
+}
+
+func (p *Profile) credentialFields() CredentialFields {
+	return CredentialFields{
+		AccessToken:          &p.AccessToken,
+		RefreshToken:         &p.RefreshToken,
+		UAAOAuthClientSecret: &p.UAAOAuthClientSecret,
+	}
+}
+
+// CredentialStore returns the name of the configured credential store, or
+// CredentialStoreNone when the credentials are kept in config.json.
+func (config *Config) CredentialStore() string {
+	if config.ConfigFile.CredentialStore == "" {
+		return CredentialStoreNone
+	}
+	return config.ConfigFile.CredentialStore
+}
+
+// CheckCredentialStore returns an error when the credential store with the
+// given name cannot be used: the helper is missing or fails, or the passphrase
+// of the file store is not set or does not match the existing file.
+func (config *Config) CheckCredentialStore(name string) error {
+	if name == CredentialStoreNone || name == "" {
+		return nil
+	}
+
+	err := NewCredentialStore(name, config.ENV.CFCredentialsPassphrase).Check()
+	if err != nil {
+		return fmt.Errorf("Unable to use the %s credential store: %s", name, err)
+	}
+	return nil
+}
+
+// SetCredentialStore changes where the credentials are kept. They are moved
+// to the new store the next time the config is written, and erased from the
+// previous store right away. The returned error is the first credential that
+// could not be erased; the store is changed regardless.
+func (config *Config) SetCredentialStore(name string) error {
+	if name == "" {
+		name = CredentialStoreNone
+	}
+	if name == config.CredentialStore() {
+		return nil
+	}
+
+	previousStore := config.credentialStore
+	previousCache := config.credentialCache
+
+	config.credentialCache = NewCredentialCache()
+	config.credentialError = nil
+	if name == CredentialStoreNone {
+		config.ConfigFile.CredentialStore = ""
+		config.credentialStore = nil
+	} else {
+		config.ConfigFile.CredentialStore = name
+		config.credentialStore = NewCredentialStore(name, config.ENV.CFCredentialsPassphrase)
+	}
+
+	if previousStore == nil {
+		return nil
+	}
+	return previousCache.erase(previousStore)
+}
+
+// erase removes every credential recorded in the cache from the store.
+func (cache CredentialCache) erase(store CredentialStore) error {
+	keys := make([]string, 0, len(cache.Secrets)+len(cache.Unreadable))
+	for key := range cache.Secrets {
+		keys = append(keys, key)
+	}
+	for key := range cache.Unreadable {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var eraseErr error
+	for _, key := range keys {
+		err := store.Erase(key)
+		if err != nil && err != ErrCredentialNotFound && eraseErr == nil {
+			eraseErr = fmt.Errorf("Unable to erase %s from the credential store: %s", key, err)
+		}
+	}
+	return eraseErr
+}
+
+// CredentialError returns the error met reading the credentials from the
+// credential store when the config was loaded. The credentials that could not
+// be read are empty, so commands run as if the user was logged out.
+func (config *Config) CredentialError() error {
+	return config.credentialError
+}
+
+// loadCredentials replaces the keys in the config file with the credentials
+// read from the configured credential store. Failing to read them is not an
+// error; it is recorded for CredentialError instead.
+func (config *Config) loadCredentials() {
+	if config.ConfigFile.CredentialStore == "" {
+		return
+	}
+
+	config.credentialStore = NewCredentialStore(config.ConfigFile.CredentialStore, config.ENV.CFCredentialsPassphrase)
+	config.credentialCache = NewCredentialCache()
+
+	config.credentialError = config.ConfigFile.credentialFields().Resolve(config.credentialStore, config.credentialCache)
+
+	for name, profile := range config.ConfigFile.Profiles {
+		err := profile.credentialFields().Resolve(config.credentialStore, config.credentialCache)
+		if err != nil && config.credentialError == nil {
+			config.credentialError = err
+		}
+		config.ConfigFile.Profiles[name] = profile
+	}
+}
+
+// saveCredentials moves the credentials in file into the configured
+// credential store, leaving only their keys.
+func (config *Config) saveCredentials(file *JSONConfig) error {
+	if config.credentialStore == nil {
+		return nil
+	}
+
+	err := file.credentialFields().Save(config.credentialStore, file.activeProfileName(), config.credentialCache)
+	if err != nil {
+		return err
+	}
+
+	profiles := make(map[string]Profile, len(file.Profiles))
+	for name, profile := range file.Profiles {
+		err = profile.credentialFields().Save(config.credentialStore, name, config.credentialCache)
+		if err != nil {
+			return err
+		}
+		profiles[name] = profile
+	}
+	if len(profiles) > 0 {
+		file.Profiles = profiles
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package configv3
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	credentialFileSaltLength = 16
+	credentialFileKeyLength  = 32
+)
+
+// FileCredentialStore keeps the credentials in a local file. Every
+// credential is encrypted with AES-GCM using a key derived from the
+// passphrase with scrypt.
+type FileCredentialStore struct {
+	Path       string
+	Passphrase string
+
+	keys *credentialKeys
+}
+
+// credentialKeys are the keys derived from the passphrase, by salt. scrypt is
+// deliberately slow, so every key is only derived once.
+type credentialKeys struct {
+	mutex  sync.Mutex
+	bySalt map[string][]byte
+}
+
+// credentialFile is the content of the credential file.
+type credentialFile struct {
+	Salt    []byte            `json:"Salt"`
+	Secrets map[string][]byte `json:"Secrets"`
+}
+
+// NewFileCredentialStore returns a credential store that keeps the
+// credentials in the file at path, encrypted with the passphrase.
+func NewFileCredentialStore(path string, passphrase string) FileCredentialStore {
+	return FileCredentialStore{
+		Path:       path,
+		Passphrase: passphrase,
+		keys:       &credentialKeys{bySalt: map[string][]byte{}},
+	}
+}
+
+func (store FileCredentialStore) Get(key string) (string, error) {
+	file, err := store.read()
+	if err != nil {
+		return "", err
+	}
+
+	sealed, ok := file.Secrets[key]
+	if !ok {
+		return "", ErrCredentialNotFound
+	}
+
+	aead, err := store.cipher(file.Salt)
+	if err != nil {
+		return "", err
+	}
+	return open(aead, key, sealed)
+}
+
+func (store FileCredentialStore) Store(key string, secret string) error {
+	file, err := store.read()
+	if err != nil {
+		return err
+	}
+
+	aead, err := store.cipher(file.Salt)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return err
+	}
+
+	file.Secrets[key] = aead.Seal(nonce, nonce, []byte(secret), []byte(key))
+	return store.write(file)
+}
+
+// Check returns an error when the passphrase is not set or does not decrypt
+// the credentials already in the file.
+func (store FileCredentialStore) Check() error {
+	file, err := store.read()
+	if err != nil {
+		return err
+	}
+
+	aead, err := store.cipher(file.Salt)
+	if err != nil {
+		return err
+	}
+
+	for key, sealed := range file.Secrets {
+		_, err = open(aead, key, sealed)
+		return err
+	}
+	return nil
+}
+
+func (store FileCredentialStore) Erase(key string) error {
+	file, err := store.read()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := file.Secrets[key]; !ok {
+		return ErrCredentialNotFound
+	}
+
+	delete(file.Secrets, key)
+	return store.write(file)
+}
+
+// read returns the content of the credential file, or a new file with a
+// random salt if it does not exist yet.
+func (store FileCredentialStore) read() (credentialFile, error) {
+	file := credentialFile{Secrets: map[string][]byte{}}
+
+	raw, err := os.ReadFile(store.Path)
+	if os.IsNotExist(err) {
+		file.Salt = make([]byte, credentialFileSaltLength)
+		_, err = rand.Read(file.Salt)
+		return file, err
+	}
+	if err != nil {
+		return file, err
+	}
+
+	err = json.Unmarshal(raw, &file)
+	if file.Secrets == nil {
+		file.Secrets = map[string][]byte{}
+	}
+	return file, err
+}
+
+// write replaces the credential file with a temporary file renamed over it,
+// the same way WriteConfig writes config.json, so that an interrupted write
+// cannot lose the credentials.
+func (store FileCredentialStore) write(file credentialFile) error {
+	raw, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(store.Path)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := os.CreateTemp(dir, "temp-credentials")
+	if err != nil {
+		return err
+	}
+	tempFileName := tempFile.Name()
+
+	_, err = tempFile.Write(raw)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tempFileName)
+		return err
+	}
+
+	for i := 0; i < 5; i++ {
+		if err = os.Rename(tempFileName, store.Path); err == nil {
+			return nil
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	_ = os.Remove(tempFileName)
+	return err
+}
+
+func (store FileCredentialStore) cipher(salt []byte) (cipher.AEAD, error) {
+	if store.Passphrase == "" {
+		return nil, errors.New("CF_CREDENTIALS_PASSPHRASE must be set to use the file credential store")
+	}
+
+	key, err := store.key(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (store FileCredentialStore) key(salt []byte) ([]byte, error) {
+	if store.keys == nil {
+		return scrypt.Key([]byte(store.Passphrase), salt, 1<<15, 8, 1, credentialFileKeyLength)
+	}
+
+	store.keys.mutex.Lock()
+	defer store.keys.mutex.Unlock()
+
+	if key, ok := store.keys.bySalt[string(salt)]; ok {
+		return key, nil
+	}
+
+	key, err := scrypt.Key([]byte(store.Passphrase), salt, 1<<15, 8, 1, credentialFileKeyLength)
+	if err != nil {
+		return nil, err
+	}
+	store.keys.bySalt[string(salt)] = key
+	return key, nil
+}
+
+// open decrypts the credential sealed for key.
+func open(aead cipher.AEAD, key string, sealed []byte) (string, error) {
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("the credential file is corrupt")
+	}
+	secret, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(key))
+	if err != nil {
+		return "", errors.New("unable to decrypt the credential file, check CF_CREDENTIALS_PASSPHRASE")
+	}
+	return string(secret), nil
+}
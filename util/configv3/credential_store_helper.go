@@ -0,0 +1,94 @@
+package configv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os/exec"
+	"strings"
+)
+
+// credentialHelperUsername is the username saved with every credential; the
+// helper protocol requires one.
+const credentialHelperUsername = "cf"
+
+// HelperCredentialStore runs a credential helper program that implements
+// the docker credential helper protocol: the "get", "store" and "erase"
+// actions read their input from stdin and report errors on stdout.
+type HelperCredentialStore struct {
+	Program string
+}
+
+type credentialHelperCredential struct {
+	ServerURL string `json:"ServerURL,omitempty"`
+	Username  string `json:"Username"`
+	Secret    string `json:"Secret"`
+}
+
+// NewHelperCredentialStore returns a credential store that runs the given
+// credential helper program.
+func NewHelperCredentialStore(program string) HelperCredentialStore {
+	return HelperCredentialStore{Program: program}
+}
+
+func (store HelperCredentialStore) Get(key string) (string, error) {
+	output, err := store.run("get", strings.NewReader(key))
+	if err != nil {
+		return "", err
+	}
+
+	var credential credentialHelperCredential
+	err = json.Unmarshal(output, &credential)
+	if err != nil {
+		return "", err
+	}
+	return credential.Secret, nil
+}
+
+func (store HelperCredentialStore) Store(key string, secret string) error {
+	input, err := json.Marshal(credentialHelperCredential{
+		ServerURL: key,
+		Username:  credentialHelperUsername,
+		Secret:    secret,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = store.run("store", bytes.NewReader(input))
+	return err
+}
+
+// Check gets a credential that is never stored, which fails unless the helper
+// is installed and working.
+func (store HelperCredentialStore) Check() error {
+	_, err := store.Get(credentialKeyScheme + "check")
+	if err == ErrCredentialNotFound {
+		return nil
+	}
+	return err
+}
+
+func (store HelperCredentialStore) Erase(key string) error {
+	_, err := store.run("erase", strings.NewReader(key))
+	return err
+}
+
+func (store HelperCredentialStore) run(action string, input io.Reader) ([]byte, error) {
+	cmd := exec.Command(store.Program, action)
+	cmd.Stdin = input
+
+	output, err := cmd.Output()
+	if err != nil {
+		message := strings.TrimSpace(string(output))
+		if strings.Contains(strings.ToLower(message), "credentials not found") {
+			return nil, ErrCredentialNotFound
+		}
+		if message == "" {
+			return nil, err
+		}
+		return nil, errors.New(message)
+	}
+	return output, nil
+}
@@ -0,0 +1,443 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/configv3/configv3fakes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("credential stores", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	Describe("CredentialFields", func() {
+		var (
+			fakeStore    *configv3fakes.FakeCredentialStore
+			accessToken  string
+			refreshToken string
+			clientSecret string
+			fields       configv3.CredentialFields
+			cache        configv3.CredentialCache
+		)
+
+		BeforeEach(func() {
+			fakeStore = new(configv3fakes.FakeCredentialStore)
+			accessToken = "cf-cli://prod/AccessToken"
+			refreshToken = "cf-cli://prod/RefreshToken"
+			clientSecret = ""
+			fields = configv3.CredentialFields{
+				AccessToken:          &accessToken,
+				RefreshToken:         &refreshToken,
+				UAAOAuthClientSecret: &clientSecret,
+			}
+			cache = configv3.NewCredentialCache()
+		})
+
+		Describe("Resolve", func() {
+			BeforeEach(func() {
+				fakeStore.GetStub = func(key string) (string, error) {
+					if key == "cf-cli://prod/RefreshToken" {
+						return "", configv3.ErrCredentialNotFound
+					}
+					return "secret for " + key, nil
+				}
+			})
+
+			It("replaces the keys with the credentials from the store", func() {
+				Expect(fields.Resolve(fakeStore, cache)).To(Succeed())
+
+				Expect(accessToken).To(Equal("secret for cf-cli://prod/AccessToken"))
+				Expect(refreshToken).To(BeEmpty())
+				Expect(clientSecret).To(BeEmpty())
+				Expect(fakeStore.GetCallCount()).To(Equal(2))
+				Expect(cache.Secrets).To(Equal(map[string]string{
+					"cf-cli://prod/AccessToken":  "secret for cf-cli://prod/AccessToken",
+					"cf-cli://prod/RefreshToken": "",
+				}))
+			})
+
+			It("leaves credentials written in clear text", func() {
+				accessToken = "bearer some-token"
+				Expect(fields.Resolve(fakeStore, cache)).To(Succeed())
+				Expect(accessToken).To(Equal("bearer some-token"))
+			})
+
+			When("the store fails", func() {
+				BeforeEach(func() {
+					fakeStore.GetStub = nil
+					fakeStore.GetReturns("", errors.New("helper exploded"))
+				})
+
+				It("empties the credentials, records them as unreadable and returns the error", func() {
+					err := fields.Resolve(fakeStore, cache)
+					Expect(err).To(MatchError("Unable to read cf-cli://prod/AccessToken from the credential store: helper exploded"))
+
+					Expect(accessToken).To(BeEmpty())
+					Expect(refreshToken).To(BeEmpty())
+					Expect(cache.Secrets).To(BeEmpty())
+					Expect(cache.Unreadable).To(Equal(map[string]bool{
+						"cf-cli://prod/AccessToken":  true,
+						"cf-cli://prod/RefreshToken": true,
+					}))
+				})
+			})
+		})
+
+		Describe("Save", func() {
+			BeforeEach(func() {
+				accessToken = "bearer new-token"
+				refreshToken = "same-refresh-token"
+				cache.Secrets["cf-cli://prod/RefreshToken"] = "same-refresh-token"
+				cache.Secrets["cf-cli://prod/UAAOAuthClientSecret"] = "old-secret"
+			})
+
+			It("stores changed credentials, erases emptied ones and leaves the keys", func() {
+				Expect(fields.Save(fakeStore, "prod", cache)).To(Succeed())
+
+				Expect(fakeStore.StoreCallCount()).To(Equal(1))
+				key, secret := fakeStore.StoreArgsForCall(0)
+				Expect(key).To(Equal("cf-cli://prod/AccessToken"))
+				Expect(secret).To(Equal("bearer new-token"))
+
+				Expect(fakeStore.EraseCallCount()).To(Equal(1))
+				Expect(fakeStore.EraseArgsForCall(0)).To(Equal("cf-cli://prod/UAAOAuthClientSecret"))
+
+				Expect(accessToken).To(Equal("cf-cli://prod/AccessToken"))
+				Expect(refreshToken).To(Equal("cf-cli://prod/RefreshToken"))
+				Expect(clientSecret).To(BeEmpty())
+				Expect(cache.Secrets).To(Equal(map[string]string{
+					"cf-cli://prod/AccessToken":  "bearer new-token",
+					"cf-cli://prod/RefreshToken": "same-refresh-token",
+				}))
+			})
+
+			When("a credential could not be read", func() {
+				BeforeEach(func() {
+					cache.Unreadable["cf-cli://prod/UAAOAuthClientSecret"] = true
+					delete(cache.Secrets, "cf-cli://prod/UAAOAuthClientSecret")
+				})
+
+				It("keeps its key while it is empty", func() {
+					Expect(fields.Save(fakeStore, "prod", cache)).To(Succeed())
+
+					Expect(fakeStore.EraseCallCount()).To(Equal(0))
+					Expect(clientSecret).To(Equal("cf-cli://prod/UAAOAuthClientSecret"))
+				})
+
+				It("stores it once it is set again", func() {
+					clientSecret = "new-secret"
+					Expect(fields.Save(fakeStore, "prod", cache)).To(Succeed())
+
+					Expect(fakeStore.StoreCallCount()).To(Equal(2))
+					Expect(clientSecret).To(Equal("cf-cli://prod/UAAOAuthClientSecret"))
+					Expect(cache.Unreadable).To(BeEmpty())
+				})
+			})
+
+			When("the store fails", func() {
+				BeforeEach(func() {
+					fakeStore.StoreReturns(errors.New("helper exploded"))
+				})
+
+				It("returns the error", func() {
+					err := fields.Save(fakeStore, "prod", cache)
+					Expect(err).To(MatchError(ContainSubstring("helper exploded")))
+				})
+			})
+		})
+	})
+
+	Describe("FileCredentialStore", func() {
+		var (
+			path  string
+			store configv3.FileCredentialStore
+		)
+
+		BeforeEach(func() {
+			path = filepath.Join(homeDir, "credentials.json")
+			store = configv3.NewFileCredentialStore(path, "some-passphrase")
+		})
+
+		It("keeps the credentials encrypted", func() {
+			Expect(store.Store("cf-cli://default/AccessToken", "bearer some-token")).To(Succeed())
+
+			raw, err := os.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).ToNot(ContainSubstring("some-token"))
+
+			info, err := os.Stat(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+			secret, err := store.Get("cf-cli://default/AccessToken")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret).To(Equal("bearer some-token"))
+
+			Expect(store.Erase("cf-cli://default/AccessToken")).To(Succeed())
+			_, err = store.Get("cf-cli://default/AccessToken")
+			Expect(err).To(MatchError(configv3.ErrCredentialNotFound))
+		})
+
+		It("replaces the file without leaving temporary files behind", func() {
+			Expect(store.Store("cf-cli://default/AccessToken", "bearer some-token")).To(Succeed())
+			Expect(store.Store("cf-cli://default/RefreshToken", "some-refresh-token")).To(Succeed())
+
+			entries, err := os.ReadDir(homeDir)
+			Expect(err).ToNot(HaveOccurred())
+			var names []string
+			for _, entry := range entries {
+				names = append(names, entry.Name())
+			}
+			Expect(names).To(ContainElement("credentials.json"))
+			Expect(names).ToNot(ContainElement(HavePrefix("temp-credentials")))
+
+			secret, err := store.Get("cf-cli://default/AccessToken")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret).To(Equal("bearer some-token"))
+		})
+
+		It("derives a new key when the file is created with a new salt", func() {
+			Expect(store.Store("cf-cli://default/AccessToken", "bearer some-token")).To(Succeed())
+			Expect(os.Remove(path)).To(Succeed())
+			Expect(store.Store("cf-cli://default/AccessToken", "bearer another-token")).To(Succeed())
+
+			secret, err := configv3.NewFileCredentialStore(path, "some-passphrase").Get("cf-cli://default/AccessToken")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret).To(Equal("bearer another-token"))
+		})
+
+		When("the passphrase is wrong", func() {
+			It("returns an error", func() {
+				Expect(store.Store("cf-cli://default/AccessToken", "bearer some-token")).To(Succeed())
+
+				_, err := configv3.NewFileCredentialStore(path, "another-passphrase").Get("cf-cli://default/AccessToken")
+				Expect(err).To(MatchError(ContainSubstring("unable to decrypt")))
+			})
+		})
+
+		When("there is no passphrase", func() {
+			It("returns an error", func() {
+				err := configv3.NewFileCredentialStore(path, "").Store("cf-cli://default/AccessToken", "bearer some-token")
+				Expect(err).To(MatchError(ContainSubstring("CF_CREDENTIALS_PASSPHRASE must be set")))
+			})
+		})
+
+		Describe("Check", func() {
+			It("succeeds when there is no file yet", func() {
+				Expect(store.Check()).To(Succeed())
+			})
+
+			It("succeeds when the passphrase decrypts the file", func() {
+				Expect(store.Store("cf-cli://default/AccessToken", "bearer some-token")).To(Succeed())
+				Expect(store.Check()).To(Succeed())
+			})
+
+			It("fails when the passphrase does not decrypt the file", func() {
+				Expect(store.Store("cf-cli://default/AccessToken", "bearer some-token")).To(Succeed())
+				err := configv3.NewFileCredentialStore(path, "another-passphrase").Check()
+				Expect(err).To(MatchError(ContainSubstring("unable to decrypt")))
+			})
+
+			It("fails when there is no passphrase", func() {
+				err := configv3.NewFileCredentialStore(path, "").Check()
+				Expect(err).To(MatchError(ContainSubstring("CF_CREDENTIALS_PASSPHRASE must be set")))
+			})
+		})
+	})
+
+	Describe("CheckCredentialStore", func() {
+		var config *configv3.Config
+
+		BeforeEach(func() {
+			var err error
+			config, err = configv3.LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("accepts none", func() {
+			Expect(config.CheckCredentialStore(configv3.CredentialStoreNone)).To(Succeed())
+		})
+
+		It("rejects the file store without a passphrase", func() {
+			err := config.CheckCredentialStore(configv3.CredentialStoreFile)
+			Expect(err).To(MatchError("Unable to use the file credential store: CF_CREDENTIALS_PASSPHRASE must be set to use the file credential store"))
+		})
+
+		It("rejects a helper that is not installed", func() {
+			err := config.CheckCredentialStore("does-not-exist")
+			Expect(err).To(MatchError(ContainSubstring("Unable to use the does-not-exist credential store")))
+		})
+	})
+
+	Describe("loading and writing the config with a credential store", func() {
+		readConfigFile := func() configv3.JSONConfig {
+			file, err := os.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+			Expect(err).ToNot(HaveOccurred())
+
+			var written configv3.JSONConfig
+			Expect(json.Unmarshal(file, &written)).To(Succeed())
+			return written
+		}
+
+		BeforeEach(func() {
+			Expect(os.Setenv("CF_CREDENTIALS_PASSPHRASE", "some-passphrase")).To(Succeed())
+
+			setConfig(homeDir, `{
+				"ConfigVersion": 4,
+				"CredentialStore": "file",
+				"Target": "https://api.example.com",
+				"AccessToken": "bearer plain-access-token",
+				"RefreshToken": "plain-refresh-token",
+				"Profiles": {
+					"prod": {"Target": "https://api.prod.example.com", "AccessToken": "bearer prod-access-token"}
+				}
+			}`)
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_CREDENTIALS_PASSPHRASE")).To(Succeed())
+		})
+
+		It("moves the credentials written in clear text into the store", func() {
+			config, err := configv3.LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("bearer plain-access-token"))
+			Expect(config.CredentialStore()).To(Equal("file"))
+			Expect(config.WriteConfig()).To(Succeed())
+
+			written := readConfigFile()
+			Expect(written.AccessToken).To(Equal("cf-cli://default/AccessToken"))
+			Expect(written.RefreshToken).To(Equal("cf-cli://default/RefreshToken"))
+			Expect(written.Profiles["prod"].AccessToken).To(Equal("cf-cli://prod/AccessToken"))
+			Expect(config.AccessToken()).To(Equal("bearer plain-access-token"))
+
+			config, err = configv3.LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("bearer plain-access-token"))
+			Expect(config.RefreshToken()).To(Equal("plain-refresh-token"))
+			Expect(config.UseProfile("prod")).To(Succeed())
+			Expect(config.AccessToken()).To(Equal("bearer prod-access-token"))
+		})
+
+		It("writes the credentials to config.json again when the store is removed", func() {
+			config, err := configv3.LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.WriteConfig()).To(Succeed())
+
+			config, err = configv3.LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.SetCredentialStore(configv3.CredentialStoreNone)).To(Succeed())
+			Expect(config.CredentialStore()).To(Equal(configv3.CredentialStoreNone))
+			Expect(config.WriteConfig()).To(Succeed())
+
+			written := readConfigFile()
+			Expect(written.CredentialStore).To(BeEmpty())
+			Expect(written.AccessToken).To(Equal("bearer plain-access-token"))
+			Expect(written.Profiles["prod"].AccessToken).To(Equal("bearer prod-access-token"))
+		})
+
+		It("erases the credentials from the previous store", func() {
+			config, err := configv3.LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.WriteConfig()).To(Succeed())
+
+			config, err = configv3.LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.SetCredentialStore(configv3.CredentialStoreNone)).To(Succeed())
+
+			store := configv3.NewFileCredentialStore(filepath.Join(homeDir, ".cf", "credentials.json"), "some-passphrase")
+			for _, key := range []string{"cf-cli://default/AccessToken", "cf-cli://default/RefreshToken", "cf-cli://prod/AccessToken"} {
+				_, err = store.Get(key)
+				Expect(err).To(MatchError(configv3.ErrCredentialNotFound), key)
+			}
+		})
+
+		It("leaves the credentials alone when the store does not change", func() {
+			config, err := configv3.LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.WriteConfig()).To(Succeed())
+
+			config, err = configv3.LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.SetCredentialStore(configv3.CredentialStoreFile)).To(Succeed())
+			Expect(config.WriteConfig()).To(Succeed())
+
+			config, err = configv3.LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("bearer plain-access-token"))
+		})
+
+		When("the credentials cannot be read", func() {
+			BeforeEach(func() {
+				config, err := configv3.LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.WriteConfig()).To(Succeed())
+
+				Expect(os.Setenv("CF_CREDENTIALS_PASSPHRASE", "wrong-passphrase")).To(Succeed())
+			})
+
+			It("loads the config without the credentials and records the error", func() {
+				config, err := configv3.LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.CredentialError()).To(MatchError(ContainSubstring("unable to decrypt")))
+				Expect(config.AccessToken()).To(BeEmpty())
+				Expect(config.RefreshToken()).To(BeEmpty())
+				Expect(config.Target()).To(Equal("https://api.example.com"))
+			})
+
+			It("keeps the keys of the credentials when the config is written", func() {
+				config, err := configv3.LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.WriteConfig()).To(Succeed())
+
+				written := readConfigFile()
+				Expect(written.AccessToken).To(Equal("cf-cli://default/AccessToken"))
+				Expect(written.RefreshToken).To(Equal("cf-cli://default/RefreshToken"))
+
+				Expect(os.Setenv("CF_CREDENTIALS_PASSPHRASE", "some-passphrase")).To(Succeed())
+				config, err = configv3.LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.CredentialError()).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("bearer plain-access-token"))
+			})
+
+			When("the passphrase is not set", func() {
+				BeforeEach(func() {
+					Expect(os.Unsetenv("CF_CREDENTIALS_PASSPHRASE")).To(Succeed())
+				})
+
+				It("can switch to keeping the credentials in config.json", func() {
+					config, err := configv3.LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(config.CredentialError()).To(MatchError(ContainSubstring("CF_CREDENTIALS_PASSPHRASE must be set")))
+
+					Expect(config.SetCredentialStore(configv3.CredentialStoreNone)).To(Succeed())
+					Expect(config.WriteConfig()).To(Succeed())
+
+					written := readConfigFile()
+					Expect(written.CredentialStore).To(BeEmpty())
+					Expect(written.AccessToken).To(BeEmpty())
+					Expect(written.Target).To(Equal("https://api.example.com"))
+
+					config, err = configv3.LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(config.CredentialError()).ToNot(HaveOccurred())
+				})
+			})
+		})
+	})
+})
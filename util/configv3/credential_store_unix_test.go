@@ -0,0 +1,93 @@
+//go:build !windows
+// +build !windows
+
+package configv3_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// credentialHelperScript is a credential helper keeping one file per
+// credential in the directory it is installed in.
+const credentialHelperScript = `#!/bin/sh
+dir=$(dirname "$0")
+case "$1" in
+get)
+	key=$(cat | tr '/:' '__')
+	if [ ! -f "$dir/$key" ]; then
+		echo "credentials not found in native keychain"
+		exit 1
+	fi
+	printf '{"ServerURL":"","Username":"cf","Secret":"%s"}' "$(cat "$dir/$key")"
+	;;
+store)
+	input=$(cat)
+	key=$(echo "$input" | sed 's/.*"ServerURL":"\([^"]*\)".*/\1/' | tr '/:' '__')
+	echo "$input" | sed 's/.*"Secret":"\([^"]*\)".*/\1/' > "$dir/$key"
+	;;
+erase)
+	rm -f "$dir/$(cat | tr '/:' '__')"
+	;;
+*)
+	echo "unknown action"
+	exit 1
+	;;
+esac
+`
+
+var _ = Describe("HelperCredentialStore", func() {
+	var (
+		helperDir string
+		store     configv3.HelperCredentialStore
+	)
+
+	BeforeEach(func() {
+		var err error
+		helperDir, err = os.MkdirTemp("", "cli-credential-helper")
+		Expect(err).ToNot(HaveOccurred())
+
+		program := filepath.Join(helperDir, "cf-credential-test")
+		Expect(os.WriteFile(program, []byte(credentialHelperScript), 0700)).To(Succeed())
+		store = configv3.NewHelperCredentialStore(program)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(helperDir)).To(Succeed())
+	})
+
+	It("stores, gets and erases credentials with the helper", func() {
+		Expect(store.Store("cf-cli://default/AccessToken", "bearer some-token")).To(Succeed())
+
+		secret, err := store.Get("cf-cli://default/AccessToken")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret).To(Equal("bearer some-token"))
+
+		Expect(store.Erase("cf-cli://default/AccessToken")).To(Succeed())
+		_, err = store.Get("cf-cli://default/AccessToken")
+		Expect(err).To(MatchError(configv3.ErrCredentialNotFound))
+	})
+
+	It("checks that the helper works", func() {
+		Expect(store.Check()).To(Succeed())
+	})
+
+	When("the helper fails", func() {
+		BeforeEach(func() {
+			program := filepath.Join(helperDir, "cf-credential-locked")
+			Expect(os.WriteFile(program, []byte("#!/bin/sh\necho 'the keychain is locked'\nexit 1\n"), 0700)).To(Succeed())
+			store = configv3.NewHelperCredentialStore(program)
+		})
+
+		It("returns the output of the helper", func() {
+			_, err := store.Get("cf-cli://default/AccessToken")
+			Expect(err).To(MatchError("the keychain is locked"))
+			Expect(store.Check()).To(MatchError("the keychain is locked"))
+		})
+	})
+})
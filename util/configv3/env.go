@@ -11,25 +11,27 @@ import (
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFDialTimeout    string
-	CFHome           string
-	CFLogLevel       string
-	CFPassword       string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	CFUsername       string
-	CFB3TraceID      string
-	DockerPassword   string
-	CNBCredentials   string
-	Experimental     string
-	ForceTTY         string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
+	BinaryName              string
+	CFAssertionFile         string
+	CFColor                 string
+	CFCredentialsPassphrase string
+	CFDialTimeout           string
+	CFHome                  string
+	CFLogLevel              string
+	CFPassword              string
+	CFPluginHome            string
+	CFStagingTimeout        string
+	CFStartupTimeout        string
+	CFTrace                 string
+	CFUsername              string
+	CFB3TraceID             string
+	DockerPassword          string
+	CNBCredentials          string
+	Experimental            string
+	ForceTTY                string
+	HTTPSProxy              string
+	Lang                    string
+	LCAll                   string
 }
 
 // BinaryName returns the running name of the CF CLI
@@ -37,6 +39,12 @@ func (config *Config) BinaryName() string {
 	return config.ENV.BinaryName
 }
 
+// CFAssertionFile returns the value of the "CF_ASSERTION_FILE" environment
+// variable.
+func (config *Config) CFAssertionFile() string {
+	return config.ENV.CFAssertionFile
+}
+
 // CFPassword returns the value of the "CF_PASSWORD" environment variable.
 func (config *Config) CFPassword() string {
 	return config.ENV.CFPassword
@@ -7,12 +7,14 @@ import (
 // JSONConfig represents .cf/config.json.
 type JSONConfig struct {
 	AccessToken              string             `json:"AccessToken"`
+	ActiveProfile            string             `json:"ActiveProfile,omitempty"`
 	APIVersion               string             `json:"APIVersion"`
 	AsyncTimeout             int                `json:"AsyncTimeout"`
 	AuthorizationEndpoint    string             `json:"AuthorizationEndpoint"`
 	CFOnK8s                  CFOnK8s            `json:"CFOnK8s"`
 	ColorEnabled             string             `json:"ColorEnabled"`
 	ConfigVersion            int                `json:"ConfigVersion"`
+	CredentialStore          string             `json:"CredentialStore,omitempty"`
 	DopplerEndpoint          string             `json:"DopplerEndPoint"`
 	Locale                   string             `json:"Locale"`
 	LogCacheEndpoint         string             `json:"LogCacheEndPoint"`
@@ -21,6 +23,7 @@ type JSONConfig struct {
 	NetworkPolicyV1Endpoint  string             `json:"NetworkPolicyV1Endpoint"`
 	TargetedOrganization     Organization       `json:"OrganizationFields"`
 	PluginRepositories       []PluginRepository `json:"PluginRepos"`
+	Profiles                 map[string]Profile `json:"Profiles,omitempty"`
 	RefreshToken             string             `json:"RefreshToken"`
 	RoutingEndpoint          string             `json:"RoutingAPIEndpoint"`
 	TargetedSpace            Space              `json:"SpaceFields"`
@@ -28,6 +31,7 @@ type JSONConfig struct {
 	SkipSSLValidation        bool               `json:"SSLDisabled"`
 	Target                   string             `json:"Target"`
 	Trace                    string             `json:"Trace"`
+	UAAAssertionFile         string             `json:"UAAAssertionFile,omitempty"`
 	UAAEndpoint              string             `json:"UaaEndpoint"`
 	UAAGrantType             string             `json:"UAAGrantType"`
 	UAAOAuthClient           string             `json:"UAAOAuthClient"`
@@ -233,6 +237,12 @@ func (config *Config) SetUAAGrantType(uaaGrantType string) {
 	config.ConfigFile.UAAGrantType = uaaGrantType
 }
 
+// SetUAAAssertionFile sets the file the assertion of a JWT bearer login is
+// read from when the token is refreshed.
+func (config *Config) SetUAAAssertionFile(path string) {
+	config.ConfigFile.UAAAssertionFile = path
+}
+
 // SkipSSLValidation returns whether or not to skip SSL validation when
 // targeting an API endpoint.
 func (config *Config) SkipSSLValidation() bool {
@@ -275,6 +285,12 @@ func (config *Config) UAAGrantType() string {
 	return config.ConfigFile.UAAGrantType
 }
 
+// UAAAssertionFile returns the file the assertion of a JWT bearer login is
+// read from again when the token is refreshed.
+func (config *Config) UAAAssertionFile() string {
+	return config.ConfigFile.UAAAssertionFile
+}
+
 // UAAOAuthClient returns the CLI's UAA client ID.
 func (config *Config) UAAOAuthClient() string {
 	return config.ConfigFile.UAAOAuthClient
@@ -303,6 +319,7 @@ func (config *Config) UnsetUserInformation() {
 	config.SetAccessToken("")
 	config.SetRefreshToken("")
 	config.SetUAAGrantType("")
+	config.SetUAAAssertionFile("")
 	config.SetUAAClientCredentials(DefaultUAAOAuthClient, DefaultUAAOAuthClientSecret)
 	config.SetKubernetesAuthInfo("")
 
@@ -476,6 +476,7 @@ var _ = Describe("JSONConfig", func() {
 			config.SetAccessToken("some-access-token")
 			config.SetRefreshToken("some-refresh-token")
 			config.SetUAAGrantType("client-credentials")
+			config.SetUAAAssertionFile("/some/assertion-file")
 			config.SetUAAClientCredentials("some-client", "some-client-secret")
 			config.SetOrganizationInformation("some-org-guid", "some-org")
 			config.SetSpaceInformation("guid-value-1", "my-org-name", true)
@@ -493,6 +494,7 @@ var _ = Describe("JSONConfig", func() {
 			Expect(config.ConfigFile.TargetedSpace.GUID).To(BeEmpty())
 			Expect(config.ConfigFile.TargetedSpace.Name).To(BeEmpty())
 			Expect(config.ConfigFile.UAAGrantType).To(BeEmpty())
+			Expect(config.UAAAssertionFile()).To(BeEmpty())
 			Expect(config.ConfigFile.UAAOAuthClient).To(Equal(DefaultUAAOAuthClient))
 			Expect(config.ConfigFile.UAAOAuthClientSecret).To(Equal(DefaultUAAOAuthClientSecret))
 			Expect(config.ConfigFile.CFOnK8s.AuthInfo).To(BeEmpty())
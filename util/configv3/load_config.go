@@ -117,25 +117,29 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	}
 
 	config.ENV = EnvOverride{
-		BinaryName:       filepath.Base(os.Args[0]),
-		CFColor:          os.Getenv("CF_COLOR"),
-		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
-		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
-		CFPassword:       os.Getenv("CF_PASSWORD"),
-		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:          os.Getenv("CF_TRACE"),
-		CFUsername:       os.Getenv("CF_USERNAME"),
-		CFB3TraceID:      os.Getenv("CF_B3_TRACE_ID"),
-		DockerPassword:   os.Getenv("CF_DOCKER_PASSWORD"),
-		CNBCredentials:   os.Getenv("CF_CNB_REGISTRY_CREDS"),
-		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
-		ForceTTY:         os.Getenv("FORCE_TTY"),
-		HTTPSProxy:       os.Getenv("https_proxy"),
-		Lang:             os.Getenv("LANG"),
-		LCAll:            os.Getenv("LC_ALL"),
-	}
+		BinaryName:              filepath.Base(os.Args[0]),
+		CFAssertionFile:         os.Getenv("CF_ASSERTION_FILE"),
+		CFColor:                 os.Getenv("CF_COLOR"),
+		CFCredentialsPassphrase: os.Getenv("CF_CREDENTIALS_PASSPHRASE"),
+		CFDialTimeout:           os.Getenv("CF_DIAL_TIMEOUT"),
+		CFLogLevel:              os.Getenv("CF_LOG_LEVEL"),
+		CFPassword:              os.Getenv("CF_PASSWORD"),
+		CFPluginHome:            os.Getenv("CF_PLUGIN_HOME"),
+		CFStagingTimeout:        os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:        os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTrace:                 os.Getenv("CF_TRACE"),
+		CFUsername:              os.Getenv("CF_USERNAME"),
+		CFB3TraceID:             os.Getenv("CF_B3_TRACE_ID"),
+		DockerPassword:          os.Getenv("CF_DOCKER_PASSWORD"),
+		CNBCredentials:          os.Getenv("CF_CNB_REGISTRY_CREDS"),
+		Experimental:            os.Getenv("CF_CLI_EXPERIMENTAL"),
+		ForceTTY:                os.Getenv("FORCE_TTY"),
+		HTTPSProxy:              os.Getenv("https_proxy"),
+		Lang:                    os.Getenv("LANG"),
+		LCAll:                   os.Getenv("LC_ALL"),
+	}
+
+	config.loadCredentials()
 
 	err = config.loadPluginConfig()
 	if err != nil {
@@ -0,0 +1,24 @@
+package configv3
+
+import "strings"
+
+// OutputFormat is the format command results are displayed in.
+type OutputFormat string
+
+const (
+	// OutputFormatDefault displays command results as human readable,
+	// translated text.
+	OutputFormatDefault OutputFormat = ""
+
+	// OutputFormatJSON displays command results as a JSON document.
+	OutputFormatJSON OutputFormat = "json"
+
+	// OutputFormatYAML displays command results as a YAML document.
+	OutputFormatYAML OutputFormat = "yaml"
+)
+
+// OutputFormat returns the format requested by the global --output flag.
+// Defaults to OutputFormatDefault if the flag was not provided.
+func (config *Config) OutputFormat() OutputFormat {
+	return OutputFormat(strings.ToLower(config.Flags.Output))
+}
@@ -0,0 +1,31 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+	})
+
+	When("the output flag is not provided", func() {
+		It("returns the default format", func() {
+			Expect(config.OutputFormat()).To(Equal(OutputFormatDefault))
+		})
+	})
+
+	When("the output flag is provided", func() {
+		BeforeEach(func() {
+			config.Flags = FlagOverride{Output: "JSON"}
+		})
+
+		It("returns the requested format", func() {
+			Expect(config.OutputFormat()).To(Equal(OutputFormatJSON))
+		})
+	})
+})
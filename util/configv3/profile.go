@@ -0,0 +1,224 @@
+package configv3
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// DefaultProfileName is the name of the profile used until another profile
+// is created and switched to.
+const DefaultProfileName = "default"
+
+// Profile holds the settings of a single foundation: its endpoints, the
+// tokens of the logged in user and the targeted org and space.
+type Profile struct {
+	Name string `json:"-"`
+
+	AccessToken              string       `json:"AccessToken"`
+	APIVersion               string       `json:"APIVersion"`
+	AuthorizationEndpoint    string       `json:"AuthorizationEndpoint"`
+	CFOnK8s                  CFOnK8s      `json:"CFOnK8s"`
+	DopplerEndpoint          string       `json:"DopplerEndPoint"`
+	LogCacheEndpoint         string       `json:"LogCacheEndPoint"`
+	MinCLIVersion            string       `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string       `json:"MinRecommendedCLIVersion"`
+	NetworkPolicyV1Endpoint  string       `json:"NetworkPolicyV1Endpoint"`
+	TargetedOrganization     Organization `json:"OrganizationFields"`
+	RefreshToken             string       `json:"RefreshToken"`
+	RoutingEndpoint          string       `json:"RoutingAPIEndpoint"`
+	TargetedSpace            Space        `json:"SpaceFields"`
+	SSHOAuthClient           string       `json:"SSHOAuthClient"`
+	SkipSSLValidation        bool         `json:"SSLDisabled"`
+	Target                   string       `json:"Target"`
+	UAAAssertionFile         string       `json:"UAAAssertionFile,omitempty"`
+	UAAEndpoint              string       `json:"UaaEndpoint"`
+	UAAGrantType             string       `json:"UAAGrantType"`
+	UAAOAuthClient           string       `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string       `json:"UAAOAuthClientSecret"`
+}
+
+// newProfile returns a profile that has not targeted an API yet.
+func newProfile(name string) Profile {
+	return Profile{
+		Name:                 name,
+		Target:               DefaultTarget,
+		SSHOAuthClient:       DefaultSSHOAuthClient,
+		UAAOAuthClient:       DefaultUAAOAuthClient,
+		UAAOAuthClientSecret: DefaultUAAOAuthClientSecret,
+	}
+}
+
+// profile returns the profile stored in the top level fields of the config
+// file.
+func (c *JSONConfig) profile() Profile {
+	return Profile{
+		AccessToken:              c.AccessToken,
+		APIVersion:               c.APIVersion,
+		AuthorizationEndpoint:    c.AuthorizationEndpoint,
+		CFOnK8s:                  c.CFOnK8s,
+		DopplerEndpoint:          c.DopplerEndpoint,
+		LogCacheEndpoint:         c.LogCacheEndpoint,
+		MinCLIVersion:            c.MinCLIVersion,
+		MinRecommendedCLIVersion: c.MinRecommendedCLIVersion,
+		NetworkPolicyV1Endpoint:  c.NetworkPolicyV1Endpoint,
+		TargetedOrganization:     c.TargetedOrganization,
+		RefreshToken:             c.RefreshToken,
+		RoutingEndpoint:          c.RoutingEndpoint,
+		TargetedSpace:            c.TargetedSpace,
+		SSHOAuthClient:           c.SSHOAuthClient,
+		SkipSSLValidation:        c.SkipSSLValidation,
+		Target:                   c.Target,
+		UAAAssertionFile:         c.UAAAssertionFile,
+		UAAEndpoint:              c.UAAEndpoint,
+		UAAGrantType:             c.UAAGrantType,
+		UAAOAuthClient:           c.UAAOAuthClient,
+		UAAOAuthClientSecret:     c.UAAOAuthClientSecret,
+	}
+}
+
+// setProfile replaces the top level fields of the config file with the
+// profile.
+func (c *JSONConfig) setProfile(profile Profile) {
+	c.AccessToken = profile.AccessToken
+	c.APIVersion = profile.APIVersion
+	c.AuthorizationEndpoint = profile.AuthorizationEndpoint
+	c.CFOnK8s = profile.CFOnK8s
+	c.DopplerEndpoint = profile.DopplerEndpoint
+	c.LogCacheEndpoint = profile.LogCacheEndpoint
+	c.MinCLIVersion = profile.MinCLIVersion
+	c.MinRecommendedCLIVersion = profile.MinRecommendedCLIVersion
+	c.NetworkPolicyV1Endpoint = profile.NetworkPolicyV1Endpoint
+	c.TargetedOrganization = profile.TargetedOrganization
+	c.RefreshToken = profile.RefreshToken
+	c.RoutingEndpoint = profile.RoutingEndpoint
+	c.TargetedSpace = profile.TargetedSpace
+	c.SSHOAuthClient = profile.SSHOAuthClient
+	c.SkipSSLValidation = profile.SkipSSLValidation
+	c.Target = profile.Target
+	c.UAAAssertionFile = profile.UAAAssertionFile
+	c.UAAEndpoint = profile.UAAEndpoint
+	c.UAAGrantType = profile.UAAGrantType
+	c.UAAOAuthClient = profile.UAAOAuthClient
+	c.UAAOAuthClientSecret = profile.UAAOAuthClientSecret
+}
+
+// activeProfileName returns the name of the profile stored in the top level
+// fields of the config file.
+func (c *JSONConfig) activeProfileName() string {
+	if c.ActiveProfile == "" {
+		return DefaultProfileName
+	}
+	return c.ActiveProfile
+}
+
+// switchProfile moves the profile in the top level fields into Profiles and
+// replaces it with the named profile.
+func (c *JSONConfig) switchProfile(from string, to string) {
+	if from == to {
+		return
+	}
+
+	if c.Profiles == nil {
+		c.Profiles = map[string]Profile{}
+	}
+	c.Profiles[from] = c.profile()
+	c.setProfile(c.Profiles[to])
+	delete(c.Profiles, to)
+}
+
+// ProfileName returns the name of the profile the command runs against. It is
+// the active profile unless the global --profile flag selected another one.
+func (config *Config) ProfileName() string {
+	if config.profileName == "" {
+		return config.ConfigFile.activeProfileName()
+	}
+	return config.profileName
+}
+
+// Profiles returns every profile in the config, sorted by name.
+func (config *Config) Profiles() []Profile {
+	current := config.ConfigFile.profile()
+	current.Name = config.ProfileName()
+	profiles := []Profile{current}
+
+	for name, profile := range config.ConfigFile.Profiles {
+		profile.Name = name
+		profiles = append(profiles, profile)
+	}
+
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+	return profiles
+}
+
+// hasProfile returns true if a profile with the given name exists.
+func (config *Config) hasProfile(name string) bool {
+	if name == config.ProfileName() {
+		return true
+	}
+	_, ok := config.ConfigFile.Profiles[name]
+	return ok
+}
+
+// CreateProfile adds a profile that has not targeted an API yet. It does not
+// switch to the new profile.
+func (config *Config) CreateProfile(name string) error {
+	if config.hasProfile(name) {
+		return translatableerror.ProfileAlreadyExistsError{Name: name}
+	}
+
+	if config.ConfigFile.Profiles == nil {
+		config.ConfigFile.Profiles = map[string]Profile{}
+	}
+	config.ConfigFile.Profiles[name] = newProfile(name)
+	return nil
+}
+
+// SelectProfile switches to the named profile for this command only. Changes
+// made to the settings are saved to that profile, and the active profile is
+// left unchanged.
+func (config *Config) SelectProfile(name string) error {
+	if !config.hasProfile(name) {
+		return translatableerror.ProfileNotFoundError{Name: name}
+	}
+
+	config.ConfigFile.switchProfile(config.ProfileName(), name)
+	config.profileName = name
+	return nil
+}
+
+// UseProfile makes the named profile the active profile.
+func (config *Config) UseProfile(name string) error {
+	err := config.SelectProfile(name)
+	if err != nil {
+		return err
+	}
+
+	config.ConfigFile.ActiveProfile = name
+	if name == DefaultProfileName {
+		config.ConfigFile.ActiveProfile = ""
+	}
+	config.profileName = ""
+	return nil
+}
+
+// fileContents returns the config file as it is saved to disk. When the
+// global --profile flag selected a profile other than the active one, the
+// active profile is moved back into the top level fields so other tools
+// reading the config file, such as plugins, keep seeing it.
+func (config *Config) fileContents() JSONConfig {
+	file := config.ConfigFile
+	active := file.activeProfileName()
+	if config.ProfileName() == active {
+		return file
+	}
+
+	file.Profiles = make(map[string]Profile, len(config.ConfigFile.Profiles))
+	for name, profile := range config.ConfigFile.Profiles {
+		file.Profiles[name] = profile
+	}
+	file.switchProfile(config.ProfileName(), active)
+	return file
+}
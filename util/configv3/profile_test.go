@@ -0,0 +1,162 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("profiles", func() {
+	var (
+		homeDir string
+		config  *configv3.Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+
+		config = &configv3.Config{
+			ConfigFile: configv3.JSONConfig{
+				ConfigVersion:        configv3.CurrentConfigVersion,
+				Target:               "https://api.dev.example.com",
+				AccessToken:          "dev-access-token",
+				RefreshToken:         "dev-refresh-token",
+				UAAOAuthClient:       "cf",
+				TargetedOrganization: configv3.Organization{GUID: "dev-org-guid", Name: "dev-org"},
+				TargetedSpace:        configv3.Space{GUID: "dev-space-guid", Name: "dev-space"},
+				ColorEnabled:         "true",
+			},
+		}
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	readConfigFile := func() configv3.JSONConfig {
+		file, err := os.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(err).ToNot(HaveOccurred())
+
+		var written configv3.JSONConfig
+		Expect(json.Unmarshal(file, &written)).To(Succeed())
+		return written
+	}
+
+	It("uses the default profile until another one is used", func() {
+		Expect(config.ProfileName()).To(Equal(configv3.DefaultProfileName))
+
+		profiles := config.Profiles()
+		Expect(profiles).To(HaveLen(1))
+		Expect(profiles[0].Name).To(Equal(configv3.DefaultProfileName))
+		Expect(profiles[0].Target).To(Equal("https://api.dev.example.com"))
+	})
+
+	Describe("CreateProfile", func() {
+		It("adds a profile without switching to it", func() {
+			Expect(config.CreateProfile("prod")).To(Succeed())
+
+			Expect(config.ProfileName()).To(Equal(configv3.DefaultProfileName))
+			Expect(config.Target()).To(Equal("https://api.dev.example.com"))
+
+			profiles := config.Profiles()
+			Expect(profiles).To(HaveLen(2))
+			Expect(profiles[1].Name).To(Equal("prod"))
+			Expect(profiles[1].Target).To(BeEmpty())
+			Expect(profiles[1].UAAOAuthClient).To(Equal(configv3.DefaultUAAOAuthClient))
+			Expect(profiles[1].SSHOAuthClient).To(Equal(configv3.DefaultSSHOAuthClient))
+		})
+
+		When("the profile already exists", func() {
+			It("returns an error", func() {
+				Expect(config.CreateProfile("prod")).To(Succeed())
+				Expect(config.CreateProfile("prod")).To(MatchError(translatableerror.ProfileAlreadyExistsError{Name: "prod"}))
+				Expect(config.CreateProfile("default")).To(MatchError(translatableerror.ProfileAlreadyExistsError{Name: "default"}))
+			})
+		})
+	})
+
+	Describe("UseProfile", func() {
+		BeforeEach(func() {
+			Expect(config.CreateProfile("prod")).To(Succeed())
+			Expect(config.UseProfile("prod")).To(Succeed())
+		})
+
+		It("swaps the settings of the profiles", func() {
+			Expect(config.ProfileName()).To(Equal("prod"))
+			Expect(config.Target()).To(BeEmpty())
+			Expect(config.AccessToken()).To(BeEmpty())
+			Expect(config.HasTargetedOrganization()).To(BeFalse())
+			Expect(config.ColorEnabled()).To(Equal(configv3.ColorEnabled))
+
+			config.SetTargetInformation(configv3.TargetInformationArgs{Api: "https://api.prod.example.com", SkipSSLValidation: true})
+			config.SetTokenInformation("prod-access-token", "prod-refresh-token", "ssh-proxy")
+
+			Expect(config.UseProfile("default")).To(Succeed())
+			Expect(config.Target()).To(Equal("https://api.dev.example.com"))
+			Expect(config.AccessToken()).To(Equal("dev-access-token"))
+			Expect(config.TargetedSpace().Name).To(Equal("dev-space"))
+			Expect(config.SkipSSLValidation()).To(BeFalse())
+
+			Expect(config.UseProfile("prod")).To(Succeed())
+			Expect(config.Target()).To(Equal("https://api.prod.example.com"))
+			Expect(config.AccessToken()).To(Equal("prod-access-token"))
+			Expect(config.SkipSSLValidation()).To(BeTrue())
+		})
+
+		It("saves the active profile in the top level fields of the config file", func() {
+			config.SetTargetInformation(configv3.TargetInformationArgs{Api: "https://api.prod.example.com"})
+			Expect(config.WriteConfig()).To(Succeed())
+
+			written := readConfigFile()
+			Expect(written.ActiveProfile).To(Equal("prod"))
+			Expect(written.Target).To(Equal("https://api.prod.example.com"))
+			Expect(written.Profiles).To(HaveLen(1))
+			Expect(written.Profiles).To(HaveKey("default"))
+			Expect(written.Profiles["default"].Target).To(Equal("https://api.dev.example.com"))
+			Expect(written.Profiles["default"].AccessToken).To(Equal("dev-access-token"))
+		})
+
+		When("the profile does not exist", func() {
+			It("returns an error", func() {
+				Expect(config.UseProfile("staging")).To(MatchError(translatableerror.ProfileNotFoundError{Name: "staging"}))
+				Expect(config.ProfileName()).To(Equal("prod"))
+			})
+		})
+	})
+
+	Describe("SelectProfile", func() {
+		BeforeEach(func() {
+			Expect(config.CreateProfile("prod")).To(Succeed())
+			Expect(config.SelectProfile("prod")).To(Succeed())
+		})
+
+		It("switches to the profile without changing the active profile", func() {
+			Expect(config.ProfileName()).To(Equal("prod"))
+			Expect(config.Target()).To(BeEmpty())
+
+			config.SetTargetInformation(configv3.TargetInformationArgs{Api: "https://api.prod.example.com"})
+			Expect(config.WriteConfig()).To(Succeed())
+
+			written := readConfigFile()
+			Expect(written.ActiveProfile).To(BeEmpty())
+			Expect(written.Target).To(Equal("https://api.dev.example.com"))
+			Expect(written.AccessToken).To(Equal("dev-access-token"))
+			Expect(written.Profiles).To(HaveLen(1))
+			Expect(written.Profiles["prod"].Target).To(Equal("https://api.prod.example.com"))
+
+			Expect(config.Target()).To(Equal("https://api.prod.example.com"))
+		})
+
+		When("the profile does not exist", func() {
+			It("returns an error", func() {
+				Expect(config.SelectProfile("staging")).To(MatchError(translatableerror.ProfileNotFoundError{Name: "staging"}))
+			})
+		})
+	})
+})
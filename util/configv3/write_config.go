@@ -12,7 +12,13 @@ import (
 // location of .cf directory is written in the same way LoadConfig reads .cf
 // directory.
 func (c *Config) WriteConfig() error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	file := c.fileContents()
+	err := c.saveCredentials(&file)
+	if err != nil {
+		return err
+	}
+
+	rawConfig, err := json.MarshalIndent(file, "", "  ")
 	if err != nil {
 		return err
 	}
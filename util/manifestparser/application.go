@@ -32,6 +32,7 @@ type Application struct {
 	Stack                   string                    `yaml:"stack,omitempty"`
 	LogRateLimit            string                    `yaml:"log-rate-limit-per-second,omitempty"`
 	Lifecycle               constant.AppLifecycleType `yaml:"lifecycle,omitempty"`
+	DependsOn               []string                  `yaml:"-"`
 	RemainingManifestFields map[string]interface{}    `yaml:"-,inline"`
 }
 
@@ -93,5 +94,22 @@ func (application *Application) UnmarshalYAML(unmarshal func(v interface{}) erro
 		delete(application.RemainingManifestFields, "disk_quota")
 	}
 
+	// depends-on is only understood by the CLI, so it is moved out of the
+	// remaining fields and never sent to the API with the rest of the manifest.
+	if rawDependsOn, ok := application.RemainingManifestFields["depends-on"]; ok {
+		dependsOn, ok := rawDependsOn.([]interface{})
+		if !ok {
+			return errors.New("`depends-on` must be a list of application names")
+		}
+		for _, dependency := range dependsOn {
+			name, ok := dependency.(string)
+			if !ok {
+				return errors.New("`depends-on` must be a list of application names")
+			}
+			application.DependsOn = append(application.DependsOn, name)
+		}
+		delete(application.RemainingManifestFields, "depends-on")
+	}
+
 	return nil
 }
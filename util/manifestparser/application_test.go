@@ -92,6 +92,34 @@ disk-quota: 6G
 			})
 		})
 
+		When("depends-on is provided", func() {
+			BeforeEach(func() {
+				rawYAML = []byte(`---
+depends-on:
+- db
+- cache
+`)
+			})
+
+			It("unmarshals the dependencies and keeps them out of the remaining manifest fields", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(application.DependsOn).To(Equal([]string{"db", "cache"}))
+				Expect(application.RemainingManifestFields).ToNot(HaveKey("depends-on"))
+			})
+
+			When("it is not a list of names", func() {
+				BeforeEach(func() {
+					rawYAML = []byte(`---
+depends-on: db
+`)
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError("`depends-on` must be a list of application names"))
+				})
+			})
+		})
+
 		Context("when default-route is provided", func() {
 			BeforeEach(func() {
 				rawYAML = []byte(`---
@@ -0,0 +1,27 @@
+package manifestparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnknownDependencyError is returned when an application depends on an
+// application that is not defined in the manifest.
+type UnknownDependencyError struct {
+	AppName    string
+	Dependency string
+}
+
+func (e UnknownDependencyError) Error() string {
+	return fmt.Sprintf("Application '%s' depends on '%s', which is not defined in the manifest", e.AppName, e.Dependency)
+}
+
+// DependencyCycleError is returned when the depends-on fields of the manifest
+// applications form a cycle.
+type DependencyCycleError struct {
+	Cycle []string
+}
+
+func (e DependencyCycleError) Error() string {
+	return fmt.Sprintf("Manifest applications have a dependency cycle: %s", strings.Join(e.Cycle, " -> "))
+}
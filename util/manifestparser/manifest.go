@@ -49,3 +49,90 @@ func (m Manifest) HasAppWithNoName() bool {
 	}
 	return false
 }
+
+// ValidateDependencies returns an error if an application depends on an
+// application that is not in the manifest, or if dependencies form a cycle.
+func (m Manifest) ValidateDependencies() error {
+	names := map[string]bool{}
+	for _, app := range m.Applications {
+		names[app.Name] = true
+	}
+
+	for _, app := range m.Applications {
+		for _, dependency := range app.DependsOn {
+			if !names[dependency] {
+				return UnknownDependencyError{AppName: app.Name, Dependency: dependency}
+			}
+		}
+	}
+
+	_, err := m.ApplicationsInDependencyOrder()
+	return err
+}
+
+// ApplicationsInDependencyOrder returns the applications ordered so that every
+// application comes after the applications it depends on. Applications
+// otherwise keep their manifest order. Dependencies on applications that are
+// not in the manifest are ignored, since the manifest may have been narrowed
+// down to a subset of its applications.
+func (m Manifest) ApplicationsInDependencyOrder() ([]Application, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	indexByName := map[string]int{}
+	for i, app := range m.Applications {
+		indexByName[app.Name] = i
+	}
+
+	state := make([]int, len(m.Applications))
+	ordered := make([]Application, 0, len(m.Applications))
+	var path []string
+
+	var visit func(i int) error
+	visit = func(i int) error {
+		app := m.Applications[i]
+		switch state[i] {
+		case visited:
+			return nil
+		case visiting:
+			return DependencyCycleError{Cycle: append(cycleFrom(path, app.Name), app.Name)}
+		}
+
+		state[i] = visiting
+		path = append(path, app.Name)
+		for _, dependency := range app.DependsOn {
+			dependencyIndex, ok := indexByName[dependency]
+			if !ok {
+				continue
+			}
+			if err := visit(dependencyIndex); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[i] = visited
+
+		ordered = append(ordered, app)
+		return nil
+	}
+
+	for i := range m.Applications {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
+
+func cycleFrom(path []string, name string) []string {
+	for i, pathName := range path {
+		if pathName == name {
+			return append([]string{}, path[i:]...)
+		}
+	}
+	return path
+}
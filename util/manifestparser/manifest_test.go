@@ -170,6 +170,65 @@ var _ = Describe("Manifest", func() {
 		})
 	})
 
+	Describe("ApplicationsInDependencyOrder", func() {
+		It("orders applications after their dependencies, keeping manifest order otherwise", func() {
+			manifest.Applications = []Application{
+				{Name: "frontend", DependsOn: []string{"api"}},
+				{Name: "worker"},
+				{Name: "api", DependsOn: []string{"db", "not-in-manifest"}},
+				{Name: "db"},
+			}
+
+			apps, err := manifest.ApplicationsInDependencyOrder()
+			Expect(err).ToNot(HaveOccurred())
+
+			var names []string
+			for _, app := range apps {
+				names = append(names, app.Name)
+			}
+			Expect(names).To(Equal([]string{"db", "api", "frontend", "worker"}))
+		})
+
+		It("returns an error when the dependencies form a cycle", func() {
+			manifest.Applications = []Application{
+				{Name: "a", DependsOn: []string{"b"}},
+				{Name: "b", DependsOn: []string{"c"}},
+				{Name: "c", DependsOn: []string{"a"}},
+			}
+
+			_, err := manifest.ApplicationsInDependencyOrder()
+			Expect(err).To(MatchError(DependencyCycleError{Cycle: []string{"a", "b", "c", "a"}}))
+			Expect(err.Error()).To(Equal("Manifest applications have a dependency cycle: a -> b -> c -> a"))
+		})
+	})
+
+	Describe("ValidateDependencies", func() {
+		It("returns an error when an application depends on an unknown application", func() {
+			manifest.Applications = []Application{
+				{Name: "api", DependsOn: []string{"db"}},
+			}
+
+			Expect(manifest.ValidateDependencies()).To(MatchError(UnknownDependencyError{AppName: "api", Dependency: "db"}))
+		})
+
+		It("returns an error when an application depends on itself", func() {
+			manifest.Applications = []Application{
+				{Name: "api", DependsOn: []string{"api"}},
+			}
+
+			Expect(manifest.ValidateDependencies()).To(MatchError(DependencyCycleError{Cycle: []string{"api", "api"}}))
+		})
+
+		It("returns nil for valid dependencies", func() {
+			manifest.Applications = []Application{
+				{Name: "api", DependsOn: []string{"db"}},
+				{Name: "db"},
+			}
+
+			Expect(manifest.ValidateDependencies()).To(Succeed())
+		})
+	})
+
 	Describe("has the correct attributes", func() {
 		It("it unmarshals with the version", func() {
 			manifestBytes := []byte(`---
@@ -68,6 +68,11 @@ func (m ManifestParser) ParseManifest(pathToManifest string, rawManifest []byte)
 		return Manifest{}, errors.New("Manifest must have at least one application.")
 	}
 
+	err = parsedManifest.ValidateDependencies()
+	if err != nil {
+		return Manifest{}, err
+	}
+
 	parsedManifest.PathToManifest = pathToManifest
 
 	return parsedManifest, nil
@@ -281,6 +281,21 @@ applications:
 			})
 		})
 
+		When("the manifest has a dependency cycle", func() {
+			BeforeEach(func() {
+				rawManifest = []byte(`applications:
+- name: one
+  depends-on: [two]
+- name: two
+  depends-on: [one]
+`)
+			})
+
+			It("returns a validation error", func() {
+				Expect(executeErr).To(MatchError(DependencyCycleError{Cycle: []string{"one", "two", "one"}}))
+			})
+		})
+
 		When("the manifest is valid", func() {
 			BeforeEach(func() {
 				rawManifest = []byte(`applications:
@@ -0,0 +1,579 @@
+package manifestparser
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	// The rest of the parser uses gopkg.in/yaml.v2, which keeps no position
+	// information. yaml/v3 nodes carry the line numbers issues are reported
+	// against; the module was already in the build graph through gomega.
+	"go.yaml.in/yaml/v3"
+)
+
+// ManifestIssue is a single problem found by ValidateManifest. Line is the
+// line of the manifest file the problem was found on, or 0 when it cannot be
+// determined.
+type ManifestIssue struct {
+	Line    int
+	Field   string
+	Message string
+}
+
+var (
+	manifestKeys = []string{"applications", "version"}
+
+	applicationKeys = []string{
+		"buildpack", "buildpacks", "cnb-credentials", "command", "default-route",
+		"depends-on", "disk-quota", "disk_quota", "docker", "env",
+		"health-check-http-endpoint", "health-check-interval",
+		"health-check-invocation-timeout", "health-check-type", "instances",
+		"lifecycle", "log-rate-limit-per-second", "memory", "metadata", "name",
+		"no-route", "path", "processes", "random-route",
+		"readiness-health-check-http-endpoint", "readiness-health-check-interval",
+		"readiness-health-check-invocation-timeout", "readiness-health-check-type",
+		"routes", "services", "sidecars", "stack", "timeout",
+	}
+
+	processKeys = []string{
+		"command", "disk-quota", "disk_quota", "health-check-http-endpoint",
+		"health-check-interval", "health-check-invocation-timeout",
+		"health-check-type", "instances", "log-rate-limit-per-second", "memory",
+		"readiness-health-check-http-endpoint", "readiness-health-check-interval",
+		"readiness-health-check-invocation-timeout", "readiness-health-check-type",
+		"timeout", "type",
+	}
+
+	dockerKeys  = []string{"image", "username"}
+	routeKeys   = []string{"options", "protocol", "route"}
+	sidecarKeys = []string{"command", "memory", "name", "process_types"}
+
+	// "none" is the deprecated alias of "process" that Cloud Controller still
+	// accepts.
+	healthCheckTypes          = []string{"http", "none", "port", "process"}
+	readinessHealthCheckTypes = []string{"http", "port", "process"}
+	routeProtocols            = []string{"http1", "http2", "tcp"}
+
+	yamlErrorLine = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+)
+
+// ValidateManifest interpolates the manifest at the provided path the same
+// way InterpolateManifest does and checks the result for problems that would
+// otherwise only surface when the manifest is applied, such as misspelled keys,
+// invalid byte quantities or malformed routes. It works entirely offline.
+// Issues are reported against the lines of the uninterpolated file. The
+// returned error is only set when the manifest cannot be read or interpolated.
+func (m ManifestParser) ValidateManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]ManifestIssue, error) {
+	rawManifest, err := os.ReadFile(pathToManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawDocument yaml.Node
+	err = yaml.Unmarshal(rawManifest, &rawDocument)
+	if err != nil {
+		return []ManifestIssue{yamlSyntaxIssue(err)}, nil
+	}
+
+	// Interpolation fails on duplicate keys, so report them up front.
+	if issues := duplicateKeys(nil, &rawDocument); len(issues) > 0 {
+		return issues, nil
+	}
+
+	interpolatedManifest, err := m.InterpolateManifest(pathToManifest, pathsToVarsFiles, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	var document yaml.Node
+	err = yaml.Unmarshal(interpolatedManifest, &document)
+	if err != nil {
+		return []ManifestIssue{yamlSyntaxIssue(err)}, nil
+	}
+
+	validator := manifestValidator{raw: &rawDocument}
+	validator.validateDocument(&document)
+
+	sort.SliceStable(validator.issues, func(i, j int) bool {
+		return validator.issues[i].Line < validator.issues[j].Line
+	})
+
+	return validator.issues, nil
+}
+
+func yamlSyntaxIssue(err error) ManifestIssue {
+	matches := yamlErrorLine.FindStringSubmatch(err.Error())
+	if matches == nil {
+		return ManifestIssue{Message: err.Error()}
+	}
+
+	line, _ := strconv.Atoi(matches[1])
+	return ManifestIssue{Line: line, Message: matches[2]}
+}
+
+func duplicateKeys(path fieldPath, node *yaml.Node) []ManifestIssue {
+	var issues []ManifestIssue
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			issues = append(issues, duplicateKeys(path, child)...)
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			issues = append(issues, duplicateKeys(path.child(i), child)...)
+		}
+	case yaml.MappingNode:
+		seen := map[string]int{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			if line, exists := seen[key.Value]; exists {
+				issues = append(issues, ManifestIssue{
+					Line:    key.Line,
+					Field:   path.child(key.Value).String(),
+					Message: fmt.Sprintf("duplicate key %q, already defined on line %d", key.Value, line),
+				})
+				continue
+			}
+			seen[key.Value] = key.Line
+			issues = append(issues, duplicateKeys(path.child(key.Value), node.Content[i+1])...)
+		}
+	}
+	return issues
+}
+
+// fieldPath locates a node in a manifest; its segments are mapping keys
+// (strings) and sequence indices (ints).
+type fieldPath []interface{}
+
+func (path fieldPath) child(segment interface{}) fieldPath {
+	return append(append(fieldPath{}, path...), segment)
+}
+
+func (path fieldPath) String() string {
+	var builder strings.Builder
+	for _, segment := range path {
+		switch segment := segment.(type) {
+		case int:
+			fmt.Fprintf(&builder, "[%d]", segment)
+		case string:
+			if builder.Len() > 0 {
+				builder.WriteByte('.')
+			}
+			builder.WriteString(segment)
+		}
+	}
+	return builder.String()
+}
+
+type manifestValidator struct {
+	raw    *yaml.Node
+	issues []ManifestIssue
+}
+
+func (v *manifestValidator) report(path fieldPath, format string, args ...interface{}) {
+	v.issues = append(v.issues, ManifestIssue{
+		Line:    lineOf(v.raw, path),
+		Field:   path.String(),
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// lineOf returns the line of the node at path in the uninterpolated
+// manifest. When interpolation changed the structure so that the path does
+// not exist, the line of the deepest node that does exist is returned.
+func lineOf(document *yaml.Node, path fieldPath) int {
+	if len(document.Content) == 0 {
+		return 0
+	}
+
+	node := document.Content[0]
+	line := node.Line
+	for _, segment := range path {
+		switch segment := segment.(type) {
+		case string:
+			key, value := mappingEntry(node, segment)
+			if key == nil {
+				return line
+			}
+			line, node = key.Line, value
+		case int:
+			if node.Kind != yaml.SequenceNode || segment >= len(node.Content) {
+				return line
+			}
+			node = node.Content[segment]
+			line = node.Line
+		}
+	}
+
+	return line
+}
+
+func mappingEntry(node *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	if node.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i], node.Content[i+1]
+		}
+	}
+
+	return nil, nil
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	_, value := mappingEntry(node, key)
+	return value
+}
+
+func (v *manifestValidator) validateDocument(document *yaml.Node) {
+	if len(document.Content) == 0 {
+		v.report(nil, "Manifest must have at least one application.")
+		return
+	}
+
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		v.report(nil, "manifest must be a mapping")
+		return
+	}
+	v.checkKeys(nil, root, manifestKeys)
+
+	path := fieldPath{"applications"}
+	applications := mappingValue(root, "applications")
+	if applications == nil || applications.Kind != yaml.SequenceNode || len(applications.Content) == 0 {
+		v.report(path, "Manifest must have at least one application.")
+		return
+	}
+
+	names := map[string]int{}
+	dependencies := map[int][]string{}
+	for i, application := range applications.Content {
+		dependencies[i] = v.validateApplication(path.child(i), application, names)
+	}
+
+	v.validateDependencies(path, applications, names, dependencies)
+}
+
+func (v *manifestValidator) validateApplication(path fieldPath, application *yaml.Node, names map[string]int) []string {
+	if application.Kind != yaml.MappingNode {
+		v.report(path, "application must be a mapping")
+		return nil
+	}
+	v.checkKeys(path, application, applicationKeys)
+
+	name := mappingValue(application, "name")
+	if name == nil || name.Kind != yaml.ScalarNode || name.Value == "" {
+		v.report(path.child("name"), "application name is required")
+	} else if first, exists := names[name.Value]; exists {
+		v.report(path.child("name"), "duplicate application name %q, already used by applications[%d]", name.Value, first)
+	} else {
+		names[name.Value] = path[len(path)-1].(int)
+	}
+
+	v.validateProcessFields(path, application)
+
+	if docker := mappingValue(application, "docker"); docker != nil {
+		v.validateDocker(path.child("docker"), docker)
+		for _, key := range []string{"buildpacks", "buildpack"} {
+			if mappingValue(application, key) != nil {
+				v.report(path.child("docker"), "`docker` cannot be used together with `%s`", key)
+			}
+		}
+	}
+
+	if buildpacks := mappingValue(application, "buildpacks"); buildpacks != nil && buildpacks.Kind != yaml.SequenceNode {
+		v.report(path.child("buildpacks"), "`buildpacks` must be a list of buildpack names")
+	}
+
+	if routes := mappingValue(application, "routes"); routes != nil {
+		v.validateRoutes(path.child("routes"), routes)
+	}
+
+	if processes := mappingValue(application, "processes"); processes != nil {
+		v.validateProcesses(path.child("processes"), processes)
+	}
+
+	if sidecars := mappingValue(application, "sidecars"); sidecars != nil {
+		v.validateSidecars(path.child("sidecars"), sidecars)
+	}
+
+	return v.dependsOn(path.child("depends-on"), mappingValue(application, "depends-on"))
+}
+
+func (v *manifestValidator) validateProcessFields(path fieldPath, node *yaml.Node) {
+	for _, key := range []string{"memory", "disk_quota", "disk-quota"} {
+		if value := mappingValue(node, key); value != nil {
+			v.validateByteQuantity(path.child(key), value)
+		}
+	}
+
+	if mappingValue(node, "disk_quota") != nil && mappingValue(node, "disk-quota") != nil {
+		v.report(path.child("disk-quota"), "cannot define both `disk_quota` and `disk-quota`")
+	}
+
+	if value := mappingValue(node, "log-rate-limit-per-second"); value != nil && value.Value != "-1" {
+		v.validateByteQuantity(path.child("log-rate-limit-per-second"), value)
+	}
+
+	if value := mappingValue(node, "health-check-type"); value != nil && !contains(healthCheckTypes, value.Value) {
+		v.report(path.child("health-check-type"), "unknown health check type %q, must be one of: %s", value.Value, strings.Join(healthCheckTypes, ", "))
+	}
+
+	if value := mappingValue(node, "readiness-health-check-type"); value != nil && !contains(readinessHealthCheckTypes, value.Value) {
+		v.report(path.child("readiness-health-check-type"), "unknown readiness health check type %q, must be one of: %s", value.Value, strings.Join(readinessHealthCheckTypes, ", "))
+	}
+
+	for _, key := range []string{"instances", "timeout"} {
+		value := mappingValue(node, key)
+		if value == nil {
+			continue
+		}
+		if number, err := strconv.Atoi(value.Value); err != nil || value.Kind != yaml.ScalarNode || number < 0 {
+			v.report(path.child(key), "`%s` must be a non-negative integer, got %q", key, value.Value)
+		}
+	}
+}
+
+func (v *manifestValidator) validateByteQuantity(path fieldPath, value *yaml.Node) {
+	if value.Kind != yaml.ScalarNode {
+		v.report(path, "byte quantity must be an integer with a unit of measurement like M, MB, G, or GB")
+		return
+	}
+
+	if _, err := bytefmt.ToBytes(value.Value); err != nil {
+		v.report(path, "invalid byte quantity %q, must be an integer with a unit of measurement like M, MB, G, or GB", value.Value)
+	}
+}
+
+func (v *manifestValidator) validateDocker(path fieldPath, docker *yaml.Node) {
+	if docker.Kind != yaml.MappingNode {
+		v.report(path, "`docker` must be a mapping with an `image` key")
+		return
+	}
+	v.checkKeys(path, docker, dockerKeys)
+
+	if image := mappingValue(docker, "image"); image == nil || image.Value == "" {
+		v.report(path.child("image"), "docker image is required")
+	}
+}
+
+func (v *manifestValidator) validateRoutes(path fieldPath, routes *yaml.Node) {
+	if routes.Kind != yaml.SequenceNode {
+		v.report(path, "`routes` must be a list of routes")
+		return
+	}
+
+	for i, route := range routes.Content {
+		routePath := path.child(i)
+		if route.Kind != yaml.MappingNode {
+			v.report(routePath, "route must be a mapping with a `route` key")
+			continue
+		}
+		v.checkKeys(routePath, route, routeKeys)
+
+		value := mappingValue(route, "route")
+		if value == nil || value.Value == "" {
+			v.report(routePath.child("route"), "route is required")
+		} else if problem := routeProblem(value.Value); problem != "" {
+			v.report(routePath.child("route"), "invalid route %q, %s", value.Value, problem)
+		}
+
+		if protocol := mappingValue(route, "protocol"); protocol != nil && !contains(routeProtocols, protocol.Value) {
+			v.report(routePath.child("protocol"), "unknown route protocol %q, must be one of: %s", protocol.Value, strings.Join(routeProtocols, ", "))
+		}
+	}
+}
+
+// routeProblem describes what is wrong with a route in HOST.DOMAIN[:PORT][/PATH]
+// form, or returns an empty string when the route is well formed.
+func routeProblem(route string) string {
+	if strings.Contains(route, "://") {
+		return "routes must not include a scheme"
+	}
+
+	if strings.ContainsAny(route, " \t") {
+		return "routes must not contain whitespace"
+	}
+
+	hostAndPort, path := route, ""
+	if i := strings.Index(route, "/"); i >= 0 {
+		hostAndPort, path = route[:i], route[i:]
+	}
+
+	host, port := hostAndPort, ""
+	if i := strings.LastIndex(hostAndPort, ":"); i >= 0 {
+		host, port = hostAndPort[:i], hostAndPort[i+1:]
+	}
+
+	if !strings.Contains(host, ".") || strings.HasPrefix(host, ".") || strings.HasSuffix(host, ".") || strings.Contains(host, "..") {
+		return "expected a fully qualified host name such as app.example.com"
+	}
+
+	if port != "" {
+		if number, err := strconv.Atoi(port); err != nil || number < 1 || number > 65535 {
+			return "port must be a number between 1 and 65535"
+		}
+		if path != "" {
+			return "routes cannot have both a port and a path"
+		}
+	}
+
+	return ""
+}
+
+func (v *manifestValidator) validateProcesses(path fieldPath, processes *yaml.Node) {
+	if processes.Kind != yaml.SequenceNode {
+		v.report(path, "`processes` must be a list of processes")
+		return
+	}
+
+	types := map[string]bool{}
+	for i, process := range processes.Content {
+		processPath := path.child(i)
+		if process.Kind != yaml.MappingNode {
+			v.report(processPath, "process must be a mapping")
+			continue
+		}
+		v.checkKeys(processPath, process, processKeys)
+
+		processType := mappingValue(process, "type")
+		if processType == nil || processType.Value == "" {
+			v.report(processPath.child("type"), "process type is required")
+		} else if types[processType.Value] {
+			v.report(processPath.child("type"), "duplicate process type %q", processType.Value)
+		} else {
+			types[processType.Value] = true
+		}
+
+		v.validateProcessFields(processPath, process)
+	}
+}
+
+func (v *manifestValidator) validateSidecars(path fieldPath, sidecars *yaml.Node) {
+	if sidecars.Kind != yaml.SequenceNode {
+		v.report(path, "`sidecars` must be a list of sidecars")
+		return
+	}
+
+	for i, sidecar := range sidecars.Content {
+		sidecarPath := path.child(i)
+		if sidecar.Kind != yaml.MappingNode {
+			v.report(sidecarPath, "sidecar must be a mapping")
+			continue
+		}
+		v.checkKeys(sidecarPath, sidecar, sidecarKeys)
+
+		if memory := mappingValue(sidecar, "memory"); memory != nil {
+			v.validateByteQuantity(sidecarPath.child("memory"), memory)
+		}
+	}
+}
+
+func (v *manifestValidator) dependsOn(path fieldPath, dependsOn *yaml.Node) []string {
+	if dependsOn == nil {
+		return nil
+	}
+
+	if dependsOn.Kind != yaml.SequenceNode {
+		v.report(path, "`depends-on` must be a list of application names")
+		return nil
+	}
+
+	var dependencies []string
+	for _, dependency := range dependsOn.Content {
+		dependencies = append(dependencies, dependency.Value)
+	}
+	return dependencies
+}
+
+func (v *manifestValidator) validateDependencies(path fieldPath, applications *yaml.Node, names map[string]int, dependencies map[int][]string) {
+	var manifest Manifest
+	for i, application := range applications.Content {
+		name := mappingValue(application, "name")
+		if name == nil {
+			continue
+		}
+		if index, exists := names[name.Value]; !exists || index != i {
+			continue
+		}
+
+		app := Application{Name: name.Value}
+		for j, dependency := range dependencies[i] {
+			if _, exists := names[dependency]; !exists {
+				v.report(path.child(i).child("depends-on").child(j), "%s", UnknownDependencyError{AppName: app.Name, Dependency: dependency}.Error())
+				continue
+			}
+			app.DependsOn = append(app.DependsOn, dependency)
+		}
+		manifest.Applications = append(manifest.Applications, app)
+	}
+
+	if _, err := manifest.ApplicationsInDependencyOrder(); err != nil {
+		v.report(path, "%s", err.Error())
+	}
+}
+
+func (v *manifestValidator) checkKeys(path fieldPath, node *yaml.Node, knownKeys []string) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i].Value
+		if contains(knownKeys, key) {
+			continue
+		}
+
+		if suggestion := closestKey(key, knownKeys); suggestion != "" {
+			v.report(path.child(key), "unknown key %q, did you mean %q?", key, suggestion)
+		} else {
+			v.report(path.child(key), "unknown key %q", key)
+		}
+	}
+}
+
+// closestKey returns the known key within a small edit distance of key, or an
+// empty string if there is none.
+func closestKey(key string, knownKeys []string) string {
+	best, bestDistance := "", 3
+	for _, known := range knownKeys {
+		if distance := editDistance(key, known); distance < bestDistance {
+			best, bestDistance = known, distance
+		}
+	}
+	return best
+}
+
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+
+	return previous[len(b)]
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,260 @@
+package manifestparser_test
+
+import (
+	"os"
+
+	. "code.cloudfoundry.org/cli/util/manifestparser"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateManifest", func() {
+	var (
+		parser         ManifestParser
+		givenManifest  string
+		pathToManifest string
+		vars           []template.VarKV
+
+		issues     []ManifestIssue
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		tempFile, err := os.CreateTemp("", "manifest-test-")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tempFile.Close()).ToNot(HaveOccurred())
+		pathToManifest = tempFile.Name()
+		vars = nil
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(pathToManifest)).ToNot(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		Expect(os.WriteFile(pathToManifest, []byte(givenManifest), 0666)).To(Succeed())
+		issues, executeErr = parser.ValidateManifest(pathToManifest, nil, vars)
+	})
+
+	When("the manifest is valid", func() {
+		BeforeEach(func() {
+			givenManifest = `---
+applications:
+- name: web-app
+  memory: 256M
+  disk_quota: 1G
+  instances: 2
+  health-check-type: http
+  readiness-health-check-type: port
+  buildpacks:
+  - ruby_buildpack
+  routes:
+  - route: web.example.com/api
+  - route: tcp.example.com:1024
+    protocol: tcp
+  processes:
+  - type: worker
+    memory: 512M
+  env:
+    anything_goes: here
+- name: worker-app
+  health-check-type: none
+  docker:
+    image: some/image
+  depends-on:
+  - web-app
+`
+		})
+
+		It("returns no issues", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(issues).To(BeEmpty())
+		})
+	})
+
+	When("the manifest has problems", func() {
+		BeforeEach(func() {
+			givenManifest = `---
+applications:
+- name: app
+  memroy: 256M
+  disk_quota: 1 gig
+  health-check-type: tcp
+  docker:
+    image: some/image
+  buildpacks:
+  - ruby_buildpack
+  routes:
+  - route: https://app.example.com
+  - route: app
+  - route: app.example.com:99999
+- name: app
+  processes:
+  - type: web
+    instances: -1
+  - type: web
+  depends-on:
+  - missing-app
+`
+		})
+
+		It("reports every issue with the line it is on", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(issues).To(Equal([]ManifestIssue{
+				{Line: 4, Field: "applications[0].memroy", Message: `unknown key "memroy", did you mean "memory"?`},
+				{Line: 5, Field: "applications[0].disk_quota", Message: `invalid byte quantity "1 gig", must be an integer with a unit of measurement like M, MB, G, or GB`},
+				{Line: 6, Field: "applications[0].health-check-type", Message: `unknown health check type "tcp", must be one of: http, none, port, process`},
+				{Line: 7, Field: "applications[0].docker", Message: "`docker` cannot be used together with `buildpacks`"},
+				{Line: 12, Field: "applications[0].routes[0].route", Message: `invalid route "https://app.example.com", routes must not include a scheme`},
+				{Line: 13, Field: "applications[0].routes[1].route", Message: `invalid route "app", expected a fully qualified host name such as app.example.com`},
+				{Line: 14, Field: "applications[0].routes[2].route", Message: `invalid route "app.example.com:99999", port must be a number between 1 and 65535`},
+				{Line: 15, Field: "applications[1].name", Message: `duplicate application name "app", already used by applications[0]`},
+				{Line: 18, Field: "applications[1].processes[0].instances", Message: "`instances` must be a non-negative integer, got \"-1\""},
+				{Line: 19, Field: "applications[1].processes[1].type", Message: `duplicate process type "web"`},
+			}))
+		})
+	})
+
+	When("the readiness health check types are unknown", func() {
+		BeforeEach(func() {
+			givenManifest = `---
+applications:
+- name: app
+  readiness-health-check-type: htpp
+  processes:
+  - type: web
+    readiness-health-check-type: none
+`
+		})
+
+		It("reports them for the application and its processes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(issues).To(Equal([]ManifestIssue{
+				{Line: 4, Field: "applications[0].readiness-health-check-type", Message: `unknown readiness health check type "htpp", must be one of: http, port, process`},
+				{Line: 7, Field: "applications[0].processes[0].readiness-health-check-type", Message: `unknown readiness health check type "none", must be one of: http, port, process`},
+			}))
+		})
+	})
+
+	When("an application depends on an unknown application", func() {
+		BeforeEach(func() {
+			givenManifest = `---
+applications:
+- name: app
+  depends-on:
+  - missing-app
+`
+		})
+
+		It("reports the dependency", func() {
+			Expect(issues).To(ConsistOf(ManifestIssue{
+				Line:    5,
+				Field:   "applications[0].depends-on[0]",
+				Message: "Application 'app' depends on 'missing-app', which is not defined in the manifest",
+			}))
+		})
+	})
+
+	When("applications depend on each other", func() {
+		BeforeEach(func() {
+			givenManifest = `---
+applications:
+- name: a
+  depends-on: [b]
+- name: b
+  depends-on: [a]
+`
+		})
+
+		It("reports the cycle", func() {
+			Expect(issues).To(ConsistOf(ManifestIssue{
+				Line:    2,
+				Field:   "applications",
+				Message: "Manifest applications have a dependency cycle: a -> b -> a",
+			}))
+		})
+	})
+
+	When("the manifest contains variables", func() {
+		BeforeEach(func() {
+			givenManifest = `---
+applications:
+- name: app
+  memory: ((memory))
+`
+			vars = []template.VarKV{{Name: "memory", Value: "lots"}}
+		})
+
+		It("validates the interpolated values against the original lines", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(issues).To(ConsistOf(ManifestIssue{
+				Line:    4,
+				Field:   "applications[0].memory",
+				Message: `invalid byte quantity "lots", must be an integer with a unit of measurement like M, MB, G, or GB`,
+			}))
+		})
+
+		When("a variable is not provided", func() {
+			BeforeEach(func() {
+				vars = nil
+			})
+
+			It("returns an interpolation error", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(InterpolationError{}))
+			})
+		})
+	})
+
+	When("the manifest is not valid YAML", func() {
+		BeforeEach(func() {
+			givenManifest = `---
+applications:
+- name: app
+  memory: 1G
+	instances: 2
+`
+		})
+
+		It("reports the syntax error with its line", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(issues).To(ConsistOf(ManifestIssue{
+				Line:    4,
+				Message: "found a tab character that violates indentation",
+			}))
+		})
+	})
+
+	When("a key is defined twice", func() {
+		BeforeEach(func() {
+			givenManifest = `---
+applications:
+- name: app
+  memory: 1G
+  memory: 2G
+`
+		})
+
+		It("reports the duplicate key", func() {
+			Expect(issues).To(ConsistOf(ManifestIssue{
+				Line:    5,
+				Field:   "applications[0].memory",
+				Message: `duplicate key "memory", already defined on line 4`,
+			}))
+		})
+	})
+
+	When("the manifest has no applications", func() {
+		BeforeEach(func() {
+			givenManifest = "---\nversion: 1\n"
+		})
+
+		It("reports that an application is required", func() {
+			Expect(issues).To(ConsistOf(ManifestIssue{
+				Line:    2,
+				Field:   "applications",
+				Message: "Manifest must have at least one application.",
+			}))
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	plugin_transition "code.cloudfoundry.org/cli/plugin/transition"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -47,6 +48,33 @@ func IsPluginCommand(osArgs []string) (configv3.Plugin, bool) {
 	return configv3.Plugin{}, false
 }
 
+// IsPluginCommandWithProfile reports whether the arguments run a plugin
+// command after the global --profile flag. Plugins always run against the
+// active profile, so the flag cannot be honoured for them.
+func IsPluginCommandWithProfile(osArgs []string) (string, bool) {
+	var withProfile bool
+	for len(osArgs) > 0 && strings.HasPrefix(osArgs[0], "-") {
+		switch {
+		case osArgs[0] == "--profile" && len(osArgs) > 1:
+			withProfile = true
+			osArgs = osArgs[2:]
+		case strings.HasPrefix(osArgs[0], "--profile="):
+			withProfile = true
+			osArgs = osArgs[1:]
+		default:
+			osArgs = osArgs[1:]
+		}
+	}
+
+	if !withProfile {
+		return "", false
+	}
+	if _, isPlugin := IsPluginCommand(osArgs); !isPlugin {
+		return "", false
+	}
+	return osArgs[0], true
+}
+
 func PluginCommandNames() []string {
 	var names []string
 
@@ -0,0 +1,68 @@
+package plugin_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/util/plugin"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("plugin commands", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		var err error
+		homeDir, err = os.MkdirTemp("", "cli-plugin-util")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.Setenv("CF_HOME", homeDir)).To(Succeed())
+		Expect(os.Setenv("CF_PLUGIN_HOME", homeDir)).To(Succeed())
+
+		pluginsDir := filepath.Join(homeDir, ".cf", "plugins")
+		Expect(os.MkdirAll(pluginsDir, 0700)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(pluginsDir, "config.json"), []byte(`{
+			"Plugins": {
+				"some-plugin": {
+					"Location": "/some/plugin",
+					"Commands": [{"Name": "some-command", "Alias": "sc"}]
+				}
+			}
+		}`), 0600)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.Unsetenv("CF_PLUGIN_HOME")).To(Succeed())
+		Expect(os.Unsetenv("CF_HOME")).To(Succeed())
+		Expect(os.RemoveAll(homeDir)).To(Succeed())
+	})
+
+	Describe("IsPluginCommandWithProfile", func() {
+		DescribeTable("detects plugin commands run with --profile",
+			func(args []string, expectedCommand string, expectedWithProfile bool) {
+				command, withProfile := plugin.IsPluginCommandWithProfile(args)
+				Expect(withProfile).To(Equal(expectedWithProfile))
+				Expect(command).To(Equal(expectedCommand))
+			},
+
+			Entry("--profile NAME before the command", []string{"--profile", "prod", "some-command"}, "some-command", true),
+			Entry("--profile=NAME before the alias", []string{"--profile=prod", "sc", "arg"}, "sc", true),
+			Entry("other global flags", []string{"-v", "--profile", "prod", "some-command"}, "some-command", true),
+			Entry("no --profile", []string{"some-command", "--profile", "prod"}, "", false),
+			Entry("a CLI command", []string{"--profile", "prod", "apps"}, "", false),
+			Entry("no command", []string{"--profile", "prod"}, "", false),
+		)
+	})
+
+	Describe("IsPluginCommand", func() {
+		It("finds the plugin by command name or alias", func() {
+			found, isPlugin := plugin.IsPluginCommand([]string{"sc"})
+			Expect(isPlugin).To(BeTrue())
+			Expect(found.Name).To(Equal("some-plugin"))
+
+			_, isPlugin = plugin.IsPluginCommand([]string{"--profile", "prod", "some-command"})
+			Expect(isPlugin).To(BeFalse())
+		})
+	})
+})
@@ -40,6 +40,19 @@ func (p *ProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64)
 	return p.bar.NewProxyReader(reader)
 }
 
+// NewProgressBarWriterWrapper starts displaying the progress of the bytes
+// written through the returned writer. Unlike NewProgressBarWrapper it does
+// not wait for Ready. A sizeOfFile of 0 displays the bytes written so far
+// when the total is unknown.
+func (p *ProgressBar) NewProgressBarWriterWrapper(writer io.Writer, sizeOfFile int64) io.Writer {
+	log.WithField("file_size", sizeOfFile).Debug("new progress bar")
+
+	p.bar = pb.New64(sizeOfFile).SetUnits(pb.U_BYTES)
+	p.bar.ShowTimeLeft = false
+	p.bar.Start()
+	return io.MultiWriter(writer, p.bar)
+}
+
 func (p *ProgressBar) Ready() {
 	p.ready <- true
 }
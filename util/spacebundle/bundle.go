@@ -0,0 +1,158 @@
+// Package spacebundle reads and writes the directory of files that
+// export-space produces and apply-space consumes.
+package spacebundle
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// ManifestFileName is the multi-app manifest of the bundle.
+	ManifestFileName = "manifest.yml"
+	// SpaceFileName describes everything in the space other than apps.
+	SpaceFileName = "space.yml"
+)
+
+// Space is the content of the space file.
+type Space struct {
+	ServiceInstances          []ServiceInstance         `yaml:"service_instances,omitempty"`
+	UserProvidedServices      []UserProvidedService     `yaml:"user_provided_services,omitempty"`
+	Routes                    []Route                   `yaml:"routes,omitempty"`
+	NetworkPolicies           []NetworkPolicy           `yaml:"network_policies,omitempty"`
+	EnvironmentVariableGroups EnvironmentVariableGroups `yaml:"environment_variable_groups,omitempty"`
+}
+
+type ServiceInstance struct {
+	Name       string                 `yaml:"name"`
+	Offering   string                 `yaml:"offering"`
+	Plan       string                 `yaml:"plan"`
+	Broker     string                 `yaml:"broker,omitempty"`
+	Tags       []string               `yaml:"tags,omitempty"`
+	Parameters map[string]interface{} `yaml:"parameters,omitempty"`
+}
+
+// UserProvidedService does not include credentials, which have to be
+// provided again when the service is recreated.
+type UserProvidedService struct {
+	Name            string   `yaml:"name"`
+	Tags            []string `yaml:"tags,omitempty"`
+	SyslogDrainURL  string   `yaml:"syslog_drain_url,omitempty"`
+	RouteServiceURL string   `yaml:"route_service_url,omitempty"`
+}
+
+type Route struct {
+	Host     string   `yaml:"host,omitempty"`
+	Domain   string   `yaml:"domain"`
+	Path     string   `yaml:"path,omitempty"`
+	Port     int      `yaml:"port,omitempty"`
+	Protocol string   `yaml:"protocol,omitempty"`
+	Apps     []string `yaml:"apps,omitempty"`
+}
+
+// URL returns the route in the form the Cloud Controller uses for the url of
+// a route, such as host.example.com/path or tcp.example.com:1024.
+func (route Route) URL() string {
+	url := route.Domain
+	if route.Host != "" {
+		url = route.Host + "." + url
+	}
+	if route.Port != 0 {
+		url = fmt.Sprintf("%s:%d", url, route.Port)
+	}
+	return url + route.Path
+}
+
+type NetworkPolicy struct {
+	Source           string `yaml:"source"`
+	Destination      string `yaml:"destination"`
+	DestinationSpace string `yaml:"destination_space,omitempty"`
+	DestinationOrg   string `yaml:"destination_org,omitempty"`
+	Protocol         string `yaml:"protocol"`
+	StartPort        int    `yaml:"start_port"`
+	EndPort          int    `yaml:"end_port"`
+}
+
+type EnvironmentVariableGroups struct {
+	Running map[string]string `yaml:"running,omitempty"`
+	Staging map[string]string `yaml:"staging,omitempty"`
+}
+
+// Write writes the manifest and the space file into dir, creating dir if
+// needed, and returns the paths of the written files.
+func Write(dir string, manifest []byte, space Space) (string, string, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return "", "", err
+	}
+
+	spaceBytes, err := yaml.Marshal(space)
+	if err != nil {
+		return "", "", err
+	}
+
+	manifestPath := filepath.Join(dir, ManifestFileName)
+	err = writePrivateFile(manifestPath, manifest)
+	if err != nil {
+		return "", "", err
+	}
+
+	spacePath := filepath.Join(dir, SpaceFileName)
+	err = writePrivateFile(spacePath, spaceBytes)
+	if err != nil {
+		return "", "", err
+	}
+
+	return manifestPath, spacePath, nil
+}
+
+// ExistingFiles returns the paths of the bundle files that already exist in
+// dir and would be overwritten by Write.
+func ExistingFiles(dir string) ([]string, error) {
+	var existing []string
+	for _, name := range []string{ManifestFileName, SpaceFileName} {
+		path := filepath.Join(dir, name)
+		_, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		existing = append(existing, path)
+	}
+
+	return existing, nil
+}
+
+// ReadSpace reads the space file in dir.
+func ReadSpace(dir string) (Space, error) {
+	spaceBytes, err := os.ReadFile(filepath.Join(dir, SpaceFileName))
+	if err != nil {
+		return Space{}, err
+	}
+
+	var space Space
+	err = yaml.Unmarshal(spaceBytes, &space)
+	if err != nil {
+		return Space{}, err
+	}
+
+	return space, nil
+}
+
+// writePrivateFile writes a file only the user can read: the manifest holds
+// the environment variables of the apps and the space file the parameters of
+// the service instances.
+func writePrivateFile(path string, contents []byte) error {
+	err := os.WriteFile(path, contents, 0600)
+	if err != nil {
+		return err
+	}
+
+	// WriteFile keeps the mode of a file that already exists.
+	return os.Chmod(path, 0600)
+}
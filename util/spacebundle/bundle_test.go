@@ -0,0 +1,172 @@
+package spacebundle_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	. "code.cloudfoundry.org/cli/util/spacebundle"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bundle", func() {
+	var (
+		dir   string
+		space Space
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "spacebundle")
+		Expect(err).ToNot(HaveOccurred())
+
+		space = Space{
+			ServiceInstances: []ServiceInstance{
+				{
+					Name:       "db",
+					Offering:   "postgres",
+					Plan:       "small",
+					Broker:     "some-broker",
+					Tags:       []string{"sql"},
+					Parameters: map[string]interface{}{"password": "secret"},
+				},
+			},
+			UserProvidedServices: []UserProvidedService{
+				{Name: "logs", SyslogDrainURL: "syslog://logs.example.com"},
+			},
+			Routes: []Route{
+				{Host: "app", Domain: "example.com", Path: "/api", Apps: []string{"app"}},
+				{Domain: "tcp.example.com", Port: 1024, Protocol: "tcp"},
+			},
+			NetworkPolicies: []NetworkPolicy{
+				{Source: "app", Destination: "backend", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+			},
+			EnvironmentVariableGroups: EnvironmentVariableGroups{
+				Running: map[string]string{"RUNNING": "value"},
+				Staging: map[string]string{"STAGING": "value"},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("Write", func() {
+		var bundleDir string
+
+		BeforeEach(func() {
+			bundleDir = filepath.Join(dir, "bundle")
+		})
+
+		It("writes a manifest and a space file that ReadSpace reads back", func() {
+			manifestPath, spacePath, err := Write(bundleDir, []byte("applications: []\n"), space)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manifestPath).To(Equal(filepath.Join(bundleDir, ManifestFileName)))
+			Expect(spacePath).To(Equal(filepath.Join(bundleDir, SpaceFileName)))
+
+			manifest, err := os.ReadFile(manifestPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(manifest)).To(Equal("applications: []\n"))
+
+			readSpace, err := ReadSpace(bundleDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readSpace).To(Equal(space))
+		})
+
+		It("omits the empty sections of the space", func() {
+			_, spacePath, err := Write(bundleDir, nil, Space{})
+			Expect(err).ToNot(HaveOccurred())
+
+			spaceFile, err := os.ReadFile(spacePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(spaceFile)).To(Equal("{}\n"))
+		})
+
+		When("the files already exist", func() {
+			BeforeEach(func() {
+				Expect(os.MkdirAll(bundleDir, 0755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(bundleDir, ManifestFileName), []byte("old"), 0644)).To(Succeed())
+			})
+
+			It("overwrites them", func() {
+				manifestPath, _, err := Write(bundleDir, []byte("new"), space)
+				Expect(err).ToNot(HaveOccurred())
+
+				manifest, err := os.ReadFile(manifestPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(manifest)).To(Equal("new"))
+			})
+
+			It("makes them readable only by the user", func() {
+				if runtime.GOOS == "windows" {
+					Skip("file modes are not supported on Windows")
+				}
+
+				manifestPath, spacePath, err := Write(bundleDir, []byte("new"), space)
+				Expect(err).ToNot(HaveOccurred())
+
+				for _, path := range []string{manifestPath, spacePath} {
+					info, err := os.Stat(path)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+				}
+			})
+		})
+	})
+
+	Describe("ExistingFiles", func() {
+		When("the directory does not exist", func() {
+			It("returns no files", func() {
+				existing, err := ExistingFiles(filepath.Join(dir, "missing"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(existing).To(BeEmpty())
+			})
+		})
+
+		When("some of the files exist", func() {
+			BeforeEach(func() {
+				Expect(os.WriteFile(filepath.Join(dir, ManifestFileName), []byte("applications: []\n"), 0644)).To(Succeed())
+			})
+
+			It("returns their paths", func() {
+				existing, err := ExistingFiles(dir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(existing).To(Equal([]string{filepath.Join(dir, ManifestFileName)}))
+			})
+		})
+	})
+
+	Describe("ReadSpace", func() {
+		When("there is no space file", func() {
+			It("returns an error", func() {
+				_, err := ReadSpace(dir)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		When("the space file is not valid YAML", func() {
+			BeforeEach(func() {
+				Expect(os.WriteFile(filepath.Join(dir, SpaceFileName), []byte("routes: {"), 0600)).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				_, err := ReadSpace(dir)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Route.URL", func() {
+		DescribeTable("returns the url of the route",
+			func(route Route, url string) {
+				Expect(route.URL()).To(Equal(url))
+			},
+			Entry("a domain", Route{Domain: "example.com"}, "example.com"),
+			Entry("a host and path", Route{Host: "app", Domain: "example.com", Path: "/api"}, "app.example.com/api"),
+			Entry("a port", Route{Domain: "tcp.example.com", Port: 1024}, "tcp.example.com:1024"),
+		)
+	})
+})
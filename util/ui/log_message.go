@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"hash/fnv"
 	"strings"
 	"time"
 
@@ -23,11 +24,39 @@ type LogMessage interface {
 	SourceInstance() string
 }
 
+// logPrefixColors are cycled through to tell the apps in a merged log stream
+// apart.
+var logPrefixColors = []color.Attribute{
+	color.FgCyan,
+	color.FgMagenta,
+	color.FgYellow,
+	color.FgGreen,
+	color.FgBlue,
+}
+
 // DisplayLogMessage formats and outputs a given log message.
 func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
+	ui.displayLogMessage("", message, displayHeader)
+}
+
+// DisplayLogMessageWithPrefix formats and outputs a given log message with
+// every line preceded by the prefix. The prefix is colored, and a given prefix
+// always gets the same color.
+func (ui *UI) DisplayLogMessageWithPrefix(prefix string, message LogMessage, displayHeader bool) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(prefix))
+	prefixColor := logPrefixColors[hash.Sum32()%uint32(len(logPrefixColors))]
+
+	ui.displayLogMessage(ui.modifyColor(prefix, color.New(prefixColor, color.Bold))+" ", message, displayHeader)
+}
+
+func (ui *UI) displayLogMessage(prefix string, message LogMessage, displayHeader bool) {
 	var header string
 	if displayHeader {
 		time := message.Timestamp().In(ui.TimezoneLocation).Format(LogTimestampFormat)
@@ -45,6 +74,6 @@ func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 		if message.Type() == "ERR" {
 			logLine = ui.modifyColor(logLine, color.New(color.FgRed))
 		}
-		fmt.Fprintf(ui.Out, "   %s\n", logLine)
+		fmt.Fprintf(ui.Out, "   %s%s\n", prefix, logLine)
 	}
 }
@@ -1,6 +1,7 @@
 package ui_test
 
 import (
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -114,5 +115,23 @@ var _ = Describe("Log Message", func() {
 				Expect(out).To(Say("\x1b\\[31mThis is a log message\x1b\\[0m\n"))
 			})
 		})
+
+		Describe("DisplayLogMessageWithPrefix", func() {
+			It("prints the colored prefix before every line", func() {
+				message.MessageReturns("This is a log message\nThis is also a log message")
+				ui.DisplayLogMessageWithPrefix("[some-app]", message, true)
+				Expect(out).To(Say(`   \x1b\[\d+;1m\[some-app\]\x1b\[0;22m 2016-07-19T16:08:12.00-0700 \[APP/PROC/WEB/12\] OUT This is a log message\n`))
+				Expect(out).To(Say(`   \x1b\[\d+;1m\[some-app\]\x1b\[0;22m 2016-07-19T16:08:12.00-0700 \[APP/PROC/WEB/12\] OUT This is also a log message\n`))
+			})
+
+			It("uses the same color for the same prefix", func() {
+				message.MessageReturns("This is a log message")
+				ui.DisplayLogMessageWithPrefix("[some-app]", message, false)
+				ui.DisplayLogMessageWithPrefix("[some-app]", message, false)
+				lines := strings.Split(strings.TrimSuffix(string(out.Contents()), "\n"), "\n")
+				Expect(lines).To(HaveLen(2))
+				Expect(lines[0]).To(Equal(lines[1]))
+			})
+		})
 	})
 })
@@ -20,6 +20,7 @@ import (
 	"github.com/fatih/color"
 	runewidth "github.com/mattn/go-runewidth"
 	"github.com/vito/go-interact/interact"
+	"gopkg.in/yaml.v2"
 )
 
 var realExiter exiterFunc = os.Exit
@@ -372,6 +373,25 @@ func (ui *UI) DisplayJSON(name string, jsonData interface{}) error {
 	return nil
 }
 
+// DisplayYAML encodes the input as YAML, nested under name when provided,
+// and outputs the result to ui.Out.
+func (ui *UI) DisplayYAML(name string, yamlData interface{}) error {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	if name != "" {
+		yamlData = map[string]interface{}{name: yamlData}
+	}
+
+	buff, err := yaml.Marshal(yamlData)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ui.Out, "%s", buff)
+	return nil
+}
+
 // FlushDeferred displays text previously deferred (using DeferText) to the UI's
 // `Out`.
 func (ui *UI) FlushDeferred() {
@@ -93,6 +93,30 @@ func (ui *UI) DisplayChangesForPush(changeSet []Change) error {
 	return nil
 }
 
+// DisplayTextWithPrefix translates the template, substitutes in
+// templateValues, and outputs each line of the result to ui.Out preceded by
+// the prefix. It is used to tell apart the output of apps pushed in parallel.
+func (ui *UI) DisplayTextWithPrefix(prefix string, template string, templateValues ...map[string]interface{}) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	coloredPrefix := ui.modifyColor(prefix, color.New(color.FgCyan, color.Bold))
+	for _, line := range strings.Split(ui.TranslateText(template, templateValues...), "\n") {
+		fmt.Fprintf(ui.Out, "%s %s\n", coloredPrefix, line)
+	}
+}
+
+// DisplayWarningsWithPrefix translates the warnings and outputs each of them
+// to ui.Err preceded by the prefix.
+func (ui *UI) DisplayWarningsWithPrefix(prefix string, warnings []string) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	for _, warning := range warnings {
+		fmt.Fprintf(ui.Err, "%s %s\n", prefix, ui.TranslateText(warning))
+	}
+}
+
 func (ui UI) displayDiffForInt(offset string, header string, oldValue int, newValue int) {
 	if oldValue != newValue {
 		formattedOld := fmt.Sprintf("- %s%s%d", ui.TranslateText(header), offset, oldValue)
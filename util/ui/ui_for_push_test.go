@@ -34,6 +34,22 @@ var _ = Describe("UI", func() {
 		ui.Err = NewBuffer()
 	})
 
+	Describe("DisplayTextWithPrefix", func() {
+		It("prefixes every line of the translated text", func() {
+			ui.DisplayTextWithPrefix("[some-app]", "line {{.Number}}\nsecond line", map[string]interface{}{"Number": 1})
+			Expect(out).To(Say(regexp.QuoteMeta("\x1b[36;1m[some-app]\x1b[0;22m line 1\n")))
+			Expect(out).To(Say(regexp.QuoteMeta("\x1b[36;1m[some-app]\x1b[0;22m second line\n")))
+		})
+	})
+
+	Describe("DisplayWarningsWithPrefix", func() {
+		It("prefixes every warning", func() {
+			ui.DisplayWarningsWithPrefix("[some-app]", []string{"warning-1", "warning-2"})
+			Expect(ui.Err).To(Say(`\[some-app\] warning-1\n`))
+			Expect(ui.Err).To(Say(`\[some-app\] warning-2\n`))
+		})
+	})
+
 	Describe("DisplayChangesForPush", func() {
 		It("aligns all the string types", func() {
 			changeSet := []Change{
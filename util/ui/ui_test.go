@@ -225,6 +225,36 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayYAML", func() {
+		It("encodes the input as YAML", func() {
+			obj := map[string]interface{}{
+				"str": "hello",
+				"int": 42,
+				"arr": []string{"a", "b"},
+			}
+
+			err := ui.DisplayYAML("", obj)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(out).To(SatisfyAll(
+				Say("arr:\n"),
+				Say("- a\n"),
+				Say("- b\n"),
+				Say("int: 42\n"),
+				Say("str: hello\n"),
+			))
+		})
+
+		When("a name is provided", func() {
+			It("displays the name as the top level key", func() {
+				err := ui.DisplayYAML("named_yaml", map[string]string{"str": "hello"})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(out).To(Say("named_yaml:\n  str: hello\n"))
+			})
+		})
+	})
+
 	Describe("DeferText", func() {
 		It("defers the template with map values substituted into ui.Out with a newline", func() {
 			ui.DeferText(